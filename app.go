@@ -2,6 +2,8 @@ package stream_chat //nolint: golint
 
 import (
 	"net/http"
+	"net/url"
+	"strings"
 	"time"
 )
 
@@ -10,7 +12,16 @@ type AppSettings struct {
 	DisablePermissions *bool           `json:"disable_permissions_checks,omitempty"`
 	APNConfig          *APNConfig      `json:"apn_config,omitempty"`
 	FirebaseConfig     *FirebaseConfig `json:"firebase_config,omitempty"`
+	HuaweiConfig       *HuaweiConfig   `json:"huawei_config,omitempty"`
 	WebhookURL         *string         `json:"webhook_url,omitempty"`
+	MultiTenantEnabled *bool           `json:"multi_tenant_enabled,omitempty"`
+	PermissionVersion  *string         `json:"permission_version,omitempty"`
+	SQSURL             *string         `json:"sqs_url,omitempty"`
+	SQSKey             *string         `json:"sqs_key,omitempty"`
+	SQSSecret          *string         `json:"sqs_secret,omitempty"`
+	SNSTopicARN        *string         `json:"sns_topic_arn,omitempty"`
+	SNSKey             *string         `json:"sns_key,omitempty"`
+	SNSSecret          *string         `json:"sns_secret,omitempty"`
 }
 
 func (a *AppSettings) SetDisableAuth(b bool) *AppSettings {
@@ -33,11 +44,43 @@ func (a *AppSettings) SetFirebaseConfig(c FirebaseConfig) *AppSettings {
 	return a
 }
 
+func (a *AppSettings) SetHuaweiConfig(c HuaweiConfig) *AppSettings {
+	a.HuaweiConfig = &c
+	return a
+}
+
 func (a *AppSettings) SetWebhookURL(s string) *AppSettings {
 	a.WebhookURL = &s
 	return a
 }
 
+func (a *AppSettings) SetMultiTenant(b bool) *AppSettings {
+	a.MultiTenantEnabled = &b
+	return a
+}
+
+// SetPermissionVersion sets the permission system version, one of "v1" or "v2"
+func (a *AppSettings) SetPermissionVersion(v string) *AppSettings {
+	a.PermissionVersion = &v
+	return a
+}
+
+// SetSQS sets the AWS SQS queue events are sent to
+func (a *AppSettings) SetSQS(url, key, secret string) *AppSettings {
+	a.SQSURL = &url
+	a.SQSKey = &key
+	a.SQSSecret = &secret
+	return a
+}
+
+// SetSNS sets the AWS SNS topic events are published to
+func (a *AppSettings) SetSNS(topicARN, key, secret string) *AppSettings {
+	a.SNSTopicARN = &topicARN
+	a.SNSKey = &key
+	a.SNSSecret = &secret
+	return a
+}
+
 func NewAppSettings() *AppSettings {
 	return &AppSettings{}
 }
@@ -59,9 +102,16 @@ type FirebaseConfig struct {
 	NotificationTemplate string `json:"notification_template"`
 }
 
+type HuaweiConfig struct {
+	Enabled bool   `json:"enabled"`
+	ID      string `json:"id,omitempty"`
+	Secret  string `json:"secret,omitempty"`
+}
+
 type PushNotificationFields struct {
 	APNConfig      APNConfig      `json:"apn"`
 	FirebaseConfig FirebaseConfig `json:"firebase"`
+	HuaweiConfig   HuaweiConfig   `json:"huawei"`
 }
 
 type Policy struct {
@@ -86,6 +136,14 @@ type AppConfig struct {
 	Suspended            bool                      `json:"suspended"`
 	DisableAuth          bool                      `json:"disable_auth_checks"`
 	DisablePermissions   bool                      `json:"disable_permissions_checks"`
+	MultiTenantEnabled   bool                      `json:"multi_tenant_enabled"`
+	PermissionVersion    string                    `json:"permission_version"`
+	SQSURL               string                    `json:"sqs_url"`
+	SQSKey               string                    `json:"sqs_key"`
+	SQSSecret            string                    `json:"sqs_secret"`
+	SNSTopicARN          string                    `json:"sns_topic_arn"`
+	SNSKey               string                    `json:"sns_key"`
+	SNSSecret            string                    `json:"sns_secret"`
 }
 
 type appResponse struct {
@@ -111,3 +169,119 @@ func (c *Client) GetAppConfig() (*AppConfig, error) {
 func (c *Client) UpdateAppSettings(settings *AppSettings) error {
 	return c.makeRequest(http.MethodPatch, "app", nil, settings, nil)
 }
+
+// CheckSQSRequest holds the SQS credentials to check,
+// stored app settings are used for empty fields
+type CheckSQSRequest struct {
+	SQSURL    string `json:"sqs_url,omitempty"`
+	SQSKey    string `json:"sqs_key,omitempty"`
+	SQSSecret string `json:"sqs_secret,omitempty"`
+}
+
+// CheckSNSRequest holds the SNS credentials to check,
+// stored app settings are used for empty fields
+type CheckSNSRequest struct {
+	SNSTopicARN string `json:"sns_topic_arn,omitempty"`
+	SNSKey      string `json:"sns_key,omitempty"`
+	SNSSecret   string `json:"sns_secret,omitempty"`
+}
+
+// CheckResponse is the result of an integration check, Status is either "ok" or "error"
+type CheckResponse struct {
+	Status string                 `json:"status"`
+	Error  string                 `json:"error,omitempty"`
+	Data   map[string]interface{} `json:"data,omitempty"`
+}
+
+// OK reports whether the check succeeded
+func (r *CheckResponse) OK() bool {
+	return r.Status == "ok"
+}
+
+// CheckSQS checks that events can be sent to the SQS queue
+func (c *Client) CheckSQS(req *CheckSQSRequest) (*CheckResponse, error) {
+	if req == nil {
+		req = &CheckSQSRequest{}
+	}
+
+	var resp CheckResponse
+
+	err := c.makeRequest(http.MethodPost, "check_sqs", nil, req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// CheckSNS checks that events can be published to the SNS topic
+func (c *Client) CheckSNS(req *CheckSNSRequest) (*CheckResponse, error) {
+	if req == nil {
+		req = &CheckSNSRequest{}
+	}
+
+	var resp CheckResponse
+
+	err := c.makeRequest(http.MethodPost, "check_sns", nil, req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetRateLimitsOptions selects the platforms and endpoints of the rate limits,
+// all platforms and endpoints are returned when nothing is selected
+type GetRateLimitsOptions struct {
+	ServerSide bool
+	Android    bool
+	IOS        bool
+	Web        bool
+	Endpoints  []string // ie "GetRateLimits", "SendMessage"
+}
+
+func (o GetRateLimitsOptions) params() url.Values {
+	params := url.Values{}
+
+	if o.ServerSide {
+		params.Set("server_side", "true")
+	}
+	if o.Android {
+		params.Set("android", "true")
+	}
+	if o.IOS {
+		params.Set("ios", "true")
+	}
+	if o.Web {
+		params.Set("web", "true")
+	}
+	if len(o.Endpoints) > 0 {
+		params.Set("endpoints", strings.Join(o.Endpoints, ","))
+	}
+
+	return params
+}
+
+// RateLimitsMap is the rate limits of the endpoints by endpoint name
+type RateLimitsMap map[string]RateLimitInfo
+
+// GetRateLimitsResponse is the rate limits of the app by platform,
+// platforms not selected are nil
+type GetRateLimitsResponse struct {
+	ServerSide RateLimitsMap `json:"server_side,omitempty"`
+	Android    RateLimitsMap `json:"android,omitempty"`
+	IOS        RateLimitsMap `json:"ios,omitempty"`
+	Web        RateLimitsMap `json:"web,omitempty"`
+}
+
+// GetRateLimits returns the current rate limits of the app
+func (c *Client) GetRateLimits(options GetRateLimitsOptions) (*GetRateLimitsResponse, error) {
+	var resp GetRateLimitsResponse
+
+	err := c.makeRequest(http.MethodGet, "rate_limits", options.params(), nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
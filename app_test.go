@@ -1,7 +1,11 @@
 package stream_chat //nolint: golint
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestClient_GetApp(t *testing.T) {
@@ -20,3 +24,78 @@ func TestClient_UpdateAppSettings(t *testing.T) {
 	err := c.UpdateAppSettings(settings)
 	mustNoError(t, err)
 }
+
+func TestClient_UpdateAppSettingsPush(t *testing.T) {
+	c := initClient(t)
+
+	orig, err := c.GetAppConfig()
+	mustNoError(t, err, "get app config")
+	defer func() {
+		restore := NewAppSettings().
+			SetWebhookURL(orig.WebhookURL).
+			SetHuaweiConfig(orig.PushNotifications.HuaweiConfig)
+		mustNoError(t, c.UpdateAppSettings(restore), "restore app settings")
+	}()
+
+	settings := NewAppSettings().
+		SetWebhookURL("https://example.com/webhook").
+		SetHuaweiConfig(HuaweiConfig{Enabled: false})
+
+	err = c.UpdateAppSettings(settings)
+	mustNoError(t, err, "update app settings")
+
+	app, err := c.GetAppConfig()
+	mustNoError(t, err, "get app config")
+	assert.Equal(t, "https://example.com/webhook", app.WebhookURL)
+}
+
+func TestClient_CheckSQS(t *testing.T) {
+	c := initClient(t)
+
+	resp, err := c.CheckSQS(&CheckSQSRequest{
+		SQSURL:    "https://sqs.us-east-1.amazonaws.com/123456789012/invalid",
+		SQSKey:    "key",
+		SQSSecret: "secret",
+	})
+	mustNoError(t, err)
+	assert.False(t, resp.OK(), "invalid SQS credentials passed the check")
+}
+
+func TestClient_CheckSNS(t *testing.T) {
+	c := initClient(t)
+
+	resp, err := c.CheckSNS(&CheckSNSRequest{
+		SNSTopicARN: "arn:aws:sns:us-east-1:123456789012:invalid",
+		SNSKey:      "key",
+		SNSSecret:   "secret",
+	})
+	mustNoError(t, err)
+	assert.False(t, resp.OK(), "invalid SNS credentials passed the check")
+}
+
+func TestClient_GetRateLimits(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rate_limits", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("server_side"))
+		assert.Empty(t, r.URL.Query().Get("android"))
+		assert.Equal(t, "SendMessage,QueryChannels", r.URL.Query().Get("endpoints"))
+
+		_, _ = w.Write([]byte(`{"server_side":{"SendMessage":{"limit":600,"remaining":598,"reset":1580000000},` +
+			`"QueryChannels":{"limit":300,"remaining":300,"reset":1580000000}},"duration":"1ms"}`))
+	}))
+	defer srv.Close()
+
+	c, err := NewClient("key", []byte("secret"))
+	mustNoError(t, err, "new client")
+	c.BaseURL = srv.URL
+
+	limits, err := c.GetRateLimits(GetRateLimitsOptions{
+		ServerSide: true,
+		Endpoints:  []string{"SendMessage", "QueryChannels"},
+	})
+	mustNoError(t, err, "get rate limits")
+
+	assert.Nil(t, limits.Android)
+	assert.Equal(t, RateLimitInfo{Limit: 600, Remaining: 598, Reset: 1580000000}, limits.ServerSide["SendMessage"])
+	assert.Equal(t, int64(300), limits.ServerSide["QueryChannels"].Remaining)
+}
@@ -0,0 +1,133 @@
+package stream_chat // nolint: golint
+
+import (
+	"errors"
+)
+
+type AttachmentType string
+
+const (
+	AttachmentTypeImage AttachmentType = "image"
+	AttachmentTypeFile  AttachmentType = "file"
+	AttachmentTypeVideo AttachmentType = "video"
+	AttachmentTypeAudio AttachmentType = "audio"
+	AttachmentTypeGiphy AttachmentType = "giphy"
+)
+
+type Attachment struct {
+	Type string `json:"type,omitempty"` // text, image, audio, video
+
+	AuthorName string `json:"author_name,omitempty"`
+	AuthorLink string `json:"author_link,omitempty"`
+	AuthorIcon string `json:"author_icon,omitempty"`
+	Title      string `json:"title,omitempty"`
+	TitleLink  string `json:"title_link,omitempty"`
+	Pretext    string `json:"pretext,omitempty"`
+	Text       string `json:"text,omitempty"`
+	Fallback   string `json:"fallback,omitempty"`
+	Color      string `json:"color,omitempty"`
+	Footer     string `json:"footer,omitempty"`
+	FooterIcon string `json:"footer_icon,omitempty"`
+
+	ImageURL    string `json:"image_url,omitempty"`
+	ThumbURL    string `json:"thumb_url,omitempty"`
+	AssetURL    string `json:"asset_url,omitempty"`
+	OGScrapeURL string `json:"og_scrape_url,omitempty"`
+
+	Actions []*AttachmentAction `json:"actions,omitempty"`
+	Fields  []*AttachmentField  `json:"fields,omitempty"`
+
+	ExtraData map[string]interface{} `json:"-,extra"` //nolint: staticcheck
+}
+
+// AttachmentAction is an interactive element of the attachment, submitted with Channel.SendAction
+type AttachmentAction struct {
+	Name  string `json:"name"`
+	Text  string `json:"text"`
+	Type  string `json:"type"` // button, select
+	Style string `json:"style,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+// AttachmentField is a title/value pair displayed in the attachment
+type AttachmentField struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+	Short bool   `json:"short"` // short fields are displayed side by side
+}
+
+// NewImageAttachment returns an image attachment of the image at given URL
+func NewImageAttachment(imageURL string) *Attachment {
+	return &Attachment{
+		Type:     string(AttachmentTypeImage),
+		ImageURL: imageURL,
+	}
+}
+
+// NewFileAttachment returns a file attachment of the file at given URL, ie returned by Channel.SendFile
+func NewFileAttachment(assetURL, title string) *Attachment {
+	return &Attachment{
+		Type:     string(AttachmentTypeFile),
+		AssetURL: assetURL,
+		Title:    title,
+	}
+}
+
+// NewGiphyAttachment returns a giphy attachment of the gif at given URL
+func NewGiphyAttachment(gifURL, title string) *Attachment {
+	return &Attachment{
+		Type:      string(AttachmentTypeGiphy),
+		Title:     title,
+		TitleLink: gifURL,
+		ThumbURL:  gifURL,
+	}
+}
+
+// IsOG reports whether the attachment is a link preview generated by URL enrichment,
+// preview data is in Title, TitleLink, Text, ImageURL, ThumbURL and AuthorName
+func (a *Attachment) IsOG() bool {
+	return a.OGScrapeURL != ""
+}
+
+// AddAction adds an action to the attachment
+func (a *Attachment) AddAction(action *AttachmentAction) *Attachment {
+	a.Actions = append(a.Actions, action)
+	return a
+}
+
+// AddField adds a field to the attachment
+func (a *Attachment) AddField(title, value string, short bool) *Attachment {
+	a.Fields = append(a.Fields, &AttachmentField{Title: title, Value: value, Short: short})
+	return a
+}
+
+// Validate checks that the attachment has the URL its type requires and its actions are complete
+func (a *Attachment) Validate() error {
+	switch AttachmentType(a.Type) {
+	case AttachmentTypeImage:
+		if a.ImageURL == "" && a.ThumbURL == "" {
+			return errors.New("image attachment URL is empty")
+		}
+	case AttachmentTypeFile, AttachmentTypeVideo, AttachmentTypeAudio:
+		if a.AssetURL == "" {
+			return errors.New(a.Type + " attachment asset URL is empty")
+		}
+	case AttachmentTypeGiphy:
+		if a.ThumbURL == "" {
+			return errors.New("giphy attachment URL is empty")
+		}
+	}
+
+	for _, action := range a.Actions {
+		switch {
+		case action == nil:
+			return errors.New("attachment action is nil")
+		case action.Name == "":
+			return errors.New("attachment action name is empty")
+		case action.Type == "":
+			return errors.New("attachment action type is empty")
+		}
+	}
+
+	return nil
+}
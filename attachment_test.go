@@ -0,0 +1,45 @@
+package stream_chat // nolint: golint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttachment_Validate(t *testing.T) {
+	tests := []struct {
+		name       string
+		attachment *Attachment
+		valid      bool
+	}{
+		{"image", NewImageAttachment("https://example.com/cat.jpg"), true},
+		{"image without url", NewImageAttachment(""), false},
+		{"file", NewFileAttachment("https://example.com/doc.pdf", "doc.pdf"), true},
+		{"video without url", &Attachment{Type: string(AttachmentTypeVideo)}, false},
+		{"giphy", NewGiphyAttachment("https://media.giphy.com/cat.gif", "cat"), true},
+		{"custom", &Attachment{Type: "location", ExtraData: map[string]interface{}{"lat": 1.0}}, true},
+		{
+			"with action",
+			NewImageAttachment("https://example.com/cat.jpg").
+				AddAction(&AttachmentAction{Name: "image_action", Text: "Send", Type: "button", Value: "send"}).
+				AddField("size", "1MB", true),
+			true,
+		},
+		{
+			"action without name",
+			NewImageAttachment("https://example.com/cat.jpg").AddAction(&AttachmentAction{Type: "button"}),
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.attachment.Validate()
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
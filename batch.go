@@ -0,0 +1,122 @@
+package stream_chat // nolint: golint
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+const (
+	defaultBatchWorkers    = 8
+	defaultBatchMaxRetries = 3
+
+	rateLimitRetryDelay = 500 * time.Millisecond
+)
+
+// BatchMessage is a message sent by BatchSender
+type BatchMessage struct {
+	Channel *Channel
+	Message *Message
+	UserID  string
+	Options []SendMessageOption `json:"-"`
+}
+
+// BatchResult is the result of a BatchMessage, Message is the sent message when Err is nil
+type BatchResult struct {
+	Message *Message
+	Err     error `json:"-"`
+}
+
+// BatchSender sends messages to many channels concurrently, ie to fan out an announcement.
+// Rate limited sends pause all workers until the rate limit window is reset and are retried.
+type BatchSender struct {
+	Workers    int  // concurrent sends, 8 by default
+	MaxRetries *int // retries of a rate limited send, 3 if nil; zero disables retries
+}
+
+// Send sends the messages and returns their results in the same order
+func (s BatchSender) Send(messages []BatchMessage) []BatchResult {
+	workers := s.Workers
+	if workers <= 0 {
+		workers = defaultBatchWorkers
+	}
+
+	maxRetries := defaultBatchMaxRetries
+	if s.MaxRetries != nil && *s.MaxRetries >= 0 {
+		maxRetries = *s.MaxRetries
+	}
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		resume  time.Time // rate limited workers wait until it
+		results = make([]BatchResult, len(messages))
+		indices = make(chan int)
+	)
+
+	wait := func() {
+		mu.Lock()
+		d := time.Until(resume)
+		mu.Unlock()
+
+		if d > 0 {
+			time.Sleep(d)
+		}
+	}
+
+	pause := func(err error) {
+		d := rateLimitRetryDelay
+		if streamErr, ok := err.(*StreamError); ok && streamErr.RateLimit != nil {
+			if untilReset := time.Until(streamErr.RateLimit.ResetTime()); untilReset > d {
+				d = untilReset
+			}
+		}
+
+		mu.Lock()
+		if t := time.Now().Add(d); t.After(resume) {
+			resume = t
+		}
+		mu.Unlock()
+	}
+
+	send := func(m BatchMessage) (msg *Message, err error) {
+		if m.Channel == nil {
+			return nil, errors.New("channel is nil")
+		}
+
+		for attempt := 0; ; attempt++ {
+			wait()
+
+			msg, err = m.Channel.SendMessage(m.Message, m.UserID, m.Options...)
+			if err == nil || !isRateLimited(err) || attempt == maxRetries {
+				return msg, err
+			}
+
+			pause(err)
+		}
+	}
+
+	for i := 0; i < workers && i < len(messages); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				msg, err := send(messages[i])
+				results[i] = BatchResult{Message: msg, Err: err}
+			}
+		}()
+	}
+
+	for i := range messages {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+
+	return results
+}
+
+func isRateLimited(err error) bool {
+	streamErr, ok := err.(*StreamError)
+	return ok && streamErr.Is(ErrRateLimited)
+}
@@ -0,0 +1,129 @@
+package stream_chat // nolint: golint
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatchSender_Send(t *testing.T) {
+	var requests int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&requests, 1)
+
+		// channels/messaging/{id}/message
+		id := strings.Split(r.URL.Path, "/")[3]
+
+		switch {
+		case id == "missing":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code":16,"message":"channel doesn't exist"}`))
+		case n == 1:
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"code":9,"message":"too many requests"}`))
+		default:
+			_, _ = fmt.Fprintf(w, `{"message":{"id":"msg-%s","text":"hi"}}`, id)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := NewClient("key", []byte("secret"), WithBaseURL(srv.URL))
+	mustNoError(t, err, "new client")
+
+	ids := []string{"a", "b", "missing", "c", "d"}
+
+	messages := make([]BatchMessage, 0, len(ids)+1)
+	for _, id := range ids {
+		messages = append(messages, BatchMessage{
+			Channel: &Channel{Type: "messaging", ID: id, client: c},
+			Message: &Message{Text: "hi"},
+			UserID:  "admin",
+			Options: []SendMessageOption{MessageSkipPush()},
+		})
+	}
+	messages = append(messages, BatchMessage{Message: &Message{Text: "hi"}})
+
+	results := BatchSender{Workers: 2}.Send(messages)
+
+	if assert.Len(t, results, len(messages)) {
+		for i, id := range ids {
+			if id == "missing" {
+				streamErr, ok := results[i].Err.(*StreamError)
+				if assert.True(t, ok, "error is StreamError: %T", results[i].Err) {
+					assert.True(t, streamErr.Is(ErrNotFound), "not found")
+				}
+				continue
+			}
+			if assert.NoError(t, results[i].Err, id) {
+				assert.Equal(t, "msg-"+id, results[i].Message.ID, "ordered results")
+			}
+		}
+		mustError(t, results[len(ids)].Err, "nil channel")
+	}
+
+	assert.Equal(t, int32(len(ids)+1), atomic.LoadInt32(&requests), "rate limited send is retried")
+
+	atomic.StoreInt32(&requests, 0)
+
+	noRetries := 0
+	results = BatchSender{MaxRetries: &noRetries}.Send(messages[:1])
+
+	if assert.Len(t, results, 1) {
+		assert.True(t, isRateLimited(results[0].Err), "rate limited send isn't retried")
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+}
+
+func TestBatchSender_SendSharedMessage(t *testing.T) {
+	var (
+		mu  sync.Mutex
+		ids = map[string]bool{}
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req), "decode request")
+
+		id, _ := req["message"]["id"].(string)
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		if ids[id] {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = fmt.Fprintf(w, `{"code":4,"message":"message with id %s already exists"}`, id)
+			return
+		}
+		ids[id] = true
+		_, _ = fmt.Fprintf(w, `{"message":{"id":%q,"text":"hi"}}`, id)
+	}))
+	defer srv.Close()
+
+	c, err := NewClient("key", []byte("secret"), WithBaseURL(srv.URL))
+	mustNoError(t, err, "new client")
+
+	announcement := &Message{Text: "maintenance at noon"}
+
+	messages := make([]BatchMessage, 5)
+	for i := range messages {
+		messages[i] = BatchMessage{
+			Channel: &Channel{Type: "messaging", ID: fmt.Sprintf("channel-%d", i), client: c},
+			Message: announcement,
+			UserID:  "admin",
+		}
+	}
+
+	for i, result := range (BatchSender{Workers: 3}).Send(messages) {
+		assert.NoError(t, result.Err, "send to channel %d", i)
+	}
+	assert.Len(t, ids, len(messages), "a message per channel")
+	assert.Empty(t, announcement.ID, "shared message isn't modified")
+}
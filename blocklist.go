@@ -0,0 +1,97 @@
+package stream_chat // nolint: golint
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+)
+
+type Blocklist struct {
+	Name      string    `json:"name"`
+	Words     []string  `json:"words"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type blocklistRequest struct {
+	Name  string   `json:"name,omitempty"`
+	Words []string `json:"words"`
+}
+
+type blocklistResponse struct {
+	Blocklist *Blocklist `json:"blocklist"`
+}
+
+type blocklistsResponse struct {
+	Blocklists []*Blocklist `json:"blocklists"`
+}
+
+// CreateBlocklist creates new blocklist with given name and words
+func (c *Client) CreateBlocklist(name string, words []string) error {
+	switch {
+	case name == "":
+		return errors.New("blocklist name is empty")
+	case len(words) == 0:
+		return errors.New("blocklist words are empty")
+	}
+
+	req := blocklistRequest{Name: name, Words: words}
+
+	return c.makeRequest(http.MethodPost, "blocklists", nil, req, nil)
+}
+
+// GetBlocklist returns blocklist with given name
+func (c *Client) GetBlocklist(name string) (*Blocklist, error) {
+	if name == "" {
+		return nil, errors.New("blocklist name is empty")
+	}
+
+	p := path.Join("blocklists", url.PathEscape(name))
+
+	var resp blocklistResponse
+
+	err := c.makeRequest(http.MethodGet, p, nil, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Blocklist, nil
+}
+
+// ListBlocklists returns all blocklists
+func (c *Client) ListBlocklists() ([]*Blocklist, error) {
+	var resp blocklistsResponse
+
+	err := c.makeRequest(http.MethodGet, "blocklists", nil, nil, &resp)
+
+	return resp.Blocklists, err
+}
+
+// UpdateBlocklist replaces words of the blocklist with given name
+func (c *Client) UpdateBlocklist(name string, words []string) error {
+	switch {
+	case name == "":
+		return errors.New("blocklist name is empty")
+	case len(words) == 0:
+		return errors.New("blocklist words are empty")
+	}
+
+	p := path.Join("blocklists", url.PathEscape(name))
+
+	req := blocklistRequest{Words: words}
+
+	return c.makeRequest(http.MethodPut, p, nil, req, nil)
+}
+
+// DeleteBlocklist removes blocklist with given name
+func (c *Client) DeleteBlocklist(name string) error {
+	if name == "" {
+		return errors.New("blocklist name is empty")
+	}
+
+	p := path.Join("blocklists", url.PathEscape(name))
+
+	return c.makeRequest(http.MethodDelete, p, nil, nil, nil)
+}
@@ -0,0 +1,34 @@
+package stream_chat // nolint: golint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Blocklists(t *testing.T) {
+	c := initClient(t)
+
+	name := randomString(10)
+
+	err := c.CreateBlocklist(name, []string{"fudge", "cream", "sugar"})
+	mustNoError(t, err, "create blocklist")
+	defer func() {
+		mustNoError(t, c.DeleteBlocklist(name), "delete blocklist")
+	}()
+
+	got, err := c.GetBlocklist(name)
+	mustNoError(t, err, "get blocklist")
+	assert.Equal(t, name, got.Name)
+	assert.Equal(t, []string{"fudge", "cream", "sugar"}, got.Words)
+
+	mustNoError(t, c.UpdateBlocklist(name, []string{"cake"}), "update blocklist")
+
+	got, err = c.GetBlocklist(name)
+	mustNoError(t, err, "get blocklist")
+	assert.Equal(t, []string{"cake"}, got.Words)
+
+	list, err := c.ListBlocklists()
+	mustNoError(t, err, "list blocklists")
+	assert.NotEmpty(t, list, "blocklists exist")
+}
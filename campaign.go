@@ -0,0 +1,235 @@
+package stream_chat // nolint: golint
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+)
+
+type CampaignStatus string
+
+const (
+	CampaignStatusDraft     CampaignStatus = "draft"
+	CampaignStatusScheduled CampaignStatus = "scheduled"
+	CampaignStatusRunning   CampaignStatus = "running"
+	CampaignStatusStopped   CampaignStatus = "stopped"
+	CampaignStatusCompleted CampaignStatus = "completed"
+)
+
+// CampaignMessageTemplate is the message sent to every campaign target
+type CampaignMessageTemplate struct {
+	Text        string        `json:"text"`
+	Attachments []*Attachment `json:"attachments,omitempty"`
+
+	ExtraData map[string]interface{} `json:"-,extra"` //nolint: staticcheck
+}
+
+// CampaignChannelTemplate describes the channel a campaign message is sent to,
+// when ID is empty a distinct channel between sender and target is used
+type CampaignChannelTemplate struct {
+	Type    string   `json:"type"`
+	ID      string   `json:"id,omitempty"`
+	Members []string `json:"members,omitempty"`
+
+	ExtraData map[string]interface{} `json:"-,extra"` //nolint: staticcheck
+}
+
+type CampaignStats struct {
+	Progress        float64 `json:"stats_progress"`
+	UsersTargeted   int     `json:"stats_users_targeted"`
+	UsersSent       int     `json:"stats_users_sent"`
+	UsersRead       int     `json:"stats_users_read"`
+	MessagesSent    int     `json:"stats_messages_sent"`
+	ChannelsCreated int     `json:"stats_channels_created"`
+}
+
+type Campaign struct {
+	ID              string                   `json:"id"`
+	Name            string                   `json:"name"`
+	Description     string                   `json:"description"`
+	SenderID        string                   `json:"sender_id"`
+	SegmentIDs      []string                 `json:"segment_ids"`
+	UserIDs         []string                 `json:"user_ids"`
+	MessageTemplate *CampaignMessageTemplate `json:"message_template"`
+	ChannelTemplate *CampaignChannelTemplate `json:"channel_template"`
+	CreateChannels  bool                     `json:"create_channels"`
+	SkipPush        bool                     `json:"skip_push"`
+	SkipWebhook     bool                     `json:"skip_webhook"`
+
+	Status       CampaignStatus `json:"status"`
+	Stats        *CampaignStats `json:"stats"`
+	ScheduledFor *time.Time     `json:"scheduled_for"`
+	StopAt       *time.Time     `json:"stop_at"`
+	CreatedAt    time.Time      `json:"created_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+}
+
+type campaignRequest struct {
+	ID              string                   `json:"id,omitempty"`
+	Name            string                   `json:"name,omitempty"`
+	Description     string                   `json:"description,omitempty"`
+	SenderID        string                   `json:"sender_id"`
+	SegmentIDs      []string                 `json:"segment_ids,omitempty"`
+	UserIDs         []string                 `json:"user_ids,omitempty"`
+	MessageTemplate *CampaignMessageTemplate `json:"message_template"`
+	ChannelTemplate *CampaignChannelTemplate `json:"channel_template,omitempty"`
+	CreateChannels  bool                     `json:"create_channels,omitempty"`
+	SkipPush        bool                     `json:"skip_push,omitempty"`
+	SkipWebhook     bool                     `json:"skip_webhook,omitempty"`
+	ScheduledFor    *time.Time               `json:"scheduled_for,omitempty"`
+	StopAt          *time.Time               `json:"stop_at,omitempty"`
+}
+
+type campaignResponse struct {
+	Campaign *Campaign `json:"campaign"`
+}
+
+// QueryCampaignsRequest filters campaigns, Next/Prev are cursors of a previous QueryCampaignsResponse
+type QueryCampaignsRequest struct {
+	Filter map[string]interface{} `json:"filter"`
+	Sort   []*SortOption          `json:"sort,omitempty"`
+	Limit  int                    `json:"limit,omitempty"`
+	Next   string                 `json:"next,omitempty"`
+	Prev   string                 `json:"prev,omitempty"`
+}
+
+type QueryCampaignsResponse struct {
+	Campaigns []*Campaign `json:"campaigns"`
+	Next      string      `json:"next"`
+	Prev      string      `json:"prev"`
+}
+
+// CreateCampaign creates a draft campaign, it's sent once started.
+// Campaigns with ScheduledFor are started at that time, StopAt stops them.
+func (c *Client) CreateCampaign(campaign *Campaign) (*Campaign, error) {
+	switch {
+	case campaign == nil:
+		return nil, errors.New("campaign is nil")
+	case campaign.SenderID == "":
+		return nil, errors.New("campaign sender ID is empty")
+	case campaign.MessageTemplate == nil:
+		return nil, errors.New("campaign message template is nil")
+	case len(campaign.SegmentIDs) == 0 && len(campaign.UserIDs) == 0:
+		return nil, errors.New("campaign has no targets")
+	case campaign.ScheduledFor != nil && campaign.StopAt != nil && !campaign.StopAt.After(*campaign.ScheduledFor):
+		return nil, errors.New("campaign must stop after it's scheduled")
+	}
+
+	req := campaignRequest{
+		ID:              campaign.ID,
+		Name:            campaign.Name,
+		Description:     campaign.Description,
+		SenderID:        campaign.SenderID,
+		SegmentIDs:      campaign.SegmentIDs,
+		UserIDs:         campaign.UserIDs,
+		MessageTemplate: campaign.MessageTemplate,
+		ChannelTemplate: campaign.ChannelTemplate,
+		CreateChannels:  campaign.CreateChannels,
+		SkipPush:        campaign.SkipPush,
+		SkipWebhook:     campaign.SkipWebhook,
+		ScheduledFor:    campaign.ScheduledFor,
+		StopAt:          campaign.StopAt,
+	}
+
+	var resp campaignResponse
+
+	err := c.makeRequest(http.MethodPost, "campaigns", nil, req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Campaign, nil
+}
+
+// GetCampaign returns the campaign with given ID
+func (c *Client) GetCampaign(id string) (*Campaign, error) {
+	return c.campaignRequest(http.MethodGet, id, "", nil)
+}
+
+// GetCampaignStats returns the delivery stats of the campaign with given ID
+func (c *Client) GetCampaignStats(id string) (*CampaignStats, error) {
+	campaign, err := c.GetCampaign(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if campaign.Stats == nil {
+		return &CampaignStats{}, nil
+	}
+
+	return campaign.Stats, nil
+}
+
+// StartCampaign starts sending the campaign with given ID
+func (c *Client) StartCampaign(id string) (*Campaign, error) {
+	return c.campaignRequest(http.MethodPost, id, "start", nil)
+}
+
+// StartCampaignOptions schedule the start of a campaign, nil times keep the ones of the campaign
+type StartCampaignOptions struct {
+	ScheduledFor *time.Time `json:"scheduled_for,omitempty"` // start time, the campaign is started immediately if nil
+	StopAt       *time.Time `json:"stop_at,omitempty"`
+}
+
+// StartCampaignWithOptions starts or schedules the campaign with given ID
+func (c *Client) StartCampaignWithOptions(id string, options StartCampaignOptions) (*Campaign, error) {
+	if options.ScheduledFor != nil && options.StopAt != nil && !options.StopAt.After(*options.ScheduledFor) {
+		return nil, errors.New("campaign must stop after it's scheduled")
+	}
+
+	return c.campaignRequest(http.MethodPost, id, "start", options)
+}
+
+// StopCampaign stops the running campaign with given ID, already sent messages are kept
+func (c *Client) StopCampaign(id string) (*Campaign, error) {
+	return c.campaignRequest(http.MethodPost, id, "stop", nil)
+}
+
+type testCampaignRequest struct {
+	UserIDs []string `json:"user_ids"`
+}
+
+// TestCampaign sends the campaign message with given ID only to the users with given IDs, ie to QA
+// a draft campaign before starting it. Test sends don't change the status and stats of the campaign.
+func (c *Client) TestCampaign(id string, userIDs []string) (*Campaign, error) {
+	if len(userIDs) == 0 {
+		return nil, errors.New("user IDs are empty")
+	}
+
+	return c.campaignRequest(http.MethodPost, id, "test", testCampaignRequest{UserIDs: userIDs})
+}
+
+func (c *Client) campaignRequest(method, id, action string, data interface{}) (*Campaign, error) {
+	if id == "" {
+		return nil, errors.New("campaign ID is empty")
+	}
+
+	p := path.Join("campaigns", url.PathEscape(id), action)
+
+	var resp campaignResponse
+
+	err := c.makeRequest(method, p, nil, data, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Campaign, nil
+}
+
+// QueryCampaigns returns campaigns matching the request
+func (c *Client) QueryCampaigns(req QueryCampaignsRequest) (*QueryCampaignsResponse, error) {
+	if req.Filter == nil {
+		req.Filter = map[string]interface{}{}
+	}
+
+	var resp QueryCampaignsResponse
+
+	err := c.makeRequest(http.MethodPost, "campaigns/query", nil, req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
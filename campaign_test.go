@@ -0,0 +1,86 @@
+package stream_chat // nolint: golint
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Campaigns(t *testing.T) {
+	c := initClient(t)
+
+	sender := randomUser()
+	target := randomUser()
+
+	campaign, err := c.CreateCampaign(&Campaign{
+		Name:            randomString(10),
+		SenderID:        sender.ID,
+		UserIDs:         []string{target.ID},
+		MessageTemplate: &CampaignMessageTemplate{Text: "hello {{ receiver.name }}"},
+		ChannelTemplate: &CampaignChannelTemplate{Type: "messaging"},
+		CreateChannels:  true,
+	})
+	mustNoError(t, err, "create campaign")
+	assert.Equal(t, CampaignStatusDraft, campaign.Status)
+
+	got, err := c.GetCampaign(campaign.ID)
+	mustNoError(t, err, "get campaign")
+	assert.Equal(t, campaign.Name, got.Name)
+
+	resp, err := c.QueryCampaigns(QueryCampaignsRequest{
+		Filter: map[string]interface{}{"id": campaign.ID},
+	})
+	mustNoError(t, err, "query campaigns")
+	if assert.Len(t, resp.Campaigns, 1) {
+		assert.Equal(t, campaign.ID, resp.Campaigns[0].ID)
+	}
+
+	_, err = c.StartCampaign(campaign.ID)
+	mustNoError(t, err, "start campaign")
+
+	_, err = c.StopCampaign(campaign.ID)
+	mustNoError(t, err, "stop campaign")
+
+	_, err = c.CreateCampaign(&Campaign{SenderID: sender.ID})
+	mustError(t, err, "campaign without template")
+}
+
+func TestClient_CampaignTestAndSchedule(t *testing.T) {
+	c := initClient(t)
+
+	sender := randomUser()
+	target := randomUser()
+	tester := randomUser()
+
+	campaign, err := c.CreateCampaign(&Campaign{
+		Name:            randomString(10),
+		SenderID:        sender.ID,
+		UserIDs:         []string{target.ID},
+		MessageTemplate: &CampaignMessageTemplate{Text: "hello {{ receiver.name }}"},
+		ChannelTemplate: &CampaignChannelTemplate{Type: "messaging"},
+		CreateChannels:  true,
+	})
+	mustNoError(t, err, "create campaign")
+
+	tested, err := c.TestCampaign(campaign.ID, []string{tester.ID})
+	mustNoError(t, err, "test campaign")
+	assert.Equal(t, CampaignStatusDraft, tested.Status, "test sends keep the draft")
+
+	scheduledFor := time.Now().Add(time.Hour).UTC()
+	stopAt := scheduledFor.Add(time.Hour)
+
+	_, err = c.StartCampaignWithOptions(campaign.ID, StartCampaignOptions{ScheduledFor: &stopAt, StopAt: &scheduledFor})
+	mustError(t, err, "stop before schedule")
+
+	scheduled, err := c.StartCampaignWithOptions(campaign.ID, StartCampaignOptions{ScheduledFor: &scheduledFor, StopAt: &stopAt})
+	mustNoError(t, err, "schedule campaign")
+	assert.Equal(t, CampaignStatusScheduled, scheduled.Status)
+
+	stats, err := c.GetCampaignStats(campaign.ID)
+	mustNoError(t, err, "get campaign stats")
+	assert.Zero(t, stats.MessagesSent, "scheduled campaign isn't sent yet")
+
+	_, err = c.StopCampaign(campaign.ID)
+	mustNoError(t, err, "stop campaign")
+}
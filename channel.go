@@ -1,17 +1,34 @@
 package stream_chat //nolint: golint
 
 import (
+	"context"
 	"errors"
 	"io"
 	"net/http"
 	"net/url"
 	"path"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
 	"time"
 )
 
+// ChannelRead is the read state of a channel member
 type ChannelRead struct {
-	User     *User     `json:"user"`
-	LastRead time.Time `json:"last_read"`
+	User              *User     `json:"user"`
+	LastRead          time.Time `json:"last_read"`
+	LastReadMessageID string    `json:"last_read_message_id,omitempty"`
+	UnreadMessages    int       `json:"unread_messages"`
+}
+
+// HasRead reports whether the message is read, ie for read receipts
+func (r *ChannelRead) HasRead(msg *Message) bool {
+	if msg.CreatedAt == nil {
+		return false
+	}
+
+	return !r.LastRead.Before(*msg.CreatedAt)
 }
 
 type ChannelMember struct {
@@ -23,9 +40,15 @@ type ChannelMember struct {
 	InviteAcceptedAt *time.Time `json:"invite_accepted_at,omitempty"`
 	InviteRejectedAt *time.Time `json:"invite_rejected_at,omitempty"`
 	Role             string     `json:"role,omitempty"`
+	ChannelRole      string     `json:"channel_role,omitempty"` // role of the member in the channel, ie "channel_moderator"
+
+	PinnedAt   *time.Time `json:"pinned_at,omitempty"`   // set when the member pinned the channel
+	ArchivedAt *time.Time `json:"archived_at,omitempty"` // set when the member archived the channel
 
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+
+	ExtraData map[string]interface{} `json:"-,extra"` //nolint: staticcheck
 }
 
 type Channel struct {
@@ -35,20 +58,114 @@ type Channel struct {
 
 	Config ChannelConfig `json:"config"`
 
-	CreatedBy *User `json:"created_by"`
-	Frozen    bool  `json:"frozen"`
+	CreatedBy *User  `json:"created_by"`
+	Frozen    bool   `json:"frozen"`
+	Team      string `json:"team,omitempty"` // team of the channel in multi-tenant apps
+
+	AutoTranslationEnabled  bool   `json:"auto_translation_enabled,omitempty"`
+	AutoTranslationLanguage string `json:"auto_translation_language,omitempty"`
 
 	MemberCount int              `json:"member_count"`
 	Members     []*ChannelMember `json:"members"`
 
+	WatcherCount int     `json:"watcher_count,omitempty"` // number of users watching the channel, set by QueryWatchers
+	Watchers     []*User `json:"watchers,omitempty"`      // users watching the channel, set by QueryWatchers
+
 	Messages []*Message     `json:"messages"`
 	Read     []*ChannelRead `json:"read"`
 
-	CreatedAt     time.Time `json:"created_at"`
-	UpdatedAt     time.Time `json:"updated_at"`
-	LastMessageAt time.Time `json:"last_message_at"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+	LastMessageAt time.Time  `json:"last_message_at"`
+	DeletedAt     *time.Time `json:"deleted_at,omitempty"` // set for soft deleted channels
+
+	// custom fields of the channel, ie name and image.
+	// Numbers are decoded as float64, integers beyond 2^53 lose precision; store them as strings.
+	ExtraData map[string]interface{} `json:"-,extra"` //nolint: staticcheck
 
 	client *Client
+	mu     *sync.RWMutex // guards channel state, see stateLock
+}
+
+// channelLocksMu guards the allocation of channel state locks
+// nolint: gochecknoglobals
+var channelLocksMu sync.Mutex
+
+// stateLock returns the lock of the channel state. It's allocated on first use, so channels
+// built as struct literals are guarded too. The lock isn't a value field since the generated
+// JSON marshalers take channels by value.
+func (ch *Channel) stateLock() *sync.RWMutex {
+	channelLocksMu.Lock()
+	defer channelLocksMu.Unlock()
+
+	if ch.mu == nil {
+		ch.mu = &sync.RWMutex{}
+	}
+
+	return ch.mu
+}
+
+// channelStateSkipped are the exported fields setState doesn't copy: identity is set once
+// and watchers are only set by QueryWatchers
+// nolint: gochecknoglobals
+var channelStateSkipped = map[string]bool{
+	"ID": true, "Type": true, "CID": true,
+	"WatcherCount": true, "Watchers": true,
+}
+
+// setState copies all exported fields of src to the channel except channelStateSkipped ones,
+// so new fields are copied without listing them. Identity fields aren't written at all,
+// since they are read without the state lock.
+func (ch *Channel) setState(src *Channel) {
+	dst, from := reflect.ValueOf(ch).Elem(), reflect.ValueOf(src).Elem()
+
+	for i := 0; i < dst.NumField(); i++ {
+		field := dst.Type().Field(i)
+		if field.PkgPath != "" || channelStateSkipped[field.Name] {
+			continue
+		}
+
+		dst.Field(i).Set(from.Field(i))
+	}
+}
+
+// Snapshot returns a copy of the channel with its current state,
+// use it to read the state of a channel shared between goroutines
+func (ch *Channel) Snapshot() *Channel {
+	mu := ch.stateLock()
+	mu.RLock()
+	defer mu.RUnlock()
+
+	snapshot := &Channel{
+		ID:     ch.ID,
+		Type:   ch.Type,
+		CID:    ch.CID,
+		client: ch.client,
+	}
+
+	snapshot.setState(ch)
+	snapshot.Members = append([]*ChannelMember(nil), ch.Members...)
+	snapshot.WatcherCount = ch.WatcherCount
+	snapshot.Watchers = append([]*User(nil), ch.Watchers...)
+	snapshot.Messages = append([]*Message(nil), ch.Messages...)
+	snapshot.Read = append([]*ChannelRead(nil), ch.Read...)
+
+	return snapshot
+}
+
+// ReadState returns the read state of the user with given ID, nil if unknown
+func (ch *Channel) ReadState(userID string) *ChannelRead {
+	mu := ch.stateLock()
+	mu.RLock()
+	defer mu.RUnlock()
+
+	for _, read := range ch.Read {
+		if read.User != nil && read.User.ID == userID {
+			return read
+		}
+	}
+
+	return nil
 }
 
 type queryResponse struct {
@@ -56,14 +173,26 @@ type queryResponse struct {
 	Messages []*Message       `json:"messages,omitempty"`
 	Members  []*ChannelMember `json:"members,omitempty"`
 	Read     []*ChannelRead   `json:"read,omitempty"`
+
+	WatcherCount int     `json:"watcher_count,omitempty"`
+	Watchers     []*User `json:"watchers,omitempty"`
 }
 
 func (q queryResponse) updateChannel(ch *Channel) {
+	mu := ch.stateLock()
+	mu.Lock()
+	defer mu.Unlock()
+
 	if q.Channel != nil {
-		// save client pointer but update channel information
-		client := ch.client
-		*ch = *q.Channel
-		ch.client = client
+		// identity is set only once, ie ID of distinct channel is known after creation
+		if ch.ID == "" {
+			ch.ID = q.Channel.ID
+		}
+		if ch.CID == "" {
+			ch.CID = q.Channel.CID
+		}
+
+		ch.setState(q.Channel)
 	}
 
 	if q.Members != nil {
@@ -77,34 +206,70 @@ func (q queryResponse) updateChannel(ch *Channel) {
 	}
 }
 
-// query makes request to channel api and updates channel internal state
-func (ch *Channel) query(options, data map[string]interface{}) (err error) {
-	payload := map[string]interface{}{
-		"state": true,
-	}
+// PaginationParams are offset based pagination options, zero values use server defaults
+type PaginationParams struct {
+	Limit  int `json:"limit,omitempty"`
+	Offset int `json:"offset,omitempty"`
+}
 
-	for k, v := range options {
-		payload[k] = v
-	}
+// MessagePaginationParams select the page of channel messages by message ID or creation time
+type MessagePaginationParams struct {
+	Limit int `json:"limit,omitempty"`
+
+	IDLT  string `json:"id_lt,omitempty"`  // messages before the message with this ID
+	IDLTE string `json:"id_lte,omitempty"` // messages before and including the message with this ID
+	IDGT  string `json:"id_gt,omitempty"`  // messages after the message with this ID
+	IDGTE string `json:"id_gte,omitempty"` // messages after and including the message with this ID
+
+	CreatedAtBefore        *time.Time `json:"created_at_before,omitempty"`
+	CreatedAtBeforeOrEqual *time.Time `json:"created_at_before_or_equal,omitempty"`
+	CreatedAtAfter         *time.Time `json:"created_at_after,omitempty"`
+	CreatedAtAfterOrEqual  *time.Time `json:"created_at_after_or_equal,omitempty"`
+	CreatedAtAround        *time.Time `json:"created_at_around,omitempty"`
+	IDAround               string     `json:"id_around,omitempty"`
+}
 
-	if data == nil {
-		data = map[string]interface{}{}
-	}
+// ChannelQueryRequest selects the channel state returned by QueryWithOptions
+type ChannelQueryRequest struct {
+	State    bool `json:"state"`    // return messages, members and reads
+	Presence bool `json:"presence"` // return watchers and online presence of members
+
+	Messages *MessagePaginationParams `json:"messages,omitempty"`
+	Members  *PaginationParams        `json:"members,omitempty"`
+	Watchers *PaginationParams        `json:"watchers,omitempty"`
+
+	// channel data to set when the channel is created by the query
+	Data map[string]interface{} `json:"data"`
+}
 
-	payload["data"] = data
+// query makes request to channel api and updates channel internal state
+func (ch *Channel) query(req *ChannelQueryRequest) error {
+	_, err := ch.queryResponse(context.Background(), req)
+	return err
+}
+
+func (ch *Channel) queryResponse(ctx context.Context, req *ChannelQueryRequest) (*queryResponse, error) {
+	if req.Data == nil {
+		req.Data = map[string]interface{}{}
+	}
 
 	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID), "query")
 
+	r, err := ch.client.newRequest(http.MethodPost, p, nil, req)
+	if err != nil {
+		return nil, err
+	}
+
 	var resp queryResponse
 
-	err = ch.client.makeRequest(http.MethodPost, p, nil, payload, &resp)
+	err = ch.client.doRequest(r.WithContext(ctx), &resp)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	resp.updateChannel(ch)
 
-	return nil
+	return &resp, nil
 }
 
 // Update edits the channel's custom properties
@@ -125,18 +290,154 @@ func (ch *Channel) Update(options map[string]interface{}, message *Message) erro
 	return ch.client.makeRequest(http.MethodPost, p, nil, payload, nil)
 }
 
-// Delete removes the channel. Messages are permanently removed.
+type channelPartialUpdateRequest struct {
+	Set   map[string]interface{} `json:"set,omitempty"`
+	Unset []string               `json:"unset,omitempty"`
+}
+
+func (ch *Channel) partialUpdate(set map[string]interface{}, unset []string) error {
+	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID))
+
+	req := channelPartialUpdateRequest{Set: set, Unset: unset}
+
+	var resp queryResponse
+
+	err := ch.client.makeRequest(http.MethodPatch, p, nil, req, &resp)
+	if err != nil {
+		return err
+	}
+
+	resp.updateChannel(ch)
+
+	return nil
+}
+
+// SetAutoTranslation enables or disables automatic translation of the channel messages
+// language: the language messages are translated to, ie "fr"; required if enabled
+func (ch *Channel) SetAutoTranslation(enabled bool, language string) error {
+	if enabled && language == "" {
+		return errors.New("auto translation language must be not empty")
+	}
+
+	set := map[string]interface{}{
+		"auto_translation_enabled": enabled,
+	}
+
+	if language != "" {
+		set["auto_translation_language"] = language
+	}
+
+	return ch.partialUpdate(set, nil)
+}
+
+// Freeze freezes the channel, members can't send new messages until it's unfrozen
+// message: optional message announcing the freeze, sent as message.User before freezing
+func (ch *Channel) Freeze(message *Message) error {
+	if err := ch.sendAnnouncement(message); err != nil {
+		return err
+	}
+
+	return ch.partialUpdate(map[string]interface{}{"frozen": true}, nil)
+}
+
+// Unfreeze unfreezes the channel
+// message: optional message announcing the unfreeze, sent as message.User after unfreezing
+func (ch *Channel) Unfreeze(message *Message) error {
+	if message != nil && message.User == nil {
+		return errors.New("message user is nil")
+	}
+
+	if err := ch.partialUpdate(map[string]interface{}{"frozen": false}, nil); err != nil {
+		return err
+	}
+
+	return ch.sendAnnouncement(message)
+}
+
+func (ch *Channel) sendAnnouncement(message *Message) error {
+	switch {
+	case message == nil:
+		return nil
+	case message.User == nil:
+		return errors.New("message user is nil")
+	}
+
+	_, err := ch.SendMessage(message, message.User.ID)
+
+	return err
+}
+
+// Delete soft deletes the channel, it's hidden from queries and its DeletedAt is set
+// but it can be restored. Use HardDelete to permanently remove the channel and its messages.
 func (ch *Channel) Delete() error {
+	return ch.delete(false)
+}
+
+// HardDelete permanently removes the channel and its messages
+func (ch *Channel) HardDelete() error {
+	return ch.delete(true)
+}
+
+func (ch *Channel) delete(hard bool) error {
 	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID))
 
-	return ch.client.makeRequest(http.MethodDelete, p, nil, nil, nil)
+	var params url.Values
+	if hard {
+		params = url.Values{"hard_delete": {"true"}}
+	}
+
+	return ch.client.makeRequest(http.MethodDelete, p, params, nil, nil)
 }
 
 // Truncate removes all messages from the channel
 func (ch *Channel) Truncate() error {
+	return ch.TruncateWithOptions(TruncateOptions{})
+}
+
+// TruncateOptions are the options of channel truncation
+type TruncateOptions struct {
+	HardDelete  bool       // permanently delete messages instead of soft deleting them
+	SkipPush    bool       // don't send push notification of the system message
+	TruncatedAt *time.Time // only remove messages created before this time; nil removes all messages
+
+	// optional system message sent after truncation, sent as UserID if message user is nil
+	Message *Message
+	UserID  string // user truncating the channel
+}
+
+type truncateRequest struct {
+	HardDelete  bool                   `json:"hard_delete,omitempty"`
+	SkipPush    bool                   `json:"skip_push,omitempty"`
+	TruncatedAt *time.Time             `json:"truncated_at,omitempty"`
+	Message     *messageRequestMessage `json:"message,omitempty"`
+	UserID      string                 `json:"user_id,omitempty"`
+}
+
+// TruncateWithOptions removes messages from the channel
+func (ch *Channel) TruncateWithOptions(options TruncateOptions) error {
+	req := truncateRequest{
+		HardDelete:  options.HardDelete,
+		SkipPush:    options.SkipPush,
+		TruncatedAt: options.TruncatedAt,
+		UserID:      options.UserID,
+	}
+
+	if options.Message != nil {
+		msg := *options.Message
+		if msg.User == nil {
+			if options.UserID == "" {
+				return errors.New("truncate message user is empty")
+			}
+			msg.User = &User{ID: options.UserID}
+		}
+
+		m := msg.toRequest().Message
+		req.Message = &m
+	}
+
 	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID), "truncate")
 
-	return ch.client.makeRequest(http.MethodPost, p, nil, nil, nil)
+	return ch.client.makeRequest(http.MethodPost, p, nil, req, nil)
 }
 
 // AddMembers adds members with given user IDs to the channel
@@ -214,6 +515,43 @@ func (ch *Channel) addModerators(userIDs []string, msg *Message) error {
 	return ch.client.makeRequest(http.MethodPost, p, nil, data, nil)
 }
 
+// RoleAssignment grants the channel role to the member with given user ID
+type RoleAssignment struct {
+	UserID      string `json:"user_id"`
+	ChannelRole string `json:"channel_role"` // built-in, ie "channel_member", or custom role, ie "vip"
+}
+
+// AssignRoles assigns channel roles to members of the channel
+// msg: optional system message
+func (ch *Channel) AssignRoles(assignments []*RoleAssignment, msg *Message) error {
+	if len(assignments) == 0 {
+		return errors.New("role assignments are empty")
+	}
+
+	for _, a := range assignments {
+		switch {
+		case a == nil:
+			return errors.New("role assignment is nil")
+		case a.UserID == "":
+			return errors.New("role assignment user ID is empty")
+		case a.ChannelRole == "":
+			return errors.New("role assignment channel role is empty")
+		}
+	}
+
+	data := map[string]interface{}{
+		"assign_roles": assignments,
+	}
+
+	if msg != nil {
+		data["message"] = msg
+	}
+
+	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID))
+
+	return ch.client.makeRequest(http.MethodPost, p, nil, data, nil)
+}
+
 // InviteMembers invites users with given IDs to the channel
 func (ch *Channel) InviteMembers(userIDs ...string) error {
 	return ch.inviteMembers(userIDs, nil)
@@ -289,6 +627,60 @@ func (ch *Channel) MarkRead(userID string, options map[string]interface{}) error
 	return ch.client.makeRequest(http.MethodPost, p, nil, options, nil)
 }
 
+// MarkReadOptions are the options of a mark read, zero values mark the whole channel read
+type MarkReadOptions struct {
+	MessageID string // last read message, messages after it stay unread
+	ThreadID  string // parent message ID to mark a thread read instead of the channel
+}
+
+type markReadRequest struct {
+	UserID    string `json:"user_id"`
+	MessageID string `json:"message_id,omitempty"`
+	ThreadID  string `json:"thread_id,omitempty"`
+}
+
+// MarkReadWithOptions marks the channel or a thread of it read for user with given ID
+func (ch *Channel) MarkReadWithOptions(userID string, options MarkReadOptions) error {
+	switch {
+	case userID == "":
+		return errors.New("user ID is empty")
+	case options.MessageID != "" && options.ThreadID != "":
+		return errors.New("mark read by both message ID and thread ID is not allowed")
+	}
+
+	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID), "read")
+
+	req := markReadRequest{
+		UserID:    userID,
+		MessageID: options.MessageID,
+		ThreadID:  options.ThreadID,
+	}
+
+	return ch.client.makeRequest(http.MethodPost, p, nil, req, nil)
+}
+
+type markUnreadRequest struct {
+	UserID    string `json:"user_id"`
+	MessageID string `json:"message_id"`
+}
+
+// MarkUnread marks the channel unread for user with given ID,
+// the message with given ID and all messages after it become unread
+func (ch *Channel) MarkUnread(userID, messageID string) error {
+	switch {
+	case userID == "":
+		return errors.New("user ID must be not empty")
+	case messageID == "":
+		return errors.New("message ID must be not empty")
+	}
+
+	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID), "unread")
+
+	req := markUnreadRequest{UserID: userID, MessageID: messageID}
+
+	return ch.client.makeRequest(http.MethodPost, p, nil, req, nil)
+}
+
 // BanUser bans target user ID from this channel
 // userID: user who bans target
 // options: additional ban options, ie {"timeout": 3600, "reason": "offensive language is not allowed here"}
@@ -308,6 +700,19 @@ func (ch *Channel) BanUser(targetID, userID string, options map[string]interface
 	return ch.client.BanUser(targetID, userID, options)
 }
 
+// BanUserWithOptions bans target user ID on this channel, userID is the user creating the ban
+func (ch *Channel) BanUserWithOptions(targetID, userID string, options BanOptions) error {
+	req, err := newBanRequest(targetID, userID, options)
+	if err != nil {
+		return err
+	}
+
+	req.Type = ch.Type
+	req.ID = ch.ID
+
+	return ch.client.makeRequest(http.MethodPost, "moderation/ban", nil, req, nil)
+}
+
 // UnBanUser removes the ban for target user ID on this channel
 func (ch *Channel) UnBanUser(targetID string, options map[string]string) error {
 	switch {
@@ -325,17 +730,95 @@ func (ch *Channel) UnBanUser(targetID string, options map[string]string) error {
 
 // Query fills channel info without state (messages, members, reads)
 func (ch *Channel) Query(data map[string]interface{}) error {
-	options := map[string]interface{}{
-		"watch":    false,
-		"state":    false,
-		"presence": false,
+	return ch.query(&ChannelQueryRequest{Data: data})
+}
+
+// QueryWithOptions fills channel info and the selected pages of the channel state, ie older messages:
+//  err := ch.QueryWithOptions(&ChannelQueryRequest{
+//    State:    true,
+//    Messages: &MessagePaginationParams{Limit: 100, IDLT: ch.Messages[0].ID},
+//  })
+func (ch *Channel) QueryWithOptions(req *ChannelQueryRequest) error {
+	if req == nil {
+		return errors.New("query request is nil")
+	}
+
+	if m := req.Messages; m != nil {
+		switch {
+		case m.Limit < 0:
+			return errors.New("messages limit must be not negative")
+		case m.IDLT != "" && m.IDLTE != "", m.IDGT != "" && m.IDGTE != "":
+			return errors.New("messages pagination by both inclusive and exclusive ID is not allowed")
+		}
+	}
+
+	for _, p := range []*PaginationParams{req.Members, req.Watchers} {
+		if p != nil && (p.Limit < 0 || p.Offset < 0) {
+			return errors.New("limit and offset must be not negative")
+		}
+	}
+
+	r := *req
+
+	return ch.query(&r)
+}
+
+// QueryWatchers returns users watching the channel with their online presence,
+// WatcherCount and Watchers of the channel are updated
+func (ch *Channel) QueryWatchers(limit, offset int) ([]*User, error) {
+	if limit <= 0 {
+		limit = defaultPageLimit
+	}
+
+	req := &ChannelQueryRequest{
+		State:    true,
+		Presence: true,
+		Watchers: &PaginationParams{Limit: limit, Offset: offset},
+	}
+
+	resp, err := ch.queryResponse(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+
+	// watchers are only in responses of presence queries, so they are updated here
+	mu := ch.stateLock()
+	mu.Lock()
+	defer mu.Unlock()
+
+	ch.WatcherCount = resp.WatcherCount
+	ch.Watchers = resp.Watchers
+
+	return ch.Watchers, nil
+}
+
+type stopWatchingRequest struct {
+	UserID string `json:"user_id"`
+}
+
+// StopWatching stops the watch of the channel by the websocket connection with given ID of the user,
+// ie a connection opened by a backend service which doesn't need the channel events anymore
+func (ch *Channel) StopWatching(userID, connectionID string) error {
+	switch {
+	case userID == "":
+		return errors.New("user ID is empty")
+	case connectionID == "":
+		return errors.New("connection ID is empty")
 	}
 
-	return ch.query(options, data)
+	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID), "stop-watching")
+
+	params := url.Values{"connection_id": {connectionID}}
+
+	return ch.client.makeRequest(http.MethodPost, p, params, stopWatchingRequest{UserID: userID}, nil)
 }
 
 // Show makes channel visible for userID
 func (ch *Channel) Show(userID string) error {
+	if userID == "" {
+		return errors.New("user ID must be not empty")
+	}
+
 	data := map[string]interface{}{
 		"user_id": userID,
 	}
@@ -356,6 +839,10 @@ func (ch *Channel) HideWithHistoryClear(userID string) error {
 }
 
 func (ch *Channel) hide(userID string, clearHistory bool) error {
+	if userID == "" {
+		return errors.New("user ID must be not empty")
+	}
+
 	data := map[string]interface{}{
 		"user_id":       userID,
 		"clear_history": clearHistory,
@@ -366,7 +853,233 @@ func (ch *Channel) hide(userID string, clearHistory bool) error {
 	return ch.client.makeRequest(http.MethodPost, p, nil, data, nil)
 }
 
+type channelMemberResponse struct {
+	ChannelMember *ChannelMember `json:"channel_member"`
+}
+
+// PartialUpdateMember sets and unsets fields of the member with given user ID, ie custom fields like "nickname"
+// which are stored on the member instead of the user. Custom fields are in ExtraData of the returned member.
+func (ch *Channel) PartialUpdateMember(userID string, set map[string]interface{}, unset []string) (*ChannelMember, error) {
+	if len(set) == 0 && len(unset) == 0 {
+		return nil, errors.New("set and unset are empty")
+	}
+
+	return ch.updateMember(userID, set, unset)
+}
+
+// Pin pins the channel for the member with given user ID, ie to list it on top
+func (ch *Channel) Pin(userID string) (*ChannelMember, error) {
+	return ch.updateMember(userID, map[string]interface{}{"pinned": true}, nil)
+}
+
+// Unpin unpins the channel for the member with given user ID
+func (ch *Channel) Unpin(userID string) (*ChannelMember, error) {
+	return ch.updateMember(userID, map[string]interface{}{"pinned": false}, nil)
+}
+
+// Archive archives the channel for the member with given user ID, ie to hide it from the inbox
+// without leaving it
+func (ch *Channel) Archive(userID string) (*ChannelMember, error) {
+	return ch.updateMember(userID, map[string]interface{}{"archived": true}, nil)
+}
+
+// Unarchive unarchives the channel for the member with given user ID
+func (ch *Channel) Unarchive(userID string) (*ChannelMember, error) {
+	return ch.updateMember(userID, map[string]interface{}{"archived": false}, nil)
+}
+
+func (ch *Channel) updateMember(userID string, set map[string]interface{}, unset []string) (*ChannelMember, error) {
+	if userID == "" {
+		return nil, errors.New("user ID must be not empty")
+	}
+
+	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID), "member")
+
+	params := url.Values{"user_id": {userID}}
+
+	var resp channelMemberResponse
+
+	err := ch.client.makeRequest(http.MethodPatch, p, params, channelPartialUpdateRequest{Set: set, Unset: unset}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.ChannelMember, nil
+}
+
+type ChannelMute struct {
+	User      User       `json:"user"`
+	Channel   Channel    `json:"channel"`
+	Expires   *time.Time `json:"expires"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+type channelMuteResponse struct {
+	ChannelMute *ChannelMute `json:"channel_mute"`
+}
+
+// Mute mutes the channel for userID. Muted channels don't trigger push notifications for the user.
+// expiration: optional duration of the mute, mute is permanent if nil
+func (ch *Channel) Mute(userID string, expiration *time.Duration) (*ChannelMute, error) {
+	if userID == "" {
+		return nil, errors.New("user ID must be not empty")
+	}
+
+	data := map[string]interface{}{
+		"user_id":     userID,
+		"channel_cid": ch.cid(),
+	}
+
+	if expiration != nil {
+		data["expiration"] = int64(*expiration / time.Millisecond)
+	}
+
+	var resp channelMuteResponse
+
+	err := ch.client.makeRequest(http.MethodPost, "moderation/mute/channel", nil, data, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.ChannelMute, nil
+}
+
+// Unmute removes the channel mute for userID
+func (ch *Channel) Unmute(userID string) error {
+	if userID == "" {
+		return errors.New("user ID must be not empty")
+	}
+
+	data := map[string]interface{}{
+		"user_id":     userID,
+		"channel_cid": ch.cid(),
+	}
+
+	return ch.client.makeRequest(http.MethodPost, "moderation/unmute/channel", nil, data, nil)
+}
+
+func (ch *Channel) cid() string {
+	if ch.CID != "" {
+		return ch.CID
+	}
+
+	return ch.Type + ":" + ch.ID
+}
+
+// nolint: gochecknoglobals
+var (
+	channelTypeRe = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+	channelIDRe   = regexp.MustCompile(`^[a-zA-Z0-9@!_-]+$`)
+)
+
+// ParseChannelCID returns the type and ID of the channel with given CID, ie "messaging:general".
+// The type can contain letters, digits, "_" and "-", the ID can also contain "@" and "!".
+func ParseChannelCID(cid string) (chanType, chanID string, err error) {
+	parts := strings.Split(cid, ":")
+
+	switch {
+	case len(parts) != 2:
+		return "", "", errors.New("channel CID must be in format type:ID, got " + cid)
+	case parts[0] == "":
+		return "", "", errors.New("channel CID type is empty")
+	case parts[1] == "":
+		return "", "", errors.New("channel CID ID is empty")
+	case !channelTypeRe.MatchString(parts[0]):
+		return "", "", errors.New("channel CID type contains invalid characters: " + parts[0])
+	case !channelIDRe.MatchString(parts[1]):
+		return "", "", errors.New("channel CID ID contains invalid characters: " + parts[1])
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// ChannelByCID returns the channel with given CID without querying it, ie a channel referenced in a webhook event.
+// Use Refresh to load the channel state.
+func (c *Client) ChannelByCID(cid string) (*Channel, error) {
+	chanType, chanID, err := ParseChannelCID(cid)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Channel{
+		Type:   chanType,
+		ID:     chanID,
+		CID:    cid,
+		client: c,
+	}, nil
+}
+
+// CreateDistinctChannel returns the distinct channel of the members, ie a direct message channel,
+// creating it if it doesn't exist. Distinct channels have no ID set by client, the server generates it from members.
+// data: optional channel data, members are overwritten
+func (c *Client) CreateDistinctChannel(chanType string, members []string, createdBy string, data map[string]interface{}) (*Channel, error) {
+	if len(members) == 0 {
+		return nil, errors.New("distinct channel members are empty")
+	}
+
+	d := make(map[string]interface{}, len(data)+1)
+	for k, v := range data {
+		d[k] = v
+	}
+	d["members"] = members
+
+	return c.CreateChannel(chanType, "", createdBy, d)
+}
+
+// CreateChannelOptions is the initial data of a channel created by CreateChannelWithOptions
+type CreateChannelOptions struct {
+	Members []string // user IDs of the members; identify a distinct channel if channel ID is empty
+	Team    string   // team of the channel in multi-tenant apps
+
+	// custom fields of the channel, ie {"name": "General"}
+	ExtraData map[string]interface{}
+}
+
+func (o CreateChannelOptions) data() (map[string]interface{}, error) {
+	data := make(map[string]interface{}, len(o.ExtraData)+2)
+
+	for k, v := range o.ExtraData {
+		switch k {
+		case "members", "team", "created_by", "created_by_id":
+			return nil, errors.New("channel extra data can't contain reserved field " + k)
+		}
+		data[k] = v
+	}
+
+	if len(o.Members) > 0 {
+		seen := make(map[string]bool, len(o.Members))
+		for _, id := range o.Members {
+			switch {
+			case id == "":
+				return nil, errors.New("channel member ID is empty")
+			case seen[id]:
+				return nil, errors.New("channel member " + id + " is duplicated")
+			}
+			seen[id] = true
+		}
+		data["members"] = o.Members
+	}
+
+	if o.Team != "" {
+		data["team"] = o.Team
+	}
+
+	return data, nil
+}
+
+// CreateChannelWithOptions creates new channel of given type and id with the initial data or returns already created one
+func (c *Client) CreateChannelWithOptions(chanType, chanID, userID string, options CreateChannelOptions) (*Channel, error) {
+	data, err := options.data()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.CreateChannel(chanType, chanID, userID, data)
+}
+
 // CreateChannel creates new channel of given type and id or returns already created one
+// data: optional channel data, ie {"members": []string{"bob"}, "team": "red"} in multi-tenant apps
 func (c *Client) CreateChannel(chanType, chanID, userID string, data map[string]interface{}) (*Channel, error) {
 	_, membersPresent := data["members"]
 
@@ -386,23 +1099,53 @@ func (c *Client) CreateChannel(chanType, chanID, userID string, data map[string]
 		CreatedBy: &User{ID: userID},
 	}
 
-	options := map[string]interface{}{
-		"watch":    false,
-		"state":    true,
-		"presence": false,
-	}
-
 	if data == nil {
 		data = make(map[string]interface{}, 1)
 	}
 
 	data["created_by"] = map[string]string{"id": userID}
 
-	err := ch.query(options, data)
+	err := ch.query(&ChannelQueryRequest{State: true, Data: data})
 
 	return ch, err
 }
 
+type deleteChannelsRequest struct {
+	CIDs       []string `json:"cids"`
+	HardDelete bool     `json:"hard_delete"`
+}
+
+// DeleteChannels starts an async deletion of the channels with given CIDs, returns the ID of the deletion task.
+// hardDelete: remove channels with their messages permanently
+func (c *Client) DeleteChannels(cids []string, hardDelete bool) (taskID string, err error) {
+	if len(cids) == 0 {
+		return "", errors.New("channel CIDs are empty")
+	}
+
+	req := deleteChannelsRequest{CIDs: cids, HardDelete: hardDelete}
+
+	var resp taskResponse
+
+	err = c.makeRequest(http.MethodPost, "channels/delete", nil, req, &resp)
+
+	return resp.TaskID, err
+}
+
+type restoreChannelsRequest struct {
+	CIDs []string `json:"cids"`
+}
+
+// RestoreChannels restores the soft deleted channels with given CIDs, hard deleted channels can't be restored
+func (c *Client) RestoreChannels(cids []string) error {
+	if len(cids) == 0 {
+		return errors.New("channel CIDs are empty")
+	}
+
+	req := restoreChannelsRequest{CIDs: cids}
+
+	return c.makeRequest(http.MethodPost, "channels/restore", nil, req, nil)
+}
+
 type SendFileRequest struct {
 	Reader io.Reader `json:"-"`
 	// name of the file would be stored
@@ -411,17 +1154,80 @@ type SendFileRequest struct {
 	User *User
 	// file content type, required for SendImage
 	ContentType string
+	// file size in bytes; optional, sets the Content-Length of the upload.
+	// The reader must provide exactly Size bytes when set.
+	Size int64
+	// max file size in bytes; optional, larger uploads fail with ErrFileTooLarge
+	MaxSize int64
+	// called with the number of bytes uploaded so far; optional
+	Progress func(uploaded int64) `json:"-"`
+	// resized variants of the image created by the server; optional, only for SendImage
+	UploadSizes []ImageSize
+}
+
+type ImageResize string
+
+const (
+	ImageResizeClip  ImageResize = "clip"
+	ImageResizeCrop  ImageResize = "crop"
+	ImageResizeScale ImageResize = "scale"
+	ImageResizeFill  ImageResize = "fill"
+)
+
+type ImageCrop string
+
+const (
+	ImageCropTop    ImageCrop = "top"
+	ImageCropBottom ImageCrop = "bottom"
+	ImageCropLeft   ImageCrop = "left"
+	ImageCropRight  ImageCrop = "right"
+	ImageCropCenter ImageCrop = "center"
+)
+
+// ImageSize is a resized variant of an uploaded image
+type ImageSize struct {
+	Width  int         `json:"width,omitempty"`
+	Height int         `json:"height,omitempty"`
+	Resize ImageResize `json:"resize,omitempty"`
+	Crop   ImageCrop   `json:"crop,omitempty"` // crop position, only for ImageResizeCrop
 }
 
 // SendFile sends file to the channel. Returns file url or error
+// The file is streamed from the request Reader, note that Client.HTTP timeout applies to the whole upload.
 func (ch *Channel) SendFile(request SendFileRequest) (string, error) {
+	if len(request.UploadSizes) > 0 {
+		return "", errors.New("upload sizes are only supported for images")
+	}
+
 	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID), "file")
 
 	return ch.client.sendFile(p, request)
 }
 
+// SendLargeFile sends file to the channel, retrying the upload up to retries times on network,
+// rate limit and server errors. Stream API doesn't support partial uploads, so every attempt re-sends
+// the whole file; the request Reader must implement io.Seeker (ie *os.File) to be rewound.
+// Client.HTTP timeout, 6s by default, applies to each attempt; use a client with a longer timeout
+// for large files, ie client.WithTimeout(10*time.Minute).
+// Returns file url or error
+func (ch *Channel) SendLargeFile(request SendFileRequest, retries int) (string, error) {
+	if len(request.UploadSizes) > 0 {
+		return "", errors.New("upload sizes are only supported for images")
+	}
+
+	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID), "file")
+
+	return ch.client.sendFileWithRetry(p, request, retries)
+}
+
 // SendFile sends image to the channel. Returns file url or error
 func (ch *Channel) SendImage(request SendFileRequest) (string, error) {
+	for _, size := range request.UploadSizes {
+		if size.Crop != "" && size.Resize != ImageResizeCrop {
+			return "", errors.New("image crop requires crop resize mode")
+		}
+	}
+
 	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID), "image")
 
 	return ch.client.sendFile(p, request)
@@ -485,14 +1291,14 @@ func (ch *Channel) RejectInvite(userID string, message *Message) error {
 	return ch.client.makeRequest(http.MethodPost, p, nil, data, nil)
 }
 
-//nolint: godox
-// todo: cleanup this
-func (ch *Channel) refresh() error {
-	options := map[string]interface{}{
-		"watch":    false,
-		"state":    true,
-		"presence": false,
-	}
+// Refresh reloads the channel state (members, messages, reads) from the server.
+// State updates are goroutine-safe, use Snapshot to read the state of a shared channel.
+func (ch *Channel) Refresh() error {
+	return ch.RefreshContext(context.Background())
+}
 
-	return ch.query(options, nil)
+// RefreshContext is Refresh with a context, cancelling it aborts the request and the state isn't updated
+func (ch *Channel) RefreshContext(ctx context.Context) error {
+	_, err := ch.queryResponse(ctx, &ChannelQueryRequest{State: true})
+	return err
 }
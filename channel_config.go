@@ -16,18 +16,38 @@ type ChannelConfig struct {
 	Reactions bool `json:"reactions"`
 	Replies   bool `json:"replies"`
 	Mutes     bool `json:"mutes"`
+	// enrich URLs in messages with open graph previews
+	URLEnrichment bool `json:"url_enrichment"`
 
 	// number of days to keep messages, must be MessageRetentionForever or numeric string
 	MessageRetention string `json:"message_retention"`
 	MaxMessageLength int    `json:"max_message_length"`
 
-	Automod     modType      `json:"automod"` // disabled, simple or AI
-	ModBehavior modBehaviour `json:"automod_behavior"`
+	Automod           modType      `json:"automod"` // disabled, simple or AI
+	ModBehavior       modBehaviour `json:"automod_behavior"`
+	AutomodThresholds *Thresholds  `json:"automod_thresholds,omitempty"` // AI automod only
+
+	Blocklist         string       `json:"blocklist,omitempty"` // name of the blocklist applied to messages
+	BlocklistBehavior modBehaviour `json:"blocklist_behavior,omitempty"`
+}
+
+// LabelThresholds are the AI automod scores, from 0 to 1, to flag or block a message with the label
+type LabelThresholds struct {
+	Flag  float32 `json:"flag"`
+	Block float32 `json:"block"`
+}
+
+// Thresholds are the AI automod thresholds per label, nil labels use the defaults
+type Thresholds struct {
+	Explicit *LabelThresholds `json:"explicit,omitempty"`
+	Spam     *LabelThresholds `json:"spam,omitempty"`
+	Toxic    *LabelThresholds `json:"toxic,omitempty"`
 }
 
 // DefaultChannelConfig is the default channel configuration
 // nolint: gochecknoglobals
 var DefaultChannelConfig = ChannelConfig{
+	URLEnrichment:    true,
 	Automod:          AutoModDisabled,
 	ModBehavior:      ModBehaviourFlag,
 	MaxMessageLength: defaultMessageLength,
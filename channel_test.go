@@ -1,9 +1,19 @@
 package stream_chat // nolint: golint
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -59,6 +69,28 @@ func TestClient_CreateChannel(t *testing.T) {
 	}
 }
 
+func TestClient_CreateDistinctChannel(t *testing.T) {
+	c := initClient(t)
+
+	members := []string{testUsers[0].ID, testUsers[1].ID}
+
+	ch, err := c.CreateDistinctChannel("messaging", members, serverUser.ID, map[string]interface{}{"color": "blue"})
+	mustNoError(t, err, "create distinct channel")
+	defer func() {
+		mustNoError(t, ch.Delete(), "delete channel")
+	}()
+
+	assert.NotEmpty(t, ch.ID, "channel ID is generated")
+	assert.Equal(t, "blue", ch.ExtraData["color"])
+
+	got, err := c.CreateDistinctChannel("messaging", []string{members[1], members[0]}, serverUser.ID, nil)
+	mustNoError(t, err, "get distinct channel")
+	assert.Equal(t, ch.ID, got.ID, "same members return the same channel")
+
+	_, err = c.CreateDistinctChannel("messaging", nil, serverUser.ID, nil)
+	mustError(t, err, "no members")
+}
+
 func TestChannel_AddMembers(t *testing.T) {
 	c := initClient(t)
 
@@ -81,7 +113,7 @@ func TestChannel_AddMembers(t *testing.T) {
 	mustNoError(t, err, "add members")
 
 	// refresh channel state
-	mustNoError(t, ch.refresh(), "refresh channel")
+	mustNoError(t, ch.Refresh(), "refresh channel")
 
 	assert.Equal(t, user.ID, ch.Members[0].User.ID, "members contain user id")
 }
@@ -105,7 +137,7 @@ func TestChannel_InviteMembers(t *testing.T) {
 	mustNoError(t, err, "invite members")
 
 	// refresh channel state
-	mustNoError(t, ch.refresh(), "refresh channel")
+	mustNoError(t, ch.Refresh(), "refresh channel")
 
 	assert.Equal(t, user.ID, ch.Members[0].User.ID, "members contain user id")
 	assert.Equal(t, true, ch.Members[0].Invited, "member is invited")
@@ -136,7 +168,7 @@ func TestChannel_Moderation(t *testing.T) {
 	mustNoError(t, err, "add moderators")
 
 	// refresh channel state
-	mustNoError(t, ch.refresh(), "refresh channel")
+	mustNoError(t, ch.Refresh(), "refresh channel")
 
 	assert.Equal(t, user.ID, ch.Members[0].User.ID, "user exists")
 	assert.Equal(t, "moderator", ch.Members[0].Role, "user role is moderator")
@@ -145,12 +177,35 @@ func TestChannel_Moderation(t *testing.T) {
 	mustNoError(t, err, "demote moderators")
 
 	// refresh channel state
-	mustNoError(t, ch.refresh(), "refresh channel")
+	mustNoError(t, ch.Refresh(), "refresh channel")
 
 	assert.Equal(t, user.ID, ch.Members[0].User.ID, "user exists")
 	assert.Equal(t, "member", ch.Members[0].Role, "user role is member")
 }
 
+func TestChannel_AssignRoles(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer func() {
+		mustNoError(t, ch.Delete(), "delete channel")
+	}()
+
+	member := testUsers[0].ID
+
+	err := ch.AssignRoles([]*RoleAssignment{{UserID: member, ChannelRole: "channel_moderator"}}, nil)
+	mustNoError(t, err, "assign roles")
+
+	mustNoError(t, ch.Refresh(), "refresh channel")
+
+	for _, m := range ch.Members {
+		if m.User.ID == member {
+			assert.Equal(t, "channel_moderator", m.ChannelRole, "member channel role")
+		}
+	}
+
+	mustError(t, ch.AssignRoles([]*RoleAssignment{{UserID: member}}, nil), "assignment without role")
+}
+
 func TestChannel_BanUser(t *testing.T) {
 	c := initClient(t)
 	ch := initChannel(t, c)
@@ -169,6 +224,9 @@ func TestChannel_BanUser(t *testing.T) {
 	})
 	mustNoError(t, err, "ban user")
 
+	err = ch.BanUserWithOptions(user.ID, serverUser.ID, BanOptions{Timeout: time.Hour, IPBan: true})
+	mustNoError(t, err, "ban user with options")
+
 	err = ch.UnBanUser(user.ID, nil)
 	mustNoError(t, err, "unban user")
 }
@@ -180,6 +238,77 @@ func TestChannel_Delete(t *testing.T) {
 	mustNoError(t, ch.Delete(), "delete channel")
 }
 
+func TestChannel_HardDelete(t *testing.T) {
+	var queries []string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/channels/messaging/general", r.URL.Path)
+		queries = append(queries, r.URL.Query().Get("hard_delete"))
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	c, err := NewClient("key", []byte("secret"), WithBaseURL(srv.URL))
+	mustNoError(t, err, "new client")
+
+	ch := &Channel{Type: "messaging", ID: "general", client: c}
+
+	mustNoError(t, ch.Delete(), "soft delete")
+	mustNoError(t, ch.HardDelete(), "hard delete")
+	assert.Equal(t, []string{"", "true"}, queries)
+}
+
+func TestChannel_StopWatching(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/channels/messaging/general/stop-watching", r.URL.Path)
+		assert.Equal(t, "conn-id", r.URL.Query().Get("connection_id"))
+
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"user_id":"bob"}`, string(b))
+
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	c, err := NewClient("key", []byte("secret"), WithBaseURL(srv.URL))
+	mustNoError(t, err, "new client")
+
+	ch := &Channel{Type: "messaging", ID: "general", client: c}
+
+	mustNoError(t, ch.StopWatching("bob", "conn-id"), "stop watching")
+	mustError(t, ch.StopWatching("bob", ""), "empty connection ID")
+}
+
+func TestClient_DeleteChannels(t *testing.T) {
+	c := initClient(t)
+	ch1, ch2 := initChannel(t, c), initChannel(t, c)
+
+	taskID, err := c.DeleteChannels([]string{ch1.CID, ch2.CID}, true)
+	mustNoError(t, err, "delete channels")
+
+	task, err := c.WaitForTask(taskID, time.Minute)
+	mustNoError(t, err, "wait for task")
+	assert.Equal(t, TaskStatusCompleted, task.Status, "channels deleted")
+}
+
+func TestClient_RestoreChannels(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer func() {
+		mustNoError(t, ch.HardDelete(), "hard delete channel")
+	}()
+
+	mustNoError(t, ch.Delete(), "soft delete channel")
+	mustNoError(t, c.RestoreChannels([]string{ch.CID}), "restore channels")
+
+	mustNoError(t, ch.Refresh(), "refresh channel")
+	assert.Nil(t, ch.DeletedAt, "channel is restored")
+
+	mustError(t, c.RestoreChannels(nil), "empty CIDs")
+}
+
 func TestChannel_GetReplies(t *testing.T) {
 	c := initClient(t)
 	ch := initChannel(t, c)
@@ -207,6 +336,52 @@ func TestChannel_MarkRead(t *testing.T) {
 
 }
 
+func TestChannel_MarkReadWithOptions(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer func() {
+		mustNoError(t, ch.Delete(), "delete channel")
+	}()
+
+	user := testUsers[0]
+
+	msg, err := ch.SendMessage(&Message{Text: "test message"}, serverUser.ID)
+	mustNoError(t, err, "send message")
+
+	mustNoError(t, ch.MarkReadWithOptions(user.ID, MarkReadOptions{MessageID: msg.ID}), "mark read")
+
+	err = ch.MarkReadWithOptions(user.ID, MarkReadOptions{MessageID: msg.ID, ThreadID: msg.ID})
+	mustError(t, err, "mark read by message and thread")
+
+	mustError(t, ch.MarkReadWithOptions("", MarkReadOptions{}), "mark read without user")
+}
+
+func TestChannel_MarkUnread(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer func() {
+		mustNoError(t, ch.Delete(), "delete channel")
+	}()
+
+	reader := testUsers[0]
+
+	msg, err := ch.SendMessage(&Message{Text: "test message"}, testUsers[1].ID)
+	mustNoError(t, err, "send message")
+
+	mustNoError(t, ch.MarkRead(reader.ID, nil), "mark read")
+
+	mustError(t, ch.MarkUnread(reader.ID, ""), "mark unread without message")
+	mustNoError(t, ch.MarkUnread(reader.ID, msg.ID), "mark unread")
+
+	mustNoError(t, ch.Refresh(), "refresh channel")
+
+	read := ch.ReadState(reader.ID)
+	if assert.NotNil(t, read, "read state") {
+		assert.False(t, read.HasRead(msg), "message is unread")
+		assert.Equal(t, 1, read.UnreadMessages, "unread messages")
+	}
+}
+
 func TestChannel_RemoveMembers(t *testing.T) {
 	c := initClient(t)
 	ch := initChannel(t, c)
@@ -228,7 +403,40 @@ func TestChannel_RemoveMembers(t *testing.T) {
 }
 
 func TestChannel_SendEvent(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer func() {
+		mustNoError(t, ch.Delete(), "delete channel")
+	}()
+
+	event := &Event{
+		Type:      EventType("order_shipped"),
+		ExtraData: map[string]interface{}{"order_id": "123"},
+	}
+
+	err := ch.SendEvent(event, serverUser.ID)
+	mustNoError(t, err, "send event")
 
+	mustError(t, ch.SendEvent(&Event{}, serverUser.ID), "send event without type")
+}
+
+func TestChannel_SendTyping(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer func() {
+		mustNoError(t, ch.Delete(), "delete channel")
+	}()
+
+	user := randomUser()
+
+	mustNoError(t, ch.SendTypingStart(user.ID, ""), "send typing start")
+	mustNoError(t, ch.SendTypingStop(user.ID, ""), "send typing stop")
+
+	msg, err := ch.SendMessage(&Message{Text: "test message"}, serverUser.ID)
+	mustNoError(t, err, "send message")
+
+	mustNoError(t, ch.SendTypingStart(user.ID, msg.ID), "send thread typing start")
+	mustNoError(t, ch.SendTypingStop(user.ID, msg.ID), "send thread typing stop")
 }
 
 func TestChannel_SendMessage(t *testing.T) {
@@ -251,6 +459,40 @@ func TestChannel_SendMessage(t *testing.T) {
 	assert.NotEmpty(t, msg.HTML, "message has HTML body")
 }
 
+func TestChannel_SendMessageWithOptions(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer func() {
+		mustNoError(t, ch.Delete(), "delete channel")
+	}()
+
+	user := randomUser()
+
+	quoted, err := ch.SendMessage(&Message{Text: "quoted message"}, user.ID)
+	mustNoError(t, err, "send message")
+
+	msg, err := ch.SendMessage(&Message{
+		Text:            "test message",
+		QuotedMessageID: quoted.ID,
+		Silent:          true,
+	}, serverUser.ID, MessageSkipPush())
+	mustNoError(t, err, "send message")
+
+	assert.Equal(t, quoted.ID, msg.QuotedMessageID, "quoted message ID")
+	if assert.NotNil(t, msg.QuotedMessage, "quoted message") {
+		assert.Equal(t, quoted.Text, msg.QuotedMessage.Text, "quoted message text")
+	}
+	assert.True(t, msg.Silent, "message is silent")
+
+	msg, err = ch.SendMessage(&Message{Text: "https://getstream.io"}, user.ID)
+	mustNoError(t, err, "send message with link")
+	assert.NotEmpty(t, msg.OGAttachments(), "link is enriched")
+
+	msg, err = ch.SendMessage(&Message{Text: "https://getstream.io"}, user.ID, MessageSkipEnrichURL())
+	mustNoError(t, err, "send message with link")
+	assert.Empty(t, msg.OGAttachments(), "link enrichment skipped")
+}
+
 func TestChannel_Truncate(t *testing.T) {
 	c := initClient(t)
 	ch := initChannel(t, c)
@@ -267,7 +509,7 @@ func TestChannel_Truncate(t *testing.T) {
 	mustNoError(t, err, "send message")
 
 	// refresh channel state
-	mustNoError(t, ch.refresh(), "refresh channel")
+	mustNoError(t, ch.Refresh(), "refresh channel")
 
 	assert.Equal(t, ch.Messages[0].ID, msg.ID, "message exists")
 
@@ -275,11 +517,160 @@ func TestChannel_Truncate(t *testing.T) {
 	mustNoError(t, err, "truncate channel")
 
 	// refresh channel state
-	mustNoError(t, ch.refresh(), "refresh channel")
+	mustNoError(t, ch.Refresh(), "refresh channel")
 
 	assert.Empty(t, ch.Messages, "message not exists")
 }
 
+func TestChannel_TruncateWithOptions(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer func() {
+		mustNoError(t, ch.Delete(), "delete channel")
+	}()
+
+	old, err := ch.SendMessage(&Message{Text: "old message"}, serverUser.ID)
+	mustNoError(t, err, "send message")
+
+	truncatedAt := old.CreatedAt.Add(time.Millisecond)
+
+	_, err = ch.SendMessage(&Message{Text: "new message", ID: randomString(12)}, serverUser.ID)
+	mustNoError(t, err, "send message")
+
+	err = ch.TruncateWithOptions(TruncateOptions{Message: &Message{Text: "truncated"}})
+	mustError(t, err, "message without user")
+
+	err = ch.TruncateWithOptions(TruncateOptions{
+		HardDelete:  true,
+		SkipPush:    true,
+		TruncatedAt: &truncatedAt,
+		Message:     &Message{Text: "channel history was cleared"},
+		UserID:      serverUser.ID,
+	})
+	mustNoError(t, err, "truncate channel")
+
+	mustNoError(t, ch.Refresh(), "refresh channel")
+
+	for _, msg := range ch.Messages {
+		assert.NotEqual(t, old.ID, msg.ID, "old message removed")
+	}
+}
+
+func TestChannel_QueryWithOptions(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer func() {
+		mustNoError(t, ch.Delete(), "delete channel")
+	}()
+
+	ids := make([]string, 0, 3)
+	for i := 0; i < 3; i++ {
+		msg, err := ch.SendMessage(&Message{Text: "test message"}, serverUser.ID)
+		mustNoError(t, err, "send message")
+		ids = append(ids, msg.ID)
+	}
+
+	err := ch.QueryWithOptions(&ChannelQueryRequest{
+		State:    true,
+		Messages: &MessagePaginationParams{Limit: 1, IDLT: ids[2]},
+		Members:  &PaginationParams{Limit: 1},
+	})
+	mustNoError(t, err, "query channel")
+
+	if assert.Len(t, ch.Messages, 1, "messages page") {
+		assert.Equal(t, ids[1], ch.Messages[0].ID, "message before the last one")
+	}
+	assert.Len(t, ch.Members, 1, "members page")
+
+	err = ch.QueryWithOptions(&ChannelQueryRequest{
+		Messages: &MessagePaginationParams{IDLT: ids[2], IDLTE: ids[2]},
+	})
+	mustError(t, err, "inclusive and exclusive pagination")
+}
+
+func TestChannel_QueryWatchers(t *testing.T) {
+	watchers := `[{"id":"bob","online":true}]`
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req), "decode request")
+		if req["presence"] != true {
+			_, _ = w.Write([]byte(`{"channel":{"id":"general","type":"messaging"}}`))
+			return
+		}
+		assert.Equal(t, map[string]interface{}{"limit": float64(10)}, req["watchers"])
+
+		if watchers == "" {
+			_, _ = w.Write([]byte(`{"channel":{"id":"general","type":"messaging"}}`))
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"channel":{"id":"general","type":"messaging"},"watcher_count":1,"watchers":%s}`, watchers)
+	}))
+	defer srv.Close()
+
+	c, err := NewClient("key", []byte("secret"), WithBaseURL(srv.URL))
+	mustNoError(t, err, "new client")
+
+	ch := &Channel{Type: "messaging", ID: "general", client: c}
+
+	got, err := ch.QueryWatchers(10, 0)
+	mustNoError(t, err, "query watchers")
+	if assert.Len(t, got, 1, "watchers") {
+		assert.Equal(t, "bob", got[0].ID)
+		assert.True(t, got[0].Online)
+	}
+	assert.Equal(t, 1, ch.WatcherCount)
+
+	// the last watcher left
+	watchers = ""
+
+	got, err = ch.QueryWatchers(10, 0)
+	mustNoError(t, err, "query watchers")
+	assert.Empty(t, got, "watchers")
+	assert.Empty(t, ch.Watchers, "watchers")
+	assert.Zero(t, ch.WatcherCount)
+
+	// responses without watchers don't change them
+	ch.WatcherCount = 3
+	mustNoError(t, ch.Refresh(), "refresh")
+	assert.Equal(t, 3, ch.WatcherCount, "watcher count is kept")
+}
+
+func TestChannel_RefreshContext(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"channel":{"id":"general","type":"messaging","member_count":2}}`))
+	}))
+	defer srv.Close()
+
+	c, err := NewClient("key", []byte("secret"), WithBaseURL(srv.URL))
+	mustNoError(t, err, "new client")
+
+	// a literal channel has no lock allocated yet
+	ch := &Channel{Type: "messaging", ID: "general", client: c}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, ch.RefreshContext(context.Background()), "refresh")
+		}()
+		go func() {
+			defer wg.Done()
+			_ = ch.Snapshot()
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 2, ch.Snapshot().MemberCount)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	ch = &Channel{Type: "messaging", ID: "general", client: c}
+	mustError(t, ch.RefreshContext(ctx), "cancelled refresh")
+	assert.Zero(t, ch.MemberCount, "state isn't updated")
+}
+
 func TestChannel_Update(t *testing.T) {
 	c := initClient(t)
 	ch := initChannel(t, c)
@@ -289,6 +680,46 @@ func TestChannel_Update(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestChannel_SetAutoTranslation(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer func() {
+		mustNoError(t, ch.Delete(), "delete channel")
+	}()
+
+	mustError(t, ch.SetAutoTranslation(true, ""), "language is required")
+
+	err := ch.SetAutoTranslation(true, "fr")
+	mustNoError(t, err, "enable auto translation")
+
+	assert.True(t, ch.AutoTranslationEnabled, "auto translation enabled")
+	assert.Equal(t, "fr", ch.AutoTranslationLanguage, "auto translation language")
+
+	msg, err := ch.SendMessage(&Message{Text: "hello world"}, serverUser.ID)
+	mustNoError(t, err, "send message")
+	assert.Equal(t, "en", msg.Language(), "detected message language")
+
+	mustNoError(t, ch.SetAutoTranslation(false, ""), "disable auto translation")
+	assert.False(t, ch.AutoTranslationEnabled, "auto translation disabled")
+}
+
+func TestChannel_Freeze(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer func() {
+		mustNoError(t, ch.Delete(), "delete channel")
+	}()
+
+	mustError(t, ch.Freeze(&Message{Text: "no user"}), "announcement without user")
+
+	err := ch.Freeze(&Message{Text: "channel is frozen", User: serverUser})
+	mustNoError(t, err, "freeze channel")
+	assert.True(t, ch.Frozen, "channel frozen")
+
+	mustNoError(t, ch.Unfreeze(nil), "unfreeze channel")
+	assert.False(t, ch.Frozen, "channel unfrozen")
+}
+
 func TestChannel_AddModerators(t *testing.T) {
 
 }
@@ -334,6 +765,132 @@ func TestChannel_SendFile(t *testing.T) {
 	})
 }
 
+func TestChannel_SendFileStreaming(t *testing.T) {
+	content := strings.Repeat("hello world\n", 1000)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ContentLength > 0 {
+			assert.True(t, r.ContentLength > int64(len(content)), "content length includes the form")
+		}
+
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		data, _ := ioutil.ReadAll(file)
+		assert.Equal(t, content, string(data), "file content")
+		assert.Contains(t, r.FormValue("user"), `"id":"user"`, "user field")
+
+		_, _ = w.Write([]byte(`{"file":"https://cdn.example.com/hello.txt"}`))
+	}))
+	defer srv.Close()
+
+	c, err := NewClient("key", []byte("secret"))
+	mustNoError(t, err, "new client")
+	c.BaseURL = srv.URL
+
+	ch := &Channel{Type: "messaging", ID: "general", client: c}
+
+	var uploaded int64
+
+	link, err := ch.SendFile(SendFileRequest{
+		Reader:   strings.NewReader(content),
+		FileName: "hello.txt",
+		User:     &User{ID: "user"},
+		Size:     int64(len(content)),
+		Progress: func(n int64) { uploaded = n },
+	})
+	mustNoError(t, err, "send file")
+	assert.Equal(t, "https://cdn.example.com/hello.txt", link)
+	assert.Equal(t, int64(len(content)), uploaded, "progress reported")
+
+	_, err = ch.SendFile(SendFileRequest{
+		Reader:   strings.NewReader(content),
+		FileName: "hello.txt",
+		User:     &User{ID: "user"},
+	})
+	mustNoError(t, err, "send file of unknown size")
+
+	_, err = ch.SendFile(SendFileRequest{
+		Reader:   strings.NewReader(content),
+		FileName: "hello.txt",
+		User:     &User{ID: "user"},
+		MaxSize:  100,
+	})
+	assert.Equal(t, ErrFileTooLarge, err, "file too large")
+}
+
+func TestChannel_SendLargeFile(t *testing.T) {
+	content := strings.Repeat("hello world\n", 1000)
+
+	var calls int
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		data, _ := ioutil.ReadAll(file)
+		assert.Equal(t, content, string(data), "whole file is re-sent")
+
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"file":"https://cdn.example.com/hello.txt"}`))
+	}))
+	defer srv.Close()
+
+	c, err := NewClient("key", []byte("secret"))
+	mustNoError(t, err, "new client")
+	c.BaseURL = srv.URL
+
+	var attempts []int
+	c.Use(func(next RequestHandler) RequestHandler {
+		return func(r *http.Request) (*http.Response, error) {
+			attempts = append(attempts, RetryAttempt(r))
+			return next(r)
+		}
+	})
+
+	ch := &Channel{Type: "messaging", ID: "general", client: c}
+
+	request := SendFileRequest{
+		Reader:   strings.NewReader(content),
+		FileName: "hello.txt",
+		User:     &User{ID: "user"},
+	}
+
+	link, err := ch.SendLargeFile(request, 2)
+	mustNoError(t, err, "send large file")
+	assert.Equal(t, "https://cdn.example.com/hello.txt", link)
+	assert.Equal(t, 2, calls, "upload retried")
+	assert.Equal(t, []int{0, 1}, attempts, "retry attempts")
+
+	// validation errors aren't retried
+	calls = 0
+
+	_, err = ch.SendLargeFile(SendFileRequest{Reader: strings.NewReader(content), FileName: "hello.txt"}, 2)
+	mustError(t, err, "user is nil")
+
+	_, err = ch.SendLargeFile(SendFileRequest{
+		Reader:   strings.NewReader(content),
+		FileName: "hello.txt",
+		User:     &User{ID: "user"},
+		Size:     10,
+	}, 2)
+	mustError(t, err, "file larger than size")
+	assert.True(t, calls <= 1, "upload isn't retried")
+
+	request.Reader = bytes.NewBufferString(content)
+	_, err = ch.SendLargeFile(request, 2)
+	mustError(t, err, "reader without seeker")
+}
+
 func TestChannel_SendImage(t *testing.T) {
 	c := initClient(t)
 	ch := initChannel(t, c)
@@ -362,6 +919,30 @@ func TestChannel_SendImage(t *testing.T) {
 		}
 	})
 
+	t.Run("Send image with upload sizes", func(t *testing.T) {
+		file, err := os.Open(path.Join("testdata", "helloworld.jpg"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		request := SendFileRequest{
+			Reader:      file,
+			FileName:    "HelloWorld.jpg",
+			User:        randomUser(),
+			ContentType: "image/jpeg",
+			UploadSizes: []ImageSize{{Width: 100, Height: 100, Resize: ImageResizeScale, Crop: ImageCropCenter}},
+		}
+
+		_, err = ch.SendImage(request)
+		mustError(t, err, "crop without crop resize")
+
+		request.UploadSizes[0].Resize = ImageResizeCrop
+
+		link, err := ch.SendImage(request)
+		mustNoError(t, err, "send image with upload sizes")
+		mustNoError(t, ch.DeleteImage(link), "delete image")
+	})
+
 	t.Run("Delete image", func(t *testing.T) {
 		err := ch.DeleteImage(url)
 		if err != nil {
@@ -411,3 +992,260 @@ func TestChannel_RejectInvite(t *testing.T) {
 	err = ch.RejectInvite(members[0], &Message{Text: "rejected", User: &User{ID: members[0]}})
 	require.NoError(t, err, "reject invite")
 }
+
+func TestChannel_HideShow(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer func() {
+		mustNoError(t, ch.Delete(), "delete channel")
+	}()
+
+	user := randomUser()
+
+	mustError(t, ch.Hide(""), "hide without user")
+	mustError(t, ch.Show(""), "show without user")
+
+	mustNoError(t, ch.Hide(user.ID), "hide channel")
+	mustNoError(t, ch.Show(user.ID), "show channel")
+
+	mustNoError(t, ch.HideWithHistoryClear(user.ID), "hide channel with history clear")
+	mustNoError(t, ch.Show(user.ID), "show channel")
+}
+
+func TestChannel_PinArchive(t *testing.T) {
+	var bodies []string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assert.Equal(t, "/channels/messaging/general/member", r.URL.Path)
+		assert.Equal(t, "bob", r.URL.Query().Get("user_id"))
+
+		b, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+
+		_, _ = w.Write([]byte(`{"channel_member":{"user_id":"bob","pinned_at":"2020-01-01T00:00:00Z"}}`))
+	}))
+	defer srv.Close()
+
+	c, err := NewClient("key", []byte("secret"), WithBaseURL(srv.URL))
+	mustNoError(t, err, "new client")
+
+	ch := &Channel{Type: "messaging", ID: "general", client: c}
+
+	member, err := ch.Pin("bob")
+	mustNoError(t, err, "pin")
+	if assert.NotNil(t, member.PinnedAt, "pinned at") {
+		assert.Equal(t, 2020, member.PinnedAt.Year())
+	}
+
+	_, err = ch.Unpin("bob")
+	mustNoError(t, err, "unpin")
+	_, err = ch.Archive("bob")
+	mustNoError(t, err, "archive")
+	_, err = ch.Unarchive("bob")
+	mustNoError(t, err, "unarchive")
+
+	assert.Equal(t, []string{
+		`{"set":{"pinned":true}}`,
+		`{"set":{"pinned":false}}`,
+		`{"set":{"archived":true}}`,
+		`{"set":{"archived":false}}`,
+	}, bodies)
+
+	_, err = ch.Pin("")
+	mustError(t, err, "empty user ID")
+}
+
+func TestChannel_PartialUpdateMember(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer func() {
+		mustNoError(t, ch.Delete(), "delete channel")
+	}()
+
+	user := randomUser()
+	mustNoError(t, ch.AddMembers([]string{user.ID}, nil), "add members")
+
+	member, err := ch.PartialUpdateMember(user.ID, map[string]interface{}{
+		"nickname":   "bobby",
+		"joined_via": "invite_link",
+	}, nil)
+	mustNoError(t, err, "set member fields")
+	assert.Equal(t, "bobby", member.ExtraData["nickname"])
+	assert.Equal(t, "invite_link", member.ExtraData["joined_via"])
+
+	member, err = ch.PartialUpdateMember(user.ID, nil, []string{"joined_via"})
+	mustNoError(t, err, "unset member fields")
+	assert.Equal(t, "bobby", member.ExtraData["nickname"])
+	assert.NotContains(t, member.ExtraData, "joined_via")
+
+	_, err = ch.PartialUpdateMember(user.ID, nil, nil)
+	mustError(t, err, "empty update")
+}
+
+func TestChannel_Mute(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer func() {
+		mustNoError(t, ch.Delete(), "delete channel")
+	}()
+
+	user := randomUser()
+
+	expiration := time.Hour
+	mute, err := ch.Mute(user.ID, &expiration)
+	mustNoError(t, err, "mute channel")
+
+	assert.Equal(t, ch.CID, mute.Channel.CID, "muted channel")
+	assert.NotNil(t, mute.Expires, "mute has expiration")
+
+	got, err := c.QueryChannels(&QueryOption{
+		Filter: map[string]interface{}{
+			"muted": true,
+			"cid":   ch.CID,
+		},
+		UserID: user.ID,
+	})
+	mustNoError(t, err, "query muted channels")
+
+	if assert.Len(t, got, 1, "muted channels") {
+		assert.Equal(t, ch.CID, got[0].CID, "muted channel")
+	}
+
+	mustNoError(t, ch.Unmute(user.ID), "unmute channel")
+}
+
+func TestChannel_RefreshConcurrent(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{
+			"channel": {"id": "general", "type": "messaging", "cid": "messaging:general", "member_count": 1},
+			"members": [{"user_id": "user"}],
+			"messages": [{"id": "msg", "text": "hello"}]
+		}`))
+	}))
+	defer srv.Close()
+
+	c, err := NewClient("key", []byte("secret"))
+	mustNoError(t, err, "new client")
+	c.BaseURL = srv.URL
+
+	ch, err := c.CreateChannel("messaging", "general", "user", nil)
+	mustNoError(t, err, "create channel")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, ch.Refresh())
+		}()
+		go func() {
+			defer wg.Done()
+			snapshot := ch.Snapshot()
+			assert.Equal(t, "messaging:general", snapshot.CID)
+			assert.Len(t, snapshot.Members, 1)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 1, ch.MemberCount)
+	assert.Equal(t, "msg", ch.Messages[0].ID)
+}
+
+func TestChannel_UnmarshalJSON(t *testing.T) {
+	data := `{"id":"general","type":"messaging","cid":"messaging:general","name":"General","color":"red",
+		"members":[{"user_id":"bob","role":"member","nickname":"bobby"}]}`
+
+	var ch Channel
+	mustNoError(t, ch.UnmarshalJSON([]byte(data)), "unmarshal channel")
+
+	assert.Equal(t, "general", ch.ID)
+	assert.Equal(t, map[string]interface{}{"name": "General", "color": "red"}, ch.ExtraData)
+	if assert.Len(t, ch.Members, 1, "members") {
+		assert.Equal(t, "member", ch.Members[0].Role)
+		assert.Equal(t, map[string]interface{}{"nickname": "bobby"}, ch.Members[0].ExtraData)
+	}
+
+	b, err := ch.MarshalJSON()
+	mustNoError(t, err, "marshal channel")
+	assert.Contains(t, string(b), `"name":"General"`, "custom fields are flattened")
+	assert.Contains(t, string(b), `"nickname":"bobby"`, "member custom fields are flattened")
+}
+
+func TestCreateChannelOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		options CreateChannelOptions
+		want    map[string]interface{}
+		wantErr bool
+	}{
+		{"empty", CreateChannelOptions{}, map[string]interface{}{}, false},
+		{"members and team", CreateChannelOptions{Members: []string{"bob", "alice"}, Team: "red"},
+			map[string]interface{}{"members": []string{"bob", "alice"}, "team": "red"}, false},
+		{"extra data", CreateChannelOptions{ExtraData: map[string]interface{}{"name": "General"}},
+			map[string]interface{}{"name": "General"}, false},
+		{"empty member", CreateChannelOptions{Members: []string{"bob", ""}}, nil, true},
+		{"duplicated member", CreateChannelOptions{Members: []string{"bob", "bob"}}, nil, true},
+		{"reserved extra data", CreateChannelOptions{ExtraData: map[string]interface{}{"members": "bob"}}, nil, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.options.data()
+			if tt.wantErr {
+				mustError(t, err, "channel data", tt)
+				return
+			}
+
+			mustNoError(t, err, "channel data", tt)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseChannelCID(t *testing.T) {
+	tests := []struct {
+		cid     string
+		_type   string
+		id      string
+		wantErr bool
+	}{
+		{"messaging:general", "messaging", "general", false},
+		{"messaging:!members-abc", "messaging", "!members-abc", false},
+		{"general", "", "", true},
+		{":general", "", "", true},
+		{"messaging:", "", "", true},
+		{"messaging:general:extra", "", "", true},
+		{"messaging:general.chat", "", "", true},
+		{"messaging:general chat", "", "", true},
+		{"my type:general", "", "", true},
+		{"live_stream:match-42", "live_stream", "match-42", false},
+	}
+
+	for _, tt := range tests {
+		chanType, chanID, err := ParseChannelCID(tt.cid)
+		if tt.wantErr {
+			mustError(t, err, "parse cid", tt.cid)
+			continue
+		}
+
+		mustNoError(t, err, "parse cid", tt.cid)
+		assert.Equal(t, tt._type, chanType, "channel type")
+		assert.Equal(t, tt.id, chanID, "channel id")
+	}
+
+	c, _ := NewClient("key", []byte("secret"))
+
+	ch, err := c.ChannelByCID("messaging:general")
+	mustNoError(t, err, "channel by cid")
+	assert.Equal(t, "messaging", ch.Type)
+	assert.Equal(t, "general", ch.ID)
+	assert.Equal(t, c, ch.client, "client link")
+
+	_, err = c.ChannelByCID("general")
+	mustError(t, err, "invalid cid")
+
+	_, err = c.ChannelByCID("messaging:general/../chat")
+	mustError(t, err, "invalid cid characters")
+}
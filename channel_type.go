@@ -2,6 +2,7 @@ package stream_chat // nolint: golint
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
 	"path"
@@ -24,23 +25,6 @@ const (
 type modType string
 type modBehaviour string
 
-type Permission struct {
-	Name   string `json:"name"`   // required
-	Action string `json:"action"` // one of: Deny Allow
-
-	Resources []string `json:"resources"` // required
-	Roles     []string `json:"roles"`
-	Owner     bool     `json:"owner"`
-	Priority  int      `json:"priority"` // required
-}
-
-type Command struct {
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	Args        string `json:"args"`
-	Set         string `json:"set"`
-}
-
 type ChannelType struct {
 	ChannelConfig
 
@@ -129,6 +113,8 @@ func (c *Client) ListChannelTypes() (map[string]*ChannelType, error) {
 	return resp.ChannelTypes, err
 }
 
+// UpdateChannelType updates channel type with given name
+// options: fields to update, ie {"read_events": false, "automod": AutoModSimple}
 func (c *Client) UpdateChannelType(name string, options map[string]interface{}) error {
 	switch {
 	case name == "":
@@ -139,9 +125,82 @@ func (c *Client) UpdateChannelType(name string, options map[string]interface{})
 
 	p := path.Join("channeltypes", url.PathEscape(name))
 
-	return c.makeRequest(http.MethodPut, p, nil, nil, nil)
+	return c.makeRequest(http.MethodPut, p, nil, options, nil)
+}
+
+// ChannelTypeModeration is the moderation configuration of a channel type, empty fields aren't changed
+type ChannelTypeModeration struct {
+	Automod           modType      `json:"automod,omitempty"`
+	AutomodBehavior   modBehaviour `json:"automod_behavior,omitempty"`
+	AutomodThresholds *Thresholds  `json:"automod_thresholds,omitempty"`
+
+	Blocklist         string       `json:"blocklist,omitempty"` // name of a blocklist, see CreateBlocklist
+	BlocklistBehavior modBehaviour `json:"blocklist_behavior,omitempty"`
+}
+
+func (m ChannelTypeModeration) validate() error {
+	switch m.Automod {
+	case "", AutoModDisabled, AutoModSimple, AutoModAI:
+	default:
+		return fmt.Errorf("invalid automod: %s", m.Automod)
+	}
+
+	for _, b := range []modBehaviour{m.AutomodBehavior, m.BlocklistBehavior} {
+		switch b {
+		case "", ModBehaviourFlag, ModBehaviourBlock:
+		default:
+			return fmt.Errorf("invalid moderation behavior: %s", b)
+		}
+	}
+
+	if t := m.AutomodThresholds; t != nil {
+		if m.Automod != "" && m.Automod != AutoModAI {
+			return errors.New("automod thresholds require AI automod")
+		}
+
+		labels := map[string]*LabelThresholds{"explicit": t.Explicit, "spam": t.Spam, "toxic": t.Toxic}
+		for label, l := range labels {
+			switch {
+			case l == nil:
+			case l.Flag < 0 || l.Flag > 1 || l.Block < 0 || l.Block > 1:
+				return fmt.Errorf("%s thresholds must be between 0 and 1", label)
+			case l.Flag > l.Block:
+				return fmt.Errorf("%s flag threshold must be not greater than block threshold", label)
+			}
+		}
+	}
+
+	if m.BlocklistBehavior != "" && m.Blocklist == "" {
+		return errors.New("blocklist behavior requires a blocklist")
+	}
+
+	return nil
+}
+
+// UpdateChannelTypeModeration updates the moderation configuration of the channel type with given name, ie
+//
+//	err := client.UpdateChannelTypeModeration("messaging", ChannelTypeModeration{
+//		Automod:           AutoModAI,
+//		AutomodBehavior:   ModBehaviourBlock,
+//		AutomodThresholds: &Thresholds{Toxic: &LabelThresholds{Flag: 0.6, Block: 0.9}},
+//	})
+func (c *Client) UpdateChannelTypeModeration(name string, moderation ChannelTypeModeration) error {
+	if name == "" {
+		return errors.New("channel type name is empty")
+	}
+	if moderation == (ChannelTypeModeration{}) {
+		return errors.New("moderation settings are empty")
+	}
+	if err := moderation.validate(); err != nil {
+		return err
+	}
+
+	p := path.Join("channeltypes", url.PathEscape(name))
+
+	return c.makeRequest(http.MethodPut, p, nil, moderation, nil)
 }
 
+// DeleteChannelType removes channel type with given name
 func (c *Client) DeleteChannelType(name string) error {
 	if name == "" {
 		return errors.New("channel type name is empty")
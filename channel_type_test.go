@@ -44,3 +44,91 @@ func TestClient_ListChannelTypes(t *testing.T) {
 
 	assert.Contains(t, got, ct.Name)
 }
+
+func TestClient_UpdateChannelType(t *testing.T) {
+	c := initClient(t)
+
+	ct := prepareChannelType(t, c)
+	defer func() {
+		mustNoError(t, c.DeleteChannelType(ct.Name), "delete channel type")
+	}()
+
+	err := c.UpdateChannelType(ct.Name, map[string]interface{}{
+		"read_events":    false,
+		"url_enrichment": false,
+	})
+	mustNoError(t, err, "update channel type")
+
+	got, err := c.GetChannelType(ct.Name)
+	mustNoError(t, err, "get channel type")
+
+	assert.False(t, got.ReadEvents, "read events disabled")
+	assert.False(t, got.URLEnrichment, "url enrichment disabled")
+}
+
+func TestClient_UpdateChannelTypeModeration(t *testing.T) {
+	c := initClient(t)
+
+	ct := prepareChannelType(t, c)
+	defer func() {
+		mustNoError(t, c.DeleteChannelType(ct.Name), "delete channel type")
+	}()
+
+	err := c.UpdateChannelTypeModeration(ct.Name, ChannelTypeModeration{
+		Automod:           AutoModAI,
+		AutomodBehavior:   ModBehaviourBlock,
+		AutomodThresholds: &Thresholds{Toxic: &LabelThresholds{Flag: 0.6, Block: 0.9}},
+	})
+	mustNoError(t, err, "update channel type moderation")
+
+	got, err := c.GetChannelType(ct.Name)
+	mustNoError(t, err, "get channel type")
+
+	assert.Equal(t, AutoModAI, got.Automod)
+	assert.Equal(t, ModBehaviourBlock, got.ModBehavior)
+	if assert.NotNil(t, got.AutomodThresholds) && assert.NotNil(t, got.AutomodThresholds.Toxic) {
+		assert.Equal(t, float32(0.9), got.AutomodThresholds.Toxic.Block)
+	}
+}
+
+func TestChannelTypeModeration_validate(t *testing.T) {
+	tests := []struct {
+		name       string
+		moderation ChannelTypeModeration
+		wantErr    bool
+	}{
+		{"automod", ChannelTypeModeration{Automod: AutoModSimple, AutomodBehavior: ModBehaviourFlag}, false},
+		{"thresholds", ChannelTypeModeration{Automod: AutoModAI,
+			AutomodThresholds: &Thresholds{Spam: &LabelThresholds{Flag: 0.5, Block: 0.8}}}, false},
+		{"blocklist", ChannelTypeModeration{Blocklist: "profanity", BlocklistBehavior: ModBehaviourBlock}, false},
+		{"invalid automod", ChannelTypeModeration{Automod: "smart"}, true},
+		{"invalid behavior", ChannelTypeModeration{AutomodBehavior: "shadow"}, true},
+		{"thresholds without AI", ChannelTypeModeration{Automod: AutoModSimple,
+			AutomodThresholds: &Thresholds{Spam: &LabelThresholds{Flag: 0.5, Block: 0.8}}}, true},
+		{"threshold out of range", ChannelTypeModeration{
+			AutomodThresholds: &Thresholds{Toxic: &LabelThresholds{Flag: 0.5, Block: 1.5}}}, true},
+		{"flag over block", ChannelTypeModeration{
+			AutomodThresholds: &Thresholds{Explicit: &LabelThresholds{Flag: 0.9, Block: 0.5}}}, true},
+		{"blocklist behavior without blocklist", ChannelTypeModeration{BlocklistBehavior: ModBehaviourFlag}, true},
+	}
+
+	for _, tt := range tests {
+		err := tt.moderation.validate()
+		if tt.wantErr {
+			mustError(t, err, tt.name)
+		} else {
+			mustNoError(t, err, tt.name)
+		}
+	}
+}
+
+func TestClient_DeleteChannelType(t *testing.T) {
+	c := initClient(t)
+
+	ct := prepareChannelType(t, c)
+
+	mustNoError(t, c.DeleteChannelType(ct.Name), "delete channel type")
+
+	_, err := c.GetChannelType(ct.Name)
+	mustError(t, err, "get deleted channel type")
+}
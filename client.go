@@ -2,6 +2,7 @@ package stream_chat // nolint: golint
 
 import (
 	"bytes"
+	"context"
 	"crypto"
 	"crypto/hmac"
 	"encoding/json"
@@ -10,10 +11,10 @@ import (
 	"io"
 	"io/ioutil"
 	"mime/multipart"
+	"net"
 	"net/http"
 	"net/textproto"
 	"net/url"
-	"os"
 	"strings"
 	"time"
 
@@ -24,6 +25,10 @@ import (
 const (
 	defaultBaseURL = "https://chat-us-east-1.stream-io-api.com"
 	defaultTimeout = 6 * time.Second
+
+	defaultPageLimit = 25
+
+	uploadRetryDelay = 500 * time.Millisecond
 )
 
 type Client struct {
@@ -33,6 +38,70 @@ type Client struct {
 	apiKey    string
 	apiSecret []byte
 	authToken string
+
+	middlewares []RequestMiddleware
+
+	logger   Logger
+	logLevel LogLevel
+}
+
+// RequestHandler sends the request of a REST call and returns its response
+type RequestHandler func(r *http.Request) (*http.Response, error)
+
+// RequestMiddleware wraps the handler of REST calls, ie to log requests or record metrics:
+//
+//	client.Use(func(next RequestHandler) RequestHandler {
+//		return func(r *http.Request) (*http.Response, error) {
+//			start := time.Now()
+//			resp, err := next(r)
+//			log.Println(r.Method, r.URL.Path, time.Since(start))
+//			return resp, err
+//		}
+//	})
+type RequestMiddleware func(next RequestHandler) RequestHandler
+
+// Use adds middlewares around every REST call of the client, the first middleware is the outermost.
+// It should be called before the client is used, it isn't safe to call concurrently with requests.
+func (c *Client) Use(middleware ...RequestMiddleware) {
+	c.middlewares = append(c.middlewares, middleware...)
+}
+
+// WithTimeout returns a copy of the client with the request timeout, 0 means no timeout.
+// The copy shares the connections and logger of the client and starts with its middlewares,
+// middlewares added to either client later don't affect the other. Channels created or
+// queried with it use the timeout too:
+//
+//	taskID, err := client.WithTimeout(time.Minute).ExportChannels(channels, nil)
+//
+// The timeout applies to each attempt of retried uploads. The copy is a *Client, it's returned
+// as StreamClient so a mocked client can return a mock.
+func (c *Client) WithTimeout(timeout time.Duration) StreamClient {
+	httpClient := *c.HTTP
+	httpClient.Timeout = timeout
+
+	client := *c
+	client.HTTP = &httpClient
+	client.middlewares = append([]RequestMiddleware(nil), c.middlewares...)
+
+	return &client
+}
+
+type retryAttemptKey struct{}
+
+// RetryAttempt returns the retry attempt of the request passed to a middleware, 0 for the first attempt
+func RetryAttempt(r *http.Request) int {
+	attempt, _ := r.Context().Value(retryAttemptKey{}).(int)
+	return attempt
+}
+
+func (c *Client) handler() RequestHandler {
+	h := c.HTTP.Do
+
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		h = c.middlewares[i](h)
+	}
+
+	return h
 }
 
 func (c *Client) setHeaders(r *http.Request) {
@@ -49,12 +118,16 @@ func (c *Client) parseResponse(resp *http.Response, result easyjson.Unmarshaler)
 
 	if resp.StatusCode >= 399 {
 		msg, _ := ioutil.ReadAll(resp.Body)
-		return fmt.Errorf("chat-client: HTTP %s %s status %s: %s",
-			resp.Request.Method, resp.Request.URL, resp.Status, string(msg))
+		return newStreamError(resp, msg)
 	}
 
 	if result != nil {
-		return easyjson.UnmarshalFromReader(resp.Body, result)
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+
+		return unmarshalResponse(body, result)
 	}
 
 	return nil
@@ -125,7 +198,13 @@ func (c *Client) makeRequest(method, path string, params url.Values,
 		return err
 	}
 
-	resp, err := c.HTTP.Do(r)
+	return c.doRequest(r, result)
+}
+
+func (c *Client) doRequest(r *http.Request, result easyjson.Unmarshaler) error {
+	start := time.Now()
+	resp, err := c.handler()(r)
+	c.logRequest(r, resp, err, time.Since(start))
 	if err != nil {
 		return err
 	}
@@ -209,74 +288,178 @@ func (form *multipartForm) setData(fieldName string, data easyjson.Marshaler) er
 	return err
 }
 
-func (form *multipartForm) setFile(fieldName string, r io.Reader, fileName, contentType string) error {
-	file, err := form.CreateFormFile(fieldName, fileName, contentType)
+func (form *multipartForm) setJSON(fieldName string, data interface{}) error {
+	field, err := form.CreateFormField(fieldName)
 	if err != nil {
 		return err
 	}
-	_, err = io.Copy(file, r)
+	return json.NewEncoder(field).Encode(data)
+}
 
-	return err
+// uploadReader reads the file of an upload, tracking progress and size limits
+type uploadReader struct {
+	r        io.Reader
+	size     int64
+	maxSize  int64
+	progress func(uploaded int64)
+
+	read int64
+	err  error
 }
 
-func (c *Client) sendFile(link string, opts SendFileRequest) (string, error) {
-	if opts.User == nil {
-		return "", errors.New("user is nil")
+func (u *uploadReader) Read(p []byte) (int, error) {
+	n, err := u.r.Read(p)
+	u.read += int64(n)
+
+	switch {
+	case u.maxSize > 0 && u.read > u.maxSize:
+		u.err = ErrFileTooLarge
+		return n, u.err
+	case u.size > 0 && u.read > u.size:
+		u.err = errors.New("file is larger than the given size")
+		return n, u.err
 	}
 
-	tmpfile, err := ioutil.TempFile("", opts.FileName)
-	if err != nil {
+	if n > 0 && u.progress != nil {
+		u.progress(u.read)
+	}
+
+	return n, err
+}
+
+// sendFile streams the multipart form of the file from opts.Reader without buffering it
+func (c *Client) sendFile(link string, opts SendFileRequest) (string, error) {
+	if err := opts.validate(); err != nil {
 		return "", err
 	}
 
-	defer func() {
-		_ = tmpfile.Close()
-		_ = os.Remove(tmpfile.Name())
-	}()
+	return c.sendFileAttempt(link, opts, 0)
+}
+
+func (opts SendFileRequest) validate() error {
+	switch {
+	case opts.User == nil:
+		return errors.New("user is nil")
+	case opts.Reader == nil:
+		return errors.New("file reader is nil")
+	case opts.MaxSize > 0 && opts.Size > opts.MaxSize:
+		return ErrFileTooLarge
+	}
 
-	form := multipartForm{multipart.NewWriter(tmpfile)}
+	return nil
+}
+
+func (c *Client) sendFileAttempt(link string, opts SendFileRequest, attempt int) (string, error) {
+	// multipart parts preceding and following the file content are rendered upfront,
+	// so the content itself is copied straight from the reader to the connection
+	var buf bytes.Buffer
+
+	form := multipartForm{multipart.NewWriter(&buf)}
 
 	if err := form.setData("user", opts.User); err != nil {
 		return "", err
 	}
 
-	err = form.setFile("file", opts.Reader, opts.FileName, opts.ContentType)
-	if err != nil {
-		return "", err
+	if len(opts.UploadSizes) > 0 {
+		if err := form.setJSON("upload_sizes", opts.UploadSizes); err != nil {
+			return "", err
+		}
 	}
 
-	err = form.Close()
-	if err != nil {
+	if _, err := form.CreateFormFile("file", opts.FileName, opts.ContentType); err != nil {
 		return "", err
 	}
 
-	if _, err = tmpfile.Seek(0, 0); err != nil {
+	head := make([]byte, buf.Len())
+	copy(head, buf.Bytes())
+	buf.Reset()
+
+	if err := form.Close(); err != nil {
 		return "", err
 	}
 
-	r, err := c.newRequest(http.MethodPost, link, nil, tmpfile)
+	tail := buf.Bytes()
+
+	file := &uploadReader{
+		r:        opts.Reader,
+		size:     opts.Size,
+		maxSize:  opts.MaxSize,
+		progress: opts.Progress,
+	}
+
+	body := io.MultiReader(bytes.NewReader(head), file, bytes.NewReader(tail))
+
+	r, err := c.newRequest(http.MethodPost, link, nil, body)
 	if err != nil {
 		return "", err
 	}
 
 	r.Header.Set("Content-Type", form.FormDataContentType())
+	if attempt > 0 {
+		r = r.WithContext(context.WithValue(r.Context(), retryAttemptKey{}, attempt))
+	}
+	if opts.Size > 0 {
+		r.ContentLength = int64(len(head)) + opts.Size + int64(len(tail))
+	}
 
-	res, err := c.HTTP.Do(r)
+	var resp sendFileResponse
+	err = c.doRequest(r, &resp)
+	if file.err != nil {
+		return "", file.err
+	}
 	if err != nil {
 		return "", err
 	}
 
-	var resp sendFileResponse
-	err = c.parseResponse(res, &resp)
+	return resp.File, err
+}
+
+// sendFileWithRetry sends the file, rewinding and re-sending it on network, rate limit and server errors
+func (c *Client) sendFileWithRetry(link string, opts SendFileRequest, retries int) (string, error) {
+	if err := opts.validate(); err != nil {
+		return "", err
+	}
+
+	seeker, ok := opts.Reader.(io.Seeker)
+	if !ok {
+		return "", errors.New("file reader must implement io.Seeker to be retried")
+	}
+
+	start, err := seeker.Seek(0, io.SeekCurrent)
 	if err != nil {
 		return "", err
 	}
 
-	return resp.File, err
+	delay := uploadRetryDelay
+
+	for attempt := 0; ; attempt++ {
+		file, err := c.sendFileAttempt(link, opts, attempt)
+		if err == nil || attempt >= retries || !isRetryableUploadError(err) {
+			return file, err
+		}
+
+		time.Sleep(delay)
+		delay *= 2
+
+		if _, err := seeker.Seek(start, io.SeekStart); err != nil {
+			return "", err
+		}
+	}
+}
+
+func isRetryableUploadError(err error) bool {
+	switch err := err.(type) {
+	case *StreamError:
+		return err.StatusCode == http.StatusTooManyRequests || err.StatusCode >= http.StatusInternalServerError
+	case *url.Error, net.Error:
+		return true
+	}
+
+	return false
 }
 
-// NewClient creates new stream chat api client
-func NewClient(apiKey string, apiSecret []byte) (*Client, error) {
+// NewClient creates new stream chat api client, options are applied in order
+func NewClient(apiKey string, apiSecret []byte, options ...ClientOption) (*Client, error) {
 	switch {
 	case apiKey == "":
 		return nil, errors.New("API key is empty")
@@ -293,6 +476,12 @@ func NewClient(apiKey string, apiSecret []byte) (*Client, error) {
 		},
 	}
 
+	for _, option := range options {
+		if err := option(client); err != nil {
+			return nil, err
+		}
+	}
+
 	token, err := client.createToken(map[string]interface{}{"server": true}, time.Time{})
 	if err != nil {
 		return nil, err
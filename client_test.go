@@ -1,7 +1,10 @@
 package stream_chat // nolint: golint
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"reflect"
+	"strconv"
 	"testing"
 	"time"
 
@@ -82,3 +85,39 @@ func TestClient_CreateToken(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_Use(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "tenant", r.Header.Get("X-Tenant"), "header set by middleware")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	c, _ := NewClient("key", []byte("secret"))
+	c.BaseURL = srv.URL
+
+	var calls []string
+
+	c.Use(
+		func(next RequestHandler) RequestHandler {
+			return func(r *http.Request) (*http.Response, error) {
+				calls = append(calls, "outer")
+				r.Header.Set("X-Tenant", "tenant")
+				return next(r)
+			}
+		},
+		func(next RequestHandler) RequestHandler {
+			return func(r *http.Request) (*http.Response, error) {
+				calls = append(calls, "inner")
+				resp, err := next(r)
+				if err == nil {
+					calls = append(calls, "status "+strconv.Itoa(resp.StatusCode))
+				}
+				return resp, err
+			}
+		},
+	)
+
+	mustNoError(t, c.DeleteCommand("location"), "request")
+	assert.Equal(t, []string{"outer", "inner", "status 200"}, calls, "middlewares are called in order")
+}
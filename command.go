@@ -0,0 +1,102 @@
+package stream_chat // nolint: golint
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"path"
+)
+
+type Command struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Args        string `json:"args"`
+	Set         string `json:"set"`
+}
+
+type commandResponse struct {
+	Command *Command `json:"command"`
+}
+
+type commandsResponse struct {
+	Commands []*Command `json:"commands"`
+}
+
+// CreateCommand registers new custom command
+func (c *Client) CreateCommand(cmd *Command) (*Command, error) {
+	switch {
+	case cmd == nil:
+		return nil, errors.New("command is nil")
+	case cmd.Name == "":
+		return nil, errors.New("command name is empty")
+	}
+
+	var resp commandResponse
+
+	err := c.makeRequest(http.MethodPost, "commands", nil, cmd, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Command, nil
+}
+
+// GetCommand returns custom command with given name
+func (c *Client) GetCommand(name string) (*Command, error) {
+	if name == "" {
+		return nil, errors.New("command name is empty")
+	}
+
+	p := path.Join("commands", url.PathEscape(name))
+
+	var cmd Command
+
+	err := c.makeRequest(http.MethodGet, p, nil, nil, &cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cmd, nil
+}
+
+// ListCommands returns all custom commands
+func (c *Client) ListCommands() ([]*Command, error) {
+	var resp commandsResponse
+
+	err := c.makeRequest(http.MethodGet, "commands", nil, nil, &resp)
+
+	return resp.Commands, err
+}
+
+// UpdateCommand updates custom command with given name
+// options: fields to update, ie {"description": "new description", "args": "[text]"}
+func (c *Client) UpdateCommand(name string, options map[string]interface{}) (*Command, error) {
+	switch {
+	case name == "":
+		return nil, errors.New("command name is empty")
+	case len(options) == 0:
+		return nil, errors.New("options are empty")
+	}
+
+	p := path.Join("commands", url.PathEscape(name))
+
+	var resp commandResponse
+
+	err := c.makeRequest(http.MethodPut, p, nil, options, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Command, nil
+}
+
+// DeleteCommand removes custom command with given name
+func (c *Client) DeleteCommand(name string) error {
+	if name == "" {
+		return errors.New("command name is empty")
+	}
+
+	p := path.Join("commands", url.PathEscape(name))
+
+	return c.makeRequest(http.MethodDelete, p, nil, nil, nil)
+}
@@ -0,0 +1,64 @@
+package stream_chat // nolint: golint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func prepareCommand(t *testing.T, c *Client) *Command {
+	cmd, err := c.CreateCommand(&Command{
+		Name:        randomString(10),
+		Description: "test command",
+		Args:        "[text]",
+		Set:         "test_set",
+	})
+	mustNoError(t, err, "create command")
+
+	return cmd
+}
+
+func TestClient_GetCommand(t *testing.T) {
+	c := initClient(t)
+
+	cmd := prepareCommand(t, c)
+	defer func() {
+		mustNoError(t, c.DeleteCommand(cmd.Name), "delete command")
+	}()
+
+	got, err := c.GetCommand(cmd.Name)
+	mustNoError(t, err, "get command")
+
+	assert.Equal(t, cmd.Name, got.Name)
+	assert.Equal(t, cmd.Description, got.Description)
+}
+
+func TestClient_ListCommands(t *testing.T) {
+	c := initClient(t)
+
+	cmd := prepareCommand(t, c)
+	defer func() {
+		mustNoError(t, c.DeleteCommand(cmd.Name), "delete command")
+	}()
+
+	got, err := c.ListCommands()
+	mustNoError(t, err, "list commands")
+
+	assert.Contains(t, got, cmd)
+}
+
+func TestClient_UpdateCommand(t *testing.T) {
+	c := initClient(t)
+
+	cmd := prepareCommand(t, c)
+	defer func() {
+		mustNoError(t, c.DeleteCommand(cmd.Name), "delete command")
+	}()
+
+	got, err := c.UpdateCommand(cmd.Name, map[string]interface{}{
+		"description": "updated description",
+	})
+	mustNoError(t, err, "update command")
+
+	assert.Equal(t, "updated description", got.Description)
+}
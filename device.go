@@ -9,6 +9,8 @@ import (
 const (
 	PushProviderAPNS     = pushProvider("apn")
 	PushProviderFirebase = pushProvider("firebase")
+	PushProviderHuawei   = pushProvider("huawei")
+	PushProviderXiaomi   = pushProvider("xiaomi")
 )
 
 type pushProvider = string
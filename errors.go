@@ -0,0 +1,141 @@
+package stream_chat // nolint: golint
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/getstream/easyjson"
+)
+
+// Stream API error codes
+const (
+	ErrorCodeInputError     = 4
+	ErrorCodeAuthFailed     = 5
+	ErrorCodeRateLimit      = 9
+	ErrorCodeDoesNotExist   = 16
+	ErrorCodeNotAllowed     = 17
+	ErrorCodeTokenExpired   = 40
+	ErrorCodeTokenSignature = 43
+)
+
+// errors to match *StreamError with errors.Is, ie errors.Is(err, ErrNotFound)
+// nolint: gochecknoglobals
+var (
+	ErrNotFound    = errors.New("chat-client: resource not found")
+	ErrRateLimited = errors.New("chat-client: rate limit exceeded")
+	ErrNotAllowed  = errors.New("chat-client: not allowed")
+	ErrAuthFailed  = errors.New("chat-client: authentication failed")
+)
+
+// ErrFileTooLarge is returned when an uploaded file exceeds SendFileRequest.MaxSize
+// nolint: gochecknoglobals
+var ErrFileTooLarge = errors.New("chat-client: file exceeds the max upload size")
+
+// ErrTooManyResults is returned by All of query iterators when there are more results than the max
+// nolint: gochecknoglobals
+var ErrTooManyResults = errors.New("chat-client: too many results")
+
+// RateLimitInfo is the rate limit state of the API endpoint
+type RateLimitInfo struct {
+	Limit     int64 `json:"limit"`     // max number of requests in the window
+	Remaining int64 `json:"remaining"` // number of requests left in the window
+	Reset     int64 `json:"reset"`     // unix time in seconds when the window is reset
+}
+
+// ResetTime returns the time when the rate limit window is reset
+func (r RateLimitInfo) ResetTime() time.Time {
+	return time.Unix(r.Reset, 0)
+}
+
+func newRateLimitInfo(h http.Header) *RateLimitInfo {
+	limit, err := strconv.ParseInt(h.Get("X-RateLimit-Limit"), 10, 64)
+	if err != nil {
+		return nil
+	}
+
+	remaining, _ := strconv.ParseInt(h.Get("X-RateLimit-Remaining"), 10, 64)
+	reset, _ := strconv.ParseInt(h.Get("X-RateLimit-Reset"), 10, 64)
+
+	return &RateLimitInfo{Limit: limit, Remaining: remaining, Reset: reset}
+}
+
+// StreamError is an error returned by Stream API
+type StreamError struct {
+	Code            int               `json:"code"`       // Stream error code, one of ErrorCode* constants
+	Message         string            `json:"message"`    // error description
+	StatusCode      int               `json:"StatusCode"` // HTTP status code
+	MoreInfo        string            `json:"more_info"`
+	ExceptionFields map[string]string `json:"exception_fields,omitempty"` // invalid input fields
+
+	RateLimit *RateLimitInfo `json:"-"` // rate limit of the endpoint, nil if unknown
+
+	method    string
+	url       string
+	status    string
+	body      []byte
+	requestID string
+}
+
+func newStreamError(resp *http.Response, body []byte) *StreamError {
+	var err StreamError
+
+	if easyjson.Unmarshal(body, &err) != nil {
+		err = StreamError{}
+	}
+
+	if err.Message == "" {
+		err.Message = string(body)
+	}
+
+	err.StatusCode = resp.StatusCode
+	err.RateLimit = newRateLimitInfo(resp.Header)
+	err.status = resp.Status
+	err.body = body
+	err.requestID = resp.Header.Get("X-Request-Id")
+
+	if resp.Request != nil {
+		err.method = resp.Request.Method
+		err.url = resp.Request.URL.String()
+	}
+
+	return &err
+}
+
+func (e *StreamError) Error() string {
+	if e.requestID != "" {
+		return fmt.Sprintf("chat-client: HTTP %s %s status %s (request %s): %s",
+			e.method, e.url, e.status, e.requestID, string(e.body))
+	}
+
+	return fmt.Sprintf("chat-client: HTTP %s %s status %s: %s", e.method, e.url, e.status, string(e.body))
+}
+
+// Body returns the raw body of the failed response
+func (e *StreamError) Body() []byte {
+	return e.body
+}
+
+// RequestID returns the ID of the failed request to share with Stream support, empty if unknown
+func (e *StreamError) RequestID() string {
+	return e.requestID
+}
+
+// Is reports whether the error matches one of Err* errors, ie ErrNotFound
+func (e *StreamError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.Code == ErrorCodeDoesNotExist || e.StatusCode == http.StatusNotFound
+	case ErrRateLimited:
+		return e.Code == ErrorCodeRateLimit || e.StatusCode == http.StatusTooManyRequests
+	case ErrNotAllowed:
+		return e.Code == ErrorCodeNotAllowed || e.StatusCode == http.StatusForbidden
+	case ErrAuthFailed:
+		return e.Code == ErrorCodeAuthFailed || e.Code == ErrorCodeTokenExpired ||
+			e.Code == ErrorCodeTokenSignature || e.StatusCode == http.StatusUnauthorized
+	}
+
+	return false
+}
@@ -0,0 +1,61 @@
+package stream_chat // nolint: golint
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "60")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", "1580000000")
+		w.Header().Set("X-Request-Id", "request-1")
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = w.Write([]byte(`{"code":9,"message":"Too many requests","StatusCode":429}`))
+	}))
+	defer srv.Close()
+
+	c, err := NewClient("key", []byte("secret"))
+	mustNoError(t, err, "new client")
+	c.BaseURL = srv.URL
+
+	_, err = c.GetMessage("id")
+	mustError(t, err, "get message")
+
+	streamErr, ok := err.(*StreamError)
+	if !assert.True(t, ok, "error is StreamError: %T", err) {
+		return
+	}
+
+	assert.Equal(t, ErrorCodeRateLimit, streamErr.Code)
+	assert.Equal(t, "Too many requests", streamErr.Message)
+	assert.Equal(t, http.StatusTooManyRequests, streamErr.StatusCode)
+	assert.True(t, streamErr.Is(ErrRateLimited), "rate limited")
+	assert.False(t, streamErr.Is(ErrNotFound), "not found")
+
+	assert.Equal(t, `{"code":9,"message":"Too many requests","StatusCode":429}`, string(streamErr.Body()))
+	assert.Equal(t, "request-1", streamErr.RequestID())
+	assert.Contains(t, streamErr.Error(), "(request request-1)")
+
+	if assert.NotNil(t, streamErr.RateLimit, "rate limit info") {
+		assert.Equal(t, int64(60), streamErr.RateLimit.Limit)
+		assert.Equal(t, int64(0), streamErr.RateLimit.Remaining)
+		assert.Equal(t, int64(1580000000), streamErr.RateLimit.ResetTime().Unix())
+	}
+}
+
+func TestStreamError_NotFound(t *testing.T) {
+	c := initClient(t)
+
+	_, err := c.GetMessage(randomString(12))
+	mustError(t, err, "get message")
+
+	streamErr, ok := err.(*StreamError)
+	if assert.True(t, ok, "error is StreamError: %T", err) {
+		assert.True(t, streamErr.Is(ErrNotFound), "not found")
+	}
+}
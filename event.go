@@ -49,8 +49,11 @@ type Event struct {
 	UserID       string         `json:"user_id,omitempty"`
 	OwnUser      *User          `json:"me,omitempty"`
 	WatcherCount int            `json:"watcher_count,omitempty"`
+	ParentID     string         `json:"parent_id,omitempty"` // parent message ID for thread events
+	ConnectionID string         `json:"connection_id,omitempty"`
 
-	ExtraData map[string]interface{} `json:"-"`
+	// any other fields of the event, ie custom event payload
+	ExtraData map[string]interface{} `json:"-,extra"` //nolint: staticcheck
 
 	CreatedAt time.Time `json:"created_at,omitempty"`
 }
@@ -59,10 +62,16 @@ type eventRequest struct {
 	Event *Event `json:"event"`
 }
 
-// SendEvent sends an event on this channel
+// SendEvent sends an event on this channel; besides Event* constants
+// custom event types are supported, ie EventType("order_shipped") with payload in ExtraData
 func (ch *Channel) SendEvent(event *Event, userID string) error {
-	if event == nil {
+	switch {
+	case event == nil:
 		return errors.New("event is nil")
+	case event.Type == "":
+		return errors.New("event type is empty")
+	case userID == "":
+		return errors.New("user ID must be not empty")
 	}
 
 	event.User = &User{ID: userID}
@@ -73,3 +82,33 @@ func (ch *Channel) SendEvent(event *Event, userID string) error {
 
 	return ch.client.makeRequest(http.MethodPost, p, nil, req, nil)
 }
+
+// SendTypingStart sends typing start event of userID on this channel
+// parentID: optional parent message ID when typing in a thread
+func (ch *Channel) SendTypingStart(userID, parentID string) error {
+	return ch.SendEvent(&Event{Type: EventTypingStart, ParentID: parentID}, userID)
+}
+
+// SendTypingStop sends typing stop event of userID on this channel
+// parentID: optional parent message ID when typing in a thread
+func (ch *Channel) SendTypingStop(userID, parentID string) error {
+	return ch.SendEvent(&Event{Type: EventTypingStop, ParentID: parentID}, userID)
+}
+
+// SendUserEvent sends a custom event to the user with given ID, the event is delivered to all user's connections
+func (c *Client) SendUserEvent(userID string, event *Event) error {
+	switch {
+	case userID == "":
+		return errors.New("user ID must be not empty")
+	case event == nil:
+		return errors.New("event is nil")
+	case event.Type == "":
+		return errors.New("event type is empty")
+	}
+
+	req := eventRequest{Event: event}
+
+	p := path.Join("users", url.PathEscape(userID), "event")
+
+	return c.makeRequest(http.MethodPost, p, nil, req, nil)
+}
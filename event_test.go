@@ -0,0 +1,23 @@
+package stream_chat // nolint: golint
+
+import (
+	"testing"
+)
+
+func TestClient_SendUserEvent(t *testing.T) {
+	c := initClient(t)
+
+	user := randomUser()
+	_, err := c.UpdateUser(user)
+	mustNoError(t, err, "update user")
+
+	event := &Event{
+		Type:      EventType("friend_request"),
+		ExtraData: map[string]interface{}{"from": serverUser.ID},
+	}
+
+	err = c.SendUserEvent(user.ID, event)
+	mustNoError(t, err, "send user event")
+
+	mustError(t, c.SendUserEvent("", event), "send user event without user")
+}
@@ -0,0 +1,256 @@
+package stream_chat // nolint: golint
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+
+	"github.com/getstream/easyjson"
+)
+
+const (
+	defaultExportPageSize = 100
+	maxExportPageSize     = 300 // the API caps message pages, a larger page would end the export early
+)
+
+// ExportableChannel is a channel to export, messages can be limited to a time range
+type ExportableChannel struct {
+	Type          string     `json:"type"`
+	ID            string     `json:"id"`
+	MessagesSince *time.Time `json:"messages_since,omitempty"`
+	MessagesUntil *time.Time `json:"messages_until,omitempty"`
+}
+
+type ExportChannelOptions struct {
+	ClearDeletedMessageText  bool `json:"clear_deleted_message_text,omitempty"`
+	IncludeTruncatedMessages bool `json:"include_truncated_messages,omitempty"`
+}
+
+type exportChannelsRequest struct {
+	Channels []*ExportableChannel `json:"channels"`
+	ExportChannelOptions
+}
+
+type ExportChannelsResult struct {
+	URL string `json:"url"` // signed url of the export file
+}
+
+type ExportChannelsStatus struct {
+	TaskID    string                 `json:"task_id"`
+	Status    string                 `json:"status"` // one of: waiting pending running completed failed
+	Result    *ExportChannelsResult  `json:"result,omitempty"`
+	Error     map[string]interface{} `json:"error,omitempty"`
+	CreatedAt time.Time              `json:"created_at"`
+	UpdatedAt time.Time              `json:"updated_at"`
+}
+
+// ExportChannels starts an async export of the given channels, returns the ID of the export task.
+// Use GetExportChannelsStatus to poll the task for the export file url.
+// options: optional export options
+func (c *Client) ExportChannels(channels []*ExportableChannel, options *ExportChannelOptions) (taskID string, err error) {
+	if len(channels) == 0 {
+		return "", errors.New("channels are empty")
+	}
+
+	for _, ch := range channels {
+		switch {
+		case ch == nil:
+			return "", errors.New("channel is nil")
+		case ch.Type == "" || ch.ID == "":
+			return "", errors.New("channel type and ID must be not empty")
+		}
+	}
+
+	req := exportChannelsRequest{Channels: channels}
+	if options != nil {
+		req.ExportChannelOptions = *options
+	}
+
+	var resp taskResponse
+
+	err = c.makeRequest(http.MethodPost, "export_channels", nil, req, &resp)
+
+	return resp.TaskID, err
+}
+
+// GetExportChannelsStatus returns the status of the channels export task with given ID
+func (c *Client) GetExportChannelsStatus(taskID string) (*ExportChannelsStatus, error) {
+	if taskID == "" {
+		return nil, errors.New("task ID is empty")
+	}
+
+	p := path.Join("export_channels", url.PathEscape(taskID))
+
+	var status ExportChannelsStatus
+
+	err := c.makeRequest(http.MethodGet, p, nil, nil, &status)
+	if err != nil {
+		return nil, err
+	}
+
+	return &status, nil
+}
+
+// ChannelExportOptions are the options of Channel.Export
+type ChannelExportOptions struct {
+	PageSize int        // messages per request, 100 by default and at most 300
+	Since    *time.Time // export messages created at or after it, all messages by default
+
+	IncludeReactions bool // all reactions of the messages
+	IncludeReplies   bool // replies of the threads, written once after their parent message
+}
+
+// ExportedMessage is a line of Channel.Export
+type ExportedMessage struct {
+	Message   *Message    `json:"message"`
+	Reactions []*Reaction `json:"reactions,omitempty"`
+}
+
+// Export pages through the message history of the channel from oldest to newest and writes
+// an ExportedMessage per line (NDJSON) to w. It doesn't change the channel state.
+// Example of usage:
+//
+//	f, err := os.Create("general.ndjson")
+//	...
+//	err = ch.Export(f, ChannelExportOptions{IncludeReactions: true, IncludeReplies: true})
+func (ch *Channel) Export(w io.Writer, options ChannelExportOptions) error {
+	if options.PageSize < 0 || options.PageSize > maxExportPageSize {
+		return fmt.Errorf("page size must be between 0 and %d", maxExportPageSize)
+	}
+	if options.PageSize == 0 {
+		options.PageSize = defaultExportPageSize
+	}
+
+	bw := bufio.NewWriter(w)
+
+	// lines written before a failure are kept
+	err := ch.export(bw, options)
+	if flushErr := bw.Flush(); err == nil {
+		err = flushErr
+	}
+
+	return err
+}
+
+func (ch *Channel) export(w io.Writer, options ChannelExportOptions) error {
+	since := time.Unix(0, 0)
+	if options.Since != nil {
+		since = *options.Since
+	}
+
+	page := &MessagePaginationParams{Limit: options.PageSize, CreatedAtAfterOrEqual: &since}
+	for {
+		messages, err := ch.exportPage(page)
+		if err != nil {
+			return err
+		}
+
+		for _, msg := range messages {
+			// replies shown in channel are written along with their thread
+			if options.IncludeReplies && msg.ParentID != "" {
+				continue
+			}
+
+			if err := ch.exportMessage(w, msg, options); err != nil {
+				return err
+			}
+
+			if options.IncludeReplies && msg.ReplyCount > 0 {
+				if err := ch.exportReplies(w, msg.ID, options); err != nil {
+					return err
+				}
+			}
+		}
+
+		if len(messages) < options.PageSize {
+			return nil
+		}
+
+		page = &MessagePaginationParams{Limit: options.PageSize, IDGT: messages[len(messages)-1].ID}
+	}
+}
+
+// exportPage returns a page of channel messages without updating the channel
+func (ch *Channel) exportPage(page *MessagePaginationParams) ([]*Message, error) {
+	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID), "query")
+
+	req := ChannelQueryRequest{State: true, Messages: page, Data: map[string]interface{}{}}
+
+	var resp queryResponse
+
+	err := ch.client.makeRequest(http.MethodPost, p, nil, req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Messages, nil
+}
+
+// exportReplies writes the replies from oldest to newest, paging forward from the first reply
+func (ch *Channel) exportReplies(w io.Writer, parentID string, options ChannelExportOptions) error {
+	epoch := time.Unix(0, 0)
+
+	page := RepliesOptions{Limit: options.PageSize, CreatedAtAfterOrEqual: &epoch}
+	for {
+		resp, err := ch.GetRepliesWithOptions(parentID, page)
+		if err != nil {
+			return err
+		}
+
+		for _, reply := range resp.Messages {
+			if err := ch.exportMessage(w, reply, options); err != nil {
+				return err
+			}
+		}
+
+		if len(resp.Messages) < options.PageSize {
+			return nil
+		}
+
+		page = RepliesOptions{Limit: options.PageSize, IDGT: resp.LastID}
+	}
+}
+
+func (ch *Channel) exportMessage(w io.Writer, msg *Message, options ChannelExportOptions) error {
+	line := ExportedMessage{Message: msg}
+
+	if options.IncludeReactions && len(msg.ReactionCounts) > 0 {
+		reactions, err := ch.allReactions(msg.ID)
+		if err != nil {
+			return err
+		}
+		line.Reactions = reactions
+	}
+
+	data, err := easyjson.Marshal(line)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
+
+func (ch *Channel) allReactions(msgID string) ([]*Reaction, error) {
+	var all []*Reaction
+
+	options := ReactionsOptions{Limit: maxReactionsPageSize}
+	for {
+		page, err := ch.GetReactionsWithOptions(msgID, options)
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, page.Reactions...)
+
+		if page.NextOffset == 0 {
+			return all, nil
+		}
+		options.Offset = page.NextOffset
+	}
+}
@@ -0,0 +1,130 @@
+package stream_chat // nolint: golint
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_ExportChannels(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+
+	_, err := ch.SendMessage(&Message{Text: "test message"}, randomUser().ID)
+	mustNoError(t, err, "send message")
+
+	_, err = c.ExportChannels(nil, nil)
+	mustError(t, err, "export without channels")
+
+	taskID, err := c.ExportChannels([]*ExportableChannel{{Type: ch.Type, ID: ch.ID}}, nil)
+	mustNoError(t, err, "export channels")
+	assert.NotEmpty(t, taskID, "export task ID")
+
+	status, err := c.GetExportChannelsStatus(taskID)
+	mustNoError(t, err, "get export channels status")
+	assert.Equal(t, taskID, status.TaskID)
+	assert.NotEmpty(t, status.Status)
+}
+
+func TestChannel_Export(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/channels/messaging/general/query":
+			var req map[string]interface{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&req), "decode request")
+
+			page, _ := req["messages"].(map[string]interface{})
+			assert.Equal(t, float64(2), page["limit"])
+
+			switch {
+			case page["created_at_after_or_equal"] != nil:
+				_, _ = w.Write([]byte(`{"messages":[{"id":"m1","text":"first","reply_count":3},` +
+					`{"id":"m2","text":"second","reaction_counts":{"like":1}}]}`))
+			case page["id_gt"] == "m2":
+				_, _ = w.Write([]byte(`{"messages":[{"id":"r2","text":"second reply","parent_id":"m1","show_in_channel":true},` +
+					`{"id":"m3","text":"third"}]}`))
+			case page["id_gt"] == "m3":
+				_, _ = w.Write([]byte(`{"messages":[]}`))
+			default:
+				t.Errorf("unexpected page: %v", page)
+			}
+		case "/messages/m1/replies":
+			q := r.URL.Query()
+			assert.Equal(t, "2", q.Get("limit"))
+
+			switch {
+			case q.Get("created_at_after_or_equal") != "":
+				_, _ = w.Write([]byte(`{"messages":[{"id":"r1","text":"first reply","parent_id":"m1"},` +
+					`{"id":"r2","text":"second reply","parent_id":"m1"}]}`))
+			case q.Get("id_gt") == "r2":
+				_, _ = w.Write([]byte(`{"messages":[{"id":"r3","text":"third reply","parent_id":"m1"}]}`))
+			default:
+				t.Errorf("unexpected replies page: %v", q)
+			}
+		case "/messages/m2/reactions":
+			assert.Equal(t, "300", r.URL.Query().Get("limit"))
+			_, _ = w.Write([]byte(`{"reactions":[{"message_id":"m2","user_id":"bob","type":"like"}]}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := NewClient("key", []byte("secret"), WithBaseURL(srv.URL))
+	mustNoError(t, err, "new client")
+
+	ch := &Channel{Type: "messaging", ID: "general", client: c}
+
+	var buf bytes.Buffer
+	err = ch.Export(&buf, ChannelExportOptions{PageSize: 2, IncludeReactions: true, IncludeReplies: true})
+	mustNoError(t, err, "export")
+
+	var ids []string
+	scanner := bufio.NewScanner(&buf)
+	for scanner.Scan() {
+		var line ExportedMessage
+		mustNoError(t, json.Unmarshal(scanner.Bytes(), &line), "decode line")
+		ids = append(ids, line.Message.ID)
+
+		if line.Message.ID == "m2" && assert.Len(t, line.Reactions, 1) {
+			assert.Equal(t, "bob", line.Reactions[0].UserID)
+		}
+	}
+
+	assert.Equal(t, []string{"m1", "r1", "r2", "r3", "m2", "m3"}, ids, "replies shown in channel are written once")
+	assert.Empty(t, ch.Messages, "channel state is not changed")
+
+	mustError(t, ch.Export(&buf, ChannelExportOptions{PageSize: -1}), "negative page size")
+	mustError(t, ch.Export(&buf, ChannelExportOptions{PageSize: maxExportPageSize + 1}), "page size above the API max")
+}
+
+func TestChannel_ExportFailure(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req), "decode request")
+
+		if page, _ := req["messages"].(map[string]interface{}); page["id_gt"] == "m1" {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"code":-1,"message":"internal error"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"messages":[{"id":"m1","text":"first"}]}`))
+	}))
+	defer srv.Close()
+
+	c, err := NewClient("key", []byte("secret"), WithBaseURL(srv.URL))
+	mustNoError(t, err, "new client")
+
+	ch := &Channel{Type: "messaging", ID: "general", client: c}
+
+	var buf bytes.Buffer
+	err = ch.Export(&buf, ChannelExportOptions{PageSize: 1})
+	mustError(t, err, "export")
+
+	assert.Contains(t, buf.String(), `"id":"m1"`, "exported lines are written")
+}
@@ -0,0 +1,43 @@
+package stream_chat // nolint: golint
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// DecodeExtraData decodes custom fields into dst, a pointer to a struct with json tags of the fields, ie
+//
+//	var data struct {
+//		Color string `json:"color"`
+//	}
+//	err := stream_chat.DecodeExtraData(ch.ExtraData, &data)
+//
+// Numbers of custom fields are decoded as float64 by the client, so integers beyond 2^53 have
+// already lost precision; json.Number isn't supported by the generated decoders.
+func DecodeExtraData(extra map[string]interface{}, dst interface{}) error {
+	if dst == nil {
+		return errors.New("destination is required")
+	}
+
+	data, err := json.Marshal(extra)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, dst)
+}
+
+// DecodeExtraData decodes custom fields of the channel into dst, see DecodeExtraData
+func (ch *Channel) DecodeExtraData(dst interface{}) error {
+	return DecodeExtraData(ch.ExtraData, dst)
+}
+
+// DecodeExtraData decodes custom fields of the message into dst, see DecodeExtraData
+func (m *Message) DecodeExtraData(dst interface{}) error {
+	return DecodeExtraData(m.ExtraData, dst)
+}
+
+// DecodeExtraData decodes custom fields of the user into dst, see DecodeExtraData
+func (u *User) DecodeExtraData(dst interface{}) error {
+	return DecodeExtraData(u.ExtraData, dst)
+}
@@ -0,0 +1,33 @@
+package stream_chat // nolint: golint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeExtraData(t *testing.T) {
+	var data struct {
+		Color string   `json:"color"`
+		Size  int      `json:"size"`
+		Tags  []string `json:"tags"`
+	}
+
+	ch := &Channel{ExtraData: map[string]interface{}{
+		"color":   "blue",
+		"size":    float64(3),
+		"tags":    []interface{}{"a", "b"},
+		"ignored": true,
+	}}
+
+	mustNoError(t, ch.DecodeExtraData(&data), "decode")
+	assert.Equal(t, "blue", data.Color)
+	assert.Equal(t, 3, data.Size)
+	assert.Equal(t, []string{"a", "b"}, data.Tags)
+
+	u := &User{ExtraData: map[string]interface{}{"size": "large"}}
+	assert.Error(t, u.DecodeExtraData(&data), "mismatched type")
+
+	assert.Error(t, DecodeExtraData(nil, nil), "nil destination")
+	assert.NoError(t, (&Message{}).DecodeExtraData(&data), "no custom fields")
+}
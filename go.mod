@@ -4,6 +4,7 @@ go 1.12
 
 require (
 	github.com/getstream/easyjson v0.0.0-20190923162548-5adc7b1f33fa
+	github.com/gorilla/websocket v1.4.1
 	github.com/pascaldekloe/jwt v1.5.0
 	github.com/stretchr/testify v1.3.0
 )
@@ -0,0 +1,133 @@
+package stream_chat // nolint: golint
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"time"
+)
+
+type ImportMode string
+
+const (
+	ImportModeInsert ImportMode = "insert"
+	ImportModeUpsert ImportMode = "upsert"
+)
+
+type ImportTaskHistory struct {
+	PrevState string    `json:"prev_state"`
+	NextState string    `json:"next_state"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ImportTask is an import of a previously uploaded file, State tracks its progress
+type ImportTask struct {
+	ID        string               `json:"id"`
+	Path      string               `json:"path"`
+	Mode      ImportMode           `json:"mode"`
+	State     string               `json:"state"`
+	Size      int64                `json:"size,omitempty"`
+	History   []*ImportTaskHistory `json:"history"`
+	CreatedAt time.Time            `json:"created_at"`
+	UpdatedAt time.Time            `json:"updated_at"`
+}
+
+// ImportURL is the location to upload an import file to
+type ImportURL struct {
+	UploadURL string `json:"upload_url"` // pre-signed URL, upload the file with a PUT request
+	Path      string `json:"path"`       // path to pass to CreateImport
+}
+
+type importURLRequest struct {
+	Filename string `json:"filename"`
+}
+
+type importRequest struct {
+	Path string     `json:"path"`
+	Mode ImportMode `json:"mode"`
+}
+
+type importResponse struct {
+	ImportTask *ImportTask `json:"import_task"`
+}
+
+type importsResponse struct {
+	ImportTasks []*ImportTask `json:"import_tasks"`
+}
+
+// CreateImportURL returns an URL to upload the import file with given name to
+func (c *Client) CreateImportURL(filename string) (*ImportURL, error) {
+	if filename == "" {
+		return nil, errors.New("filename is empty")
+	}
+
+	req := importURLRequest{Filename: filename}
+
+	var resp ImportURL
+
+	err := c.makeRequest(http.MethodPost, "import_urls", nil, req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// CreateImport starts importing the uploaded file at given path
+func (c *Client) CreateImport(filePath string, mode ImportMode) (*ImportTask, error) {
+	switch {
+	case filePath == "":
+		return nil, errors.New("import path is empty")
+	case mode != ImportModeInsert && mode != ImportModeUpsert:
+		return nil, errors.New("import mode is invalid")
+	}
+
+	req := importRequest{Path: filePath, Mode: mode}
+
+	var resp importResponse
+
+	err := c.makeRequest(http.MethodPost, "imports", nil, req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.ImportTask, nil
+}
+
+// GetImport returns the import with given ID
+func (c *Client) GetImport(id string) (*ImportTask, error) {
+	if id == "" {
+		return nil, errors.New("import ID is empty")
+	}
+
+	p := path.Join("imports", url.PathEscape(id))
+
+	var resp importResponse
+
+	err := c.makeRequest(http.MethodGet, p, nil, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.ImportTask, nil
+}
+
+// ListImports returns imports, most recent first
+// limit and offset are optional, zero values use server defaults
+func (c *Client) ListImports(limit, offset int) ([]*ImportTask, error) {
+	params := url.Values{}
+	if limit > 0 {
+		params.Set("limit", strconv.Itoa(limit))
+	}
+	if offset > 0 {
+		params.Set("offset", strconv.Itoa(offset))
+	}
+
+	var resp importsResponse
+
+	err := c.makeRequest(http.MethodGet, "imports", params, nil, &resp)
+
+	return resp.ImportTasks, err
+}
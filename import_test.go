@@ -0,0 +1,43 @@
+package stream_chat // nolint: golint
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Imports(t *testing.T) {
+	c := initClient(t)
+
+	importURL, err := c.CreateImportURL(randomString(10) + ".json")
+	mustNoError(t, err, "create import url")
+	require.NotEmpty(t, importURL.UploadURL)
+
+	data := `[{"type":"user","item":{"id":"` + randomString(10) + `","role":"user"}}]`
+
+	req, err := http.NewRequest(http.MethodPut, importURL.UploadURL, strings.NewReader(data))
+	mustNoError(t, err, "upload request")
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	mustNoError(t, err, "upload import file")
+	_ = resp.Body.Close()
+
+	task, err := c.CreateImport(importURL.Path, ImportModeUpsert)
+	mustNoError(t, err, "create import")
+	assert.Equal(t, ImportModeUpsert, task.Mode)
+
+	got, err := c.GetImport(task.ID)
+	mustNoError(t, err, "get import")
+	assert.Equal(t, task.ID, got.ID)
+
+	tasks, err := c.ListImports(10, 0)
+	mustNoError(t, err, "list imports")
+	assert.NotEmpty(t, tasks)
+
+	_, err = c.CreateImport(importURL.Path, ImportMode("replace"))
+	mustError(t, err, "invalid mode")
+}
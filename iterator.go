@@ -0,0 +1,340 @@
+package stream_chat // nolint: golint
+
+import "context"
+
+const (
+	maxUsersPageSize    = 100
+	maxChannelsPageSize = 30
+	maxSearchPageSize   = 100
+	maxMembersPageSize  = 100
+
+	// defaultMaxResults is the max of All when it's not set
+	defaultMaxResults = 1000
+)
+
+// offsetIterator pages through the results of an offset paginated query, pages are fetched
+// on demand until a page is shorter than the limit
+type offsetIterator struct {
+	fetch  func(limit, offset int) ([]interface{}, error)
+	limit  int
+	offset int
+
+	page []interface{}
+	done bool
+
+	item interface{}
+	err  error
+}
+
+func newOffsetIterator(limit, offset, maxLimit int, fetch func(limit, offset int) ([]interface{}, error)) offsetIterator {
+	if limit <= 0 || limit > maxLimit {
+		limit = maxLimit
+	}
+
+	return offsetIterator{fetch: fetch, limit: limit, offset: offset}
+}
+
+// fill fetches the next page if the current one is consumed, returns false when there are no more results.
+// The context is checked before every fetch.
+func (it *offsetIterator) fill(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+
+	if len(it.page) > 0 {
+		return true
+	}
+	if it.done {
+		return false
+	}
+
+	if err := ctx.Err(); err != nil {
+		it.err = err
+		return false
+	}
+
+	page, err := it.fetch(it.limit, it.offset)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	it.offset += len(page)
+	if len(page) < it.limit {
+		it.done = true
+	}
+
+	it.page = page
+
+	return len(page) > 0
+}
+
+func (it *offsetIterator) next(ctx context.Context) bool {
+	if !it.fill(ctx) {
+		return false
+	}
+
+	it.item = it.page[0]
+	it.page = it.page[1:]
+
+	return true
+}
+
+// all passes the remaining results to add, returns ErrTooManyResults if there are more than max results,
+// which are left for the next calls. Zero or negative max means defaultMaxResults.
+func (it *offsetIterator) all(ctx context.Context, max int, add func(item interface{})) error {
+	if max <= 0 {
+		max = defaultMaxResults
+	}
+
+	for n := 0; it.fill(ctx); n++ {
+		if n == max {
+			return ErrTooManyResults
+		}
+
+		it.next(ctx)
+		add(it.item)
+	}
+
+	return it.err
+}
+
+// UserIterator iterates over the users matching a query, see IterateUsers
+type UserIterator struct {
+	it offsetIterator
+}
+
+// IterateUsers returns an iterator over the users matching the query starting from its offset,
+// pages of query limit (up to 100) are fetched on demand.
+// Example of usage:
+//
+//	it := client.IterateUsers(&QueryOption{Filter: map[string]interface{}{"role": "admin"}})
+//	for it.Next(ctx) {
+//		user := it.User()
+//	}
+//	if err := it.Err(); err != nil {
+//		// handle error
+//	}
+func (c *Client) IterateUsers(q *QueryOption, sort ...*SortOption) *UserIterator {
+	var query QueryOption
+	if q != nil {
+		query = *q
+	}
+
+	return &UserIterator{it: newOffsetIterator(query.Limit, query.Offset, maxUsersPageSize,
+		func(limit, offset int) ([]interface{}, error) {
+			query.Limit, query.Offset = limit, offset
+
+			users, err := c.QueryUsers(&query, sort...)
+			if err != nil {
+				return nil, err
+			}
+
+			items := make([]interface{}, len(users))
+			for i := range users {
+				items[i] = users[i]
+			}
+			return items, nil
+		})}
+}
+
+// Next advances the iterator to the next user, returns false when there are no more users or on error.
+// The context is checked before fetching a page, a cancelled context stops the iteration with its error.
+func (it *UserIterator) Next(ctx context.Context) bool {
+	return it.it.next(ctx)
+}
+
+// User returns the current user
+func (it *UserIterator) User() *User {
+	user, _ := it.it.item.(*User)
+	return user
+}
+
+// Err returns the error occurred during iteration
+func (it *UserIterator) Err() error {
+	return it.it.err
+}
+
+// All returns the remaining users, ErrTooManyResults along with the first max users if there are more;
+// zero max means up to 1000.
+func (it *UserIterator) All(ctx context.Context, max int) ([]*User, error) {
+	var users []*User
+
+	err := it.it.all(ctx, max, func(item interface{}) {
+		users = append(users, item.(*User))
+	})
+
+	return users, err
+}
+
+// ChannelIterator iterates over the channels matching a query, see IterateChannels
+type ChannelIterator struct {
+	it offsetIterator
+}
+
+// IterateChannels returns an iterator over the channels matching the query starting from its offset,
+// pages of query limit (up to 30) are fetched on demand. See IterateUsers for an example.
+func (c *Client) IterateChannels(q *QueryOption, sort ...*SortOption) *ChannelIterator {
+	var query QueryOption
+	if q != nil {
+		query = *q
+	}
+
+	return &ChannelIterator{it: newOffsetIterator(query.Limit, query.Offset, maxChannelsPageSize,
+		func(limit, offset int) ([]interface{}, error) {
+			query.Limit, query.Offset = limit, offset
+
+			channels, err := c.QueryChannels(&query, sort...)
+			if err != nil {
+				return nil, err
+			}
+
+			items := make([]interface{}, len(channels))
+			for i := range channels {
+				items[i] = channels[i]
+			}
+			return items, nil
+		})}
+}
+
+// Next advances the iterator to the next channel, returns false when there are no more channels or on error.
+// The context is checked before fetching a page, a cancelled context stops the iteration with its error.
+func (it *ChannelIterator) Next(ctx context.Context) bool {
+	return it.it.next(ctx)
+}
+
+// Channel returns the current channel
+func (it *ChannelIterator) Channel() *Channel {
+	ch, _ := it.it.item.(*Channel)
+	return ch
+}
+
+// Err returns the error occurred during iteration
+func (it *ChannelIterator) Err() error {
+	return it.it.err
+}
+
+// All returns the remaining channels, ErrTooManyResults along with the first max channels if there are more;
+// zero max means up to 1000.
+func (it *ChannelIterator) All(ctx context.Context, max int) ([]*Channel, error) {
+	var channels []*Channel
+
+	err := it.it.all(ctx, max, func(item interface{}) {
+		channels = append(channels, item.(*Channel))
+	})
+
+	return channels, err
+}
+
+// SearchIterator iterates over the messages matching a search, see IterateSearch
+type SearchIterator struct {
+	it offsetIterator
+}
+
+// IterateSearch returns an iterator over the messages matching the search starting from its offset,
+// pages of request limit (up to 100) are fetched on demand. See IterateUsers for an example.
+func (c *Client) IterateSearch(request SearchRequest) *SearchIterator {
+	return &SearchIterator{it: newOffsetIterator(request.Limit, request.Offset, maxSearchPageSize,
+		func(limit, offset int) ([]interface{}, error) {
+			request.Limit, request.Offset = limit, offset
+
+			messages, err := c.Search(request)
+			if err != nil {
+				return nil, err
+			}
+
+			items := make([]interface{}, len(messages))
+			for i := range messages {
+				items[i] = messages[i]
+			}
+			return items, nil
+		})}
+}
+
+// Next advances the iterator to the next message, returns false when there are no more messages or on error.
+// The context is checked before fetching a page, a cancelled context stops the iteration with its error.
+func (it *SearchIterator) Next(ctx context.Context) bool {
+	return it.it.next(ctx)
+}
+
+// Message returns the current message
+func (it *SearchIterator) Message() *Message {
+	msg, _ := it.it.item.(*Message)
+	return msg
+}
+
+// Err returns the error occurred during iteration
+func (it *SearchIterator) Err() error {
+	return it.it.err
+}
+
+// All returns the remaining messages, ErrTooManyResults along with the first max messages if there are more;
+// zero max means up to 1000.
+func (it *SearchIterator) All(ctx context.Context, max int) ([]*Message, error) {
+	var messages []*Message
+
+	err := it.it.all(ctx, max, func(item interface{}) {
+		messages = append(messages, item.(*Message))
+	})
+
+	return messages, err
+}
+
+// MemberIterator iterates over the channel members matching a query, see IterateMembers
+type MemberIterator struct {
+	it offsetIterator
+}
+
+// IterateMembers returns an iterator over the channel members matching the query starting from its offset,
+// pages of query limit (up to 100) are fetched on demand. See IterateUsers for an example.
+func (ch *Channel) IterateMembers(q *QueryOption, sort ...*SortOption) *MemberIterator {
+	var query QueryOption
+	if q != nil {
+		query = *q
+	}
+
+	return &MemberIterator{it: newOffsetIterator(query.Limit, query.Offset, maxMembersPageSize,
+		func(limit, offset int) ([]interface{}, error) {
+			query.Limit, query.Offset = limit, offset
+
+			members, err := ch.QueryMembers(&query, sort...)
+			if err != nil {
+				return nil, err
+			}
+
+			items := make([]interface{}, len(members))
+			for i := range members {
+				items[i] = members[i]
+			}
+			return items, nil
+		})}
+}
+
+// Next advances the iterator to the next member, returns false when there are no more members or on error.
+// The context is checked before fetching a page, a cancelled context stops the iteration with its error.
+func (it *MemberIterator) Next(ctx context.Context) bool {
+	return it.it.next(ctx)
+}
+
+// Member returns the current member
+func (it *MemberIterator) Member() *ChannelMember {
+	member, _ := it.it.item.(*ChannelMember)
+	return member
+}
+
+// Err returns the error occurred during iteration
+func (it *MemberIterator) Err() error {
+	return it.it.err
+}
+
+// All returns the remaining members, ErrTooManyResults along with the first max members if there are more;
+// zero max means up to 1000.
+func (it *MemberIterator) All(ctx context.Context, max int) ([]*ChannelMember, error) {
+	var members []*ChannelMember
+
+	err := it.it.all(ctx, max, func(item interface{}) {
+		members = append(members, item.(*ChannelMember))
+	})
+
+	return members, err
+}
@@ -0,0 +1,134 @@
+package stream_chat // nolint: golint
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_IterateUsers(t *testing.T) {
+	var pages []string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]map[string]interface{}
+		assert.NoError(t, json.Unmarshal([]byte(r.URL.Query().Get("payload")), &payload), "payload")
+
+		q := payload["filter_conditions"]
+		limit, offset := int(q["limit"].(float64)), 0
+		if o, ok := q["offset"].(float64); ok {
+			offset = int(o)
+		}
+		pages = append(pages, fmt.Sprintf("%d+%d", offset, limit))
+
+		// 5 users in total
+		var users []string
+		for i := offset; i < offset+limit && i < 5; i++ {
+			users = append(users, fmt.Sprintf(`{"id":"user-%d"}`, i))
+		}
+		_, _ = fmt.Fprintf(w, `{"users":[%s]}`, strings.Join(users, ","))
+	}))
+	defer srv.Close()
+
+	c, err := NewClient("key", []byte("secret"), WithBaseURL(srv.URL))
+	mustNoError(t, err, "new client")
+
+	ctx := context.Background()
+
+	it := c.IterateUsers(&QueryOption{Filter: map[string]interface{}{"role": "user"}, Limit: 2})
+
+	var ids []string
+	for it.Next(ctx) {
+		ids = append(ids, it.User().ID)
+	}
+	mustNoError(t, it.Err(), "iterate users")
+
+	assert.Equal(t, []string{"user-0", "user-1", "user-2", "user-3", "user-4"}, ids)
+	assert.Equal(t, []string{"0+2", "2+2", "4+2"}, pages)
+
+	users, err := c.IterateUsers(&QueryOption{Limit: 2, Offset: 1}).All(ctx, 10)
+	mustNoError(t, err, "all users")
+	assert.Len(t, users, 4)
+
+	users, err = c.IterateUsers(&QueryOption{Limit: 2}).All(ctx, 0)
+	mustNoError(t, err, "all users up to the default max")
+	assert.Len(t, users, 5)
+
+	it = c.IterateUsers(&QueryOption{Limit: 2})
+	users, err = it.All(ctx, 3)
+	assert.Equal(t, ErrTooManyResults, err)
+	assert.Len(t, users, 3, "first max users are returned")
+
+	if assert.True(t, it.Next(ctx), "remaining users are kept") {
+		assert.Equal(t, "user-3", it.User().ID)
+	}
+
+	pages = nil
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+
+	it = c.IterateUsers(&QueryOption{Limit: 2})
+	assert.False(t, it.Next(cancelled))
+	assert.Equal(t, context.Canceled, it.Err())
+	assert.Empty(t, pages, "no page is fetched")
+}
+
+func TestChannel_IterateMembers(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/members", r.URL.Path)
+
+		var payload queryMembersRequest
+		assert.NoError(t, json.Unmarshal([]byte(r.URL.Query().Get("payload")), &payload), "payload")
+		assert.Equal(t, "messaging", payload.Type)
+		assert.Equal(t, "general", payload.ID)
+		assert.Equal(t, map[string]interface{}{"banned": false}, payload.FilterConditions)
+		assert.Equal(t, maxMembersPageSize, payload.Limit)
+
+		// one more member than the default max of All
+		var members []string
+		for i := payload.Offset; i < payload.Offset+payload.Limit && i <= defaultMaxResults; i++ {
+			members = append(members, fmt.Sprintf(`{"user_id":"user-%d"}`, i))
+		}
+		_, _ = fmt.Fprintf(w, `{"members":[%s]}`, strings.Join(members, ","))
+	}))
+	defer srv.Close()
+
+	c, err := NewClient("key", []byte("secret"), WithBaseURL(srv.URL))
+	mustNoError(t, err, "new client")
+
+	ch := &Channel{Type: "messaging", ID: "general", client: c}
+
+	it := ch.IterateMembers(&QueryOption{Filter: map[string]interface{}{"banned": false}})
+	members, err := it.All(context.Background(), 0)
+	assert.Equal(t, ErrTooManyResults, err)
+	assert.Len(t, members, defaultMaxResults, "all is bounded without max")
+
+	if assert.True(t, it.Next(context.Background()), "remaining members are kept") {
+		assert.Equal(t, "user-1000", it.Member().UserID)
+	}
+	assert.False(t, it.Next(context.Background()))
+	mustNoError(t, it.Err(), "iterate members")
+}
+
+func TestClient_IterateChannels(t *testing.T) {
+	c := initClient(t)
+
+	ch := initChannel(t, c)
+	defer func() {
+		mustNoError(t, ch.Delete(), "delete channel")
+	}()
+
+	channels, err := c.IterateChannels(&QueryOption{
+		Filter: map[string]interface{}{"cid": ch.CID},
+	}).All(context.Background(), 10)
+	mustNoError(t, err, "iterate channels")
+	if assert.Len(t, channels, 1) {
+		assert.Equal(t, ch.CID, channels[0].CID)
+	}
+}
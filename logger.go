@@ -0,0 +1,112 @@
+package stream_chat // nolint: golint
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+type LogLevel int
+
+const (
+	LogLevelDebug LogLevel = iota // every request
+	LogLevelWarn                  // rejected requests, ie rate limited
+	LogLevelError                 // network and server errors
+)
+
+func (l LogLevel) String() string {
+	switch l {
+	case LogLevelDebug:
+		return "debug"
+	case LogLevelWarn:
+		return "warn"
+	case LogLevelError:
+		return "error"
+	}
+
+	return "unknown"
+}
+
+// Logger logs the requests of the client, fields are method, path, duration, status,
+// rate_limit_remaining when known and error for failed requests
+type Logger interface {
+	Log(level LogLevel, msg string, fields map[string]interface{})
+}
+
+type stdLogger struct {
+	l *log.Logger
+}
+
+// NewStdLogger returns a Logger writing key=value lines to l, log.Printf if l is nil
+func NewStdLogger(l *log.Logger) Logger {
+	return stdLogger{l: l}
+}
+
+func (s stdLogger) Log(level LogLevel, msg string, fields map[string]interface{}) {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "level=%s msg=%q", level, msg)
+	for _, k := range keys {
+		fmt.Fprintf(&b, " %s=%v", k, fields[k])
+	}
+
+	if s.l == nil {
+		log.Print(b.String())
+		return
+	}
+	s.l.Print(b.String())
+}
+
+// SetLogger sets the logger of the requests with level or above, nil logger disables logging.
+// Successful requests are logged at debug level.
+func (c *Client) SetLogger(logger Logger, level LogLevel) {
+	c.logger = logger
+	c.logLevel = level
+}
+
+func (c *Client) logRequest(r *http.Request, resp *http.Response, err error, duration time.Duration) {
+	if c.logger == nil {
+		return
+	}
+
+	level := LogLevelDebug
+	msg := "request"
+
+	fields := map[string]interface{}{
+		"method":   r.Method,
+		"path":     r.URL.Path,
+		"duration": duration,
+	}
+
+	switch {
+	case err != nil:
+		level, msg = LogLevelError, "request failed"
+		fields["error"] = err.Error()
+	case resp.StatusCode >= http.StatusInternalServerError:
+		level, msg = LogLevelError, "request failed"
+	case resp.StatusCode >= http.StatusBadRequest:
+		level, msg = LogLevelWarn, "request rejected"
+	}
+
+	if level < c.logLevel {
+		return
+	}
+
+	if resp != nil {
+		fields["status"] = resp.StatusCode
+		if limit := newRateLimitInfo(resp.Header); limit != nil {
+			fields["rate_limit_remaining"] = limit.Remaining
+		}
+	}
+
+	c.logger.Log(level, msg, fields)
+}
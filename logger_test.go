@@ -0,0 +1,62 @@
+package stream_chat // nolint: golint
+
+import (
+	"bytes"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type logFunc func(level LogLevel, msg string, fields map[string]interface{})
+
+func (f logFunc) Log(level LogLevel, msg string, fields map[string]interface{}) {
+	f(level, msg, fields)
+}
+
+func TestClient_SetLogger(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "60")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	c, _ := NewClient("key", []byte("secret"))
+	c.BaseURL = srv.URL
+
+	var (
+		levels []LogLevel
+		fields []map[string]interface{}
+	)
+	c.SetLogger(logFunc(func(level LogLevel, msg string, f map[string]interface{}) {
+		levels = append(levels, level)
+		fields = append(fields, f)
+	}), LogLevelWarn)
+
+	_, err := c.GetCommand("location")
+	mustNoError(t, err, "get command")
+	assert.Empty(t, levels, "successful requests are logged at debug level")
+
+	mustError(t, c.DeleteCommand("location"), "rate limited")
+	if assert.Len(t, levels, 1) {
+		assert.Equal(t, LogLevelWarn, levels[0])
+		assert.Equal(t, "/commands/location", fields[0]["path"])
+		assert.Equal(t, http.StatusTooManyRequests, fields[0]["status"])
+		assert.Equal(t, int64(0), fields[0]["rate_limit_remaining"])
+		assert.Contains(t, fields[0], "duration")
+	}
+
+	var buf bytes.Buffer
+	c.SetLogger(NewStdLogger(log.New(&buf, "", 0)), LogLevelDebug)
+
+	_, err = c.GetCommand("location")
+	mustNoError(t, err, "get command")
+	assert.Contains(t, buf.String(), `level=debug msg="request" `)
+	assert.Contains(t, buf.String(), "method=GET path=/commands/location rate_limit_remaining=0 status=200")
+}
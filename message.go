@@ -1,11 +1,19 @@
 package stream_chat // nolint: golint
 
 import (
+	"crypto/rand"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
 	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
+
+	"github.com/getstream/easyjson"
 )
 
 type MessageType string
@@ -31,31 +39,116 @@ type Message struct {
 	LatestReactions []*Reaction    `json:"latest_reactions"` // last reactions
 	OwnReactions    []*Reaction    `json:"own_reactions"`
 	ReactionCounts  map[string]int `json:"reaction_counts"`
+	ReactionScores  map[string]int `json:"reaction_scores"` // sum of reaction scores by type, ie total claps
 
 	ParentID      string `json:"parent_id"`       // id of parent message if it's reply
 	ShowInChannel bool   `json:"show_in_channel"` // show reply message also in channel
 
-	ReplyCount int `json:"reply_count,omitempty"`
+	ReplyCount         int     `json:"reply_count,omitempty"`
+	ThreadParticipants []*User `json:"thread_participants,omitempty"` // users replied to the message
+
+	QuotedMessageID string   `json:"quoted_message_id,omitempty"` // id of quoted message
+	QuotedMessage   *Message `json:"quoted_message,omitempty"`
+
+	Silent bool `json:"silent,omitempty"` // silent messages don't increase unread counts and trigger push
+
+	// command of the message text, ie "giphy" and "cats" for "/giphy cats"; set by server
+	Command string `json:"command,omitempty"`
+	Args    string `json:"args,omitempty"`
+
+	// translations of the message text; language code => text, ie {"fr_text": "bonjour"}
+	I18n map[string]string `json:"i18n,omitempty"`
 
+	// users mentioned in the message; only IDs are sent, full users are returned, see ParseMentions
 	MentionedUsers []*User `json:"mentioned_users"`
 
+	PollID string `json:"poll_id,omitempty"` // id of poll sent with the message, see CreatePoll
+	Poll   *Poll  `json:"poll,omitempty"`
+
+	Pinned     bool       `json:"pinned,omitempty"`
+	PinnedAt   *time.Time `json:"pinned_at,omitempty"`
+	PinnedBy   *User      `json:"pinned_by,omitempty"`
+	PinExpires *time.Time `json:"pin_expires,omitempty"` // pin is permanent if nil
+
 	CreatedAt *time.Time `json:"created_at,omitempty"`
 	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 
-	// any other fields the user wants to attach a message
-	ExtraData map[string]interface{}
+	// any other fields the user wants to attach a message.
+	// Numbers are decoded as float64, integers beyond 2^53 lose precision; store them as strings.
+	ExtraData map[string]interface{} `json:"-,extra"` //nolint: staticcheck
+}
+
+// Language returns the detected language of the message text, it's available when translation is enabled
+func (m *Message) Language() string {
+	return m.I18n["language"]
+}
+
+// MentionedUserIDs returns the IDs of the users mentioned in the message
+func (m *Message) MentionedUserIDs() []string {
+	ids := make([]string, 0, len(m.MentionedUsers))
+
+	for _, u := range m.MentionedUsers {
+		ids = append(ids, u.ID)
+	}
+
+	return ids
+}
+
+// a mention is @ followed by a user ID, not preceded by an ID character to skip emails
+// nolint: gochecknoglobals
+var mentionRegexp = regexp.MustCompile(`(?:^|[^a-zA-Z0-9_.@-])@([a-zA-Z0-9_-]+)`)
+
+// ParseMentions returns the unique user IDs mentioned in the text as @userID, in order of appearance.
+// Set them as Message.MentionedUsers to notify the users:
+//
+//	for _, id := range ParseMentions(text) {
+//		msg.MentionedUsers = append(msg.MentionedUsers, &User{ID: id})
+//	}
+func ParseMentions(text string) []string {
+	var ids []string
+
+	seen := make(map[string]bool)
+	for _, match := range mentionRegexp.FindAllStringSubmatch(text, -1) {
+		id := match[1]
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+// OGAttachments returns the link preview attachments added by URL enrichment
+func (m *Message) OGAttachments() []*Attachment {
+	var og []*Attachment
+
+	for _, a := range m.Attachments {
+		if a.IsOG() {
+			og = append(og, a)
+		}
+	}
+
+	return og
 }
 
 func (m *Message) toRequest() messageRequest {
 	var req messageRequest
 
 	req.Message = messageRequestMessage{
-		Text:          m.Text,
-		Attachments:   m.Attachments,
-		User:          messageRequestUser{ID: m.User.ID},
-		ExtraData:     m.ExtraData,
-		ParentID:      m.ParentID,
-		ShowInChannel: m.ShowInChannel,
+		ID:              m.ID,
+		Text:            m.Text,
+		Type:            m.Type,
+		Attachments:     m.Attachments,
+		User:            messageRequestUser{ID: m.User.ID},
+		ExtraData:       m.ExtraData,
+		ParentID:        m.ParentID,
+		ShowInChannel:   m.ShowInChannel,
+		Pinned:          m.Pinned,
+		PinExpires:      m.PinExpires,
+		QuotedMessageID: m.QuotedMessageID,
+		Silent:          m.Silent,
+		PollID:          m.PollID,
 	}
 
 	if len(m.MentionedUsers) > 0 {
@@ -69,45 +162,104 @@ func (m *Message) toRequest() messageRequest {
 }
 
 type messageRequest struct {
-	Message messageRequestMessage `json:"message"`
+	Message  messageRequestMessage `json:"message"`
+	SkipPush bool                  `json:"skip_push,omitempty"`
+	Pending  bool                  `json:"pending,omitempty"`
+
+	SkipEnrichURL bool `json:"skip_enrich_url,omitempty"`
+}
+
+// SendMessageOption is an option of SendMessage request
+type SendMessageOption func(*messageRequest)
+
+// MessageSkipPush disables push notifications for the message
+func MessageSkipPush() SendMessageOption {
+	return func(r *messageRequest) {
+		r.SkipPush = true
+	}
+}
+
+// MessageSkipEnrichURL disables URL enrichment of the message, no preview attachments
+// are added for links in the message text
+func MessageSkipEnrichURL() SendMessageOption {
+	return func(r *messageRequest) {
+		r.SkipEnrichURL = true
+	}
+}
+
+// MessageIdempotencyKey makes retries of the send safe, the key is the ID of the message, so
+// a retry with the same key fails with a duplicate message ID error instead of storing the message twice.
+// It overrides Message.ID, ie to send a shared message template to many channels with a key per channel.
+func MessageIdempotencyKey(key string) SendMessageOption {
+	return func(r *messageRequest) {
+		r.Message.ID = key
+	}
+}
+
+// MessagePending sends the message as pending; pending messages are visible only to the sender
+// until they are committed
+func MessagePending() SendMessageOption {
+	return func(r *messageRequest) {
+		r.Pending = true
+	}
 }
 
 type messageRequestMessage struct {
-	Text           string                 `json:"text"`
-	Attachments    []*Attachment          `json:"attachments"`
-	User           messageRequestUser     `json:"user"`
-	MentionedUsers []string               `json:"mentioned_users"`
-	ParentID       string                 `json:"parent_id"`
-	ShowInChannel  bool                   `json:"show_in_channel"`
-	ExtraData      map[string]interface{} `json:"-,extra"` //nolint: staticcheck
+	ID              string                 `json:"id,omitempty"`
+	Text            string                 `json:"text"`
+	Type            MessageType            `json:"type,omitempty"`
+	Attachments     []*Attachment          `json:"attachments"`
+	User            messageRequestUser     `json:"user"`
+	MentionedUsers  []string               `json:"mentioned_users"`
+	ParentID        string                 `json:"parent_id"`
+	ShowInChannel   bool                   `json:"show_in_channel"`
+	Pinned          bool                   `json:"pinned,omitempty"`
+	PinExpires      *time.Time             `json:"pin_expires,omitempty"`
+	QuotedMessageID string                 `json:"quoted_message_id,omitempty"`
+	Silent          bool                   `json:"silent,omitempty"`
+	PollID          string                 `json:"poll_id,omitempty"`
+	ExtraData       map[string]interface{} `json:"-,extra"` //nolint: staticcheck
 }
 
 type messageRequestUser struct {
 	ID string `json:"id"`
 }
 
-type messageResponse struct {
-	Message *Message `json:"message"`
+// MessageResponse is the response of a message request with the complete message as stored by the server
+type MessageResponse struct {
+	Message  *Message `json:"message"`
+	Duration string   `json:"duration"` // server side processing time, ie "1.25ms"
 }
 
-type Attachment struct {
-	Type string `json:"type,omitempty"` // text, image, audio, video
-
-	AuthorName string `json:"author_name,omitempty"`
-	Title      string `json:"title,omitempty"`
-	TitleLink  string `json:"title_link,omitempty"`
-	Text       string `json:"text,omitempty"`
+// newMessageID returns a random UUID v4 to identify a message before it's sent
+func newMessageID() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
 
-	ImageURL    string `json:"image_url,omitempty"`
-	ThumbURL    string `json:"thumb_url,omitempty"`
-	AssetURL    string `json:"asset_url,omitempty"`
-	OGScrapeURL string `json:"og_scrape_url,omitempty"`
+	b[6] = b[6]&0x0f | 0x40 // version 4
+	b[8] = b[8]&0x3f | 0x80 // RFC 4122 variant
 
-	ExtraData map[string]interface{} `json:"-,extra"` //nolint: staticcheck
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:]), nil
 }
 
 // SendMessage sends a message to the channel. Returns full message details from server
-func (ch *Channel) SendMessage(message *Message, userID string) (*Message, error) {
+// options: optional request options, ie MessageSkipPush()
+func (ch *Channel) SendMessage(message *Message, userID string, options ...SendMessageOption) (*Message, error) {
+	resp, err := ch.SendMessageWithResponse(message, userID, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Message, nil
+}
+
+// SendMessageWithResponse sends a message to the channel, the given message isn't modified.
+// The message is sent with a generated ID if it has none, set Message.ID or use MessageIdempotencyKey
+// to retry sends safely: an error of duplicate message ID on retry means the message was already stored.
+// Returns the complete message from server, ie with attachments enriched and mentioned users.
+func (ch *Channel) SendMessageWithResponse(message *Message, userID string, options ...SendMessageOption) (*MessageResponse, error) {
 	switch {
 	case message == nil:
 		return nil, errors.New("message is nil")
@@ -115,21 +267,57 @@ func (ch *Channel) SendMessage(message *Message, userID string) (*Message, error
 		return nil, errors.New("user ID must be not empty")
 	}
 
-	var resp messageResponse
+	for _, attachment := range message.Attachments {
+		if attachment == nil {
+			return nil, errors.New("attachment is nil")
+		}
+		if err := attachment.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	var resp MessageResponse
 
-	message.User = &User{ID: userID}
+	msg := *message
+	msg.User = &User{ID: userID}
 
 	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID), "message")
 
-	err := ch.client.makeRequest(http.MethodPost, p, nil, message.toRequest(), &resp)
+	req := msg.toRequest()
+	for _, opt := range options {
+		opt(&req)
+	}
+
+	if req.Message.ID == "" {
+		id, err := newMessageID()
+		if err != nil {
+			return nil, err
+		}
+		req.Message.ID = id
+	}
+
+	err := ch.client.makeRequest(http.MethodPost, p, nil, req, &resp)
 	if err != nil {
 		return nil, err
 	}
 
-	return resp.Message, nil
+	return &resp, nil
+}
+
+// SendSystemMessage sends a system message with the text to the channel, ie an order update.
+// Push notifications are skipped, send a message with MessageTypeSystem by SendMessage to push it.
+func (ch *Channel) SendSystemMessage(text, userID string, options ...SendMessageOption) (*Message, error) {
+	if text == "" {
+		return nil, errors.New("message text is empty")
+	}
+
+	msg := &Message{Text: text, Type: MessageTypeSystem}
+
+	return ch.SendMessage(msg, userID, append([]SendMessageOption{MessageSkipPush()}, options...)...)
 }
 
-// MarkAllRead marks all messages as read for userID
+// MarkAllRead marks all messages of all channels as read for userID,
+// unread counts of the user are reset to zero
 func (c *Client) MarkAllRead(userID string) error {
 	if userID == "" {
 		return errors.New("user ID must be not empty")
@@ -150,7 +338,7 @@ func (c *Client) GetMessage(msgID string) (*Message, error) {
 		return nil, errors.New("message ID must be not empty")
 	}
 
-	var resp messageResponse
+	var resp MessageResponse
 
 	p := path.Join("messages", url.PathEscape(msgID))
 
@@ -171,7 +359,7 @@ func (c *Client) UpdateMessage(msg *Message, msgID string) (*Message, error) {
 		return nil, errors.New("message ID must be not empty")
 	}
 
-	var resp messageResponse
+	var resp MessageResponse
 
 	p := path.Join("messages", url.PathEscape(msgID))
 
@@ -184,28 +372,108 @@ func (c *Client) UpdateMessage(msg *Message, msgID string) (*Message, error) {
 }
 
 func (c *Client) DeleteMessage(msgID string) error {
+	return c.deleteMessage(msgID, false)
+}
+
+// HardDeleteMessage permanently removes the message with given ID, it can't be restored
+func (c *Client) HardDeleteMessage(msgID string) error {
+	return c.deleteMessage(msgID, true)
+}
+
+func (c *Client) deleteMessage(msgID string, hard bool) error {
 	if msgID == "" {
 		return errors.New("message ID must be not empty")
 	}
 
 	p := path.Join("messages", url.PathEscape(msgID))
 
-	return c.makeRequest(http.MethodDelete, p, nil, nil, nil)
+	var params url.Values
+	if hard {
+		params = url.Values{"hard": []string{"true"}}
+	}
+
+	return c.makeRequest(http.MethodDelete, p, params, nil, nil)
 }
 
-func (c *Client) FlagMessage(msgID string) error {
-	if msgID == "" {
-		return errors.New("message ID is empty")
+// number of concurrent requests of DeleteMessages
+const deleteMessagesConcurrency = 4
+
+// DeleteMessages deletes the messages with given IDs, permanently if hard is set.
+// The API has no bulk delete of messages so they are deleted concurrently one by one,
+// the first error stops deleting the remaining messages.
+// To delete all messages of a user, ie a spammer, use DeleteUsers with Messages option instead.
+func (c *Client) DeleteMessages(msgIDs []string, hard bool) error {
+	if len(msgIDs) == 0 {
+		return errors.New("message IDs are empty")
 	}
 
-	options := map[string]interface{}{
-		"target_message_id": msgID,
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+		done     = make(chan struct{})
+		ids      = make(chan string)
+	)
+
+	for i := 0; i < deleteMessagesConcurrency && i < len(msgIDs); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range ids {
+				if err := c.deleteMessage(id, hard); err != nil {
+					errOnce.Do(func() {
+						firstErr = err
+						close(done)
+					})
+				}
+			}
+		}()
+	}
+
+loop:
+	for _, id := range msgIDs {
+		select {
+		case ids <- id:
+		case <-done:
+			break loop
+		}
+	}
+	close(ids)
+	wg.Wait()
+
+	return firstErr
+}
+
+// FlagMessage flags the message with given ID for moderation
+func (c *Client) FlagMessage(msgID string) error {
+	return c.flagMessage("moderation/flag", msgID, "")
+}
+
+// FlagMessageByUser flags the message with given ID on behalf of userID,
+// flags show up in the moderation dashboard with the user as reporter
+func (c *Client) FlagMessageByUser(msgID, userID string) error {
+	if userID == "" {
+		return errors.New("user ID is empty")
 	}
 
-	return c.makeRequest(http.MethodPost, "moderation/flag", nil, options, nil)
+	return c.flagMessage("moderation/flag", msgID, userID)
 }
 
+// UnflagMessage removes the flag from the message with given ID
 func (c *Client) UnflagMessage(msgID string) error {
+	return c.flagMessage("moderation/unflag", msgID, "")
+}
+
+// UnflagMessageByUser removes the flag userID set on the message with given ID
+func (c *Client) UnflagMessageByUser(msgID, userID string) error {
+	if userID == "" {
+		return errors.New("user ID is empty")
+	}
+
+	return c.flagMessage("moderation/unflag", msgID, userID)
+}
+
+func (c *Client) flagMessage(p, msgID, userID string) error {
 	if msgID == "" {
 		return errors.New("message ID is empty")
 	}
@@ -214,36 +482,442 @@ func (c *Client) UnflagMessage(msgID string) error {
 		"target_message_id": msgID,
 	}
 
-	return c.makeRequest(http.MethodPost, "moderation/unflag", nil, options, nil)
+	if userID != "" {
+		options["user_id"] = userID
+	}
+
+	return c.makeRequest(http.MethodPost, p, nil, options, nil)
+}
+
+type translateMessageRequest struct {
+	Language string `json:"language"`
+}
+
+// TranslateMessage translates the message with given ID to the language, ie "fr".
+// Returns the message with translations in Message.I18n
+func (c *Client) TranslateMessage(msgID, language string) (*Message, error) {
+	switch {
+	case msgID == "":
+		return nil, errors.New("message ID must be not empty")
+	case language == "":
+		return nil, errors.New("language must be not empty")
+	}
+
+	p := path.Join("messages", url.PathEscape(msgID), "translate")
+
+	var resp MessageResponse
+
+	err := c.makeRequest(http.MethodPost, p, nil, translateMessageRequest{Language: language}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Message, nil
+}
+
+type pinMessageRequest struct {
+	Set    map[string]interface{} `json:"set"`
+	UserID string                 `json:"user_id"`
+}
+
+// PinMessage pins the message with given ID on behalf of userID
+// expiration: optional time when the pin expires, the pin is permanent if nil
+func (c *Client) PinMessage(msgID, userID string, expiration *time.Time) (*Message, error) {
+	set := map[string]interface{}{
+		"pinned": true,
+	}
+
+	if expiration != nil {
+		set["pin_expires"] = expiration.UTC().Format(time.RFC3339Nano)
+	}
+
+	return c.pinMessage(msgID, userID, set)
+}
+
+// UnpinMessage unpins the message with given ID on behalf of userID
+func (c *Client) UnpinMessage(msgID, userID string) (*Message, error) {
+	return c.pinMessage(msgID, userID, map[string]interface{}{"pinned": false})
+}
+
+func (c *Client) pinMessage(msgID, userID string, set map[string]interface{}) (*Message, error) {
+	switch {
+	case msgID == "":
+		return nil, errors.New("message ID must be not empty")
+	case userID == "":
+		return nil, errors.New("user ID must be not empty")
+	}
+
+	p := path.Join("messages", url.PathEscape(msgID))
+
+	var resp MessageResponse
+
+	err := c.makeRequest(http.MethodPut, p, nil, pinMessageRequest{Set: set, UserID: userID}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Message, nil
+}
+
+// PinnedMessagesOptions are pagination options of pinned messages
+type PinnedMessagesOptions struct {
+	Limit  int `json:"limit,omitempty"`
+	Offset int `json:"offset,omitempty"`
+
+	PinnedAtBefore *time.Time `json:"pinned_at_before,omitempty"`
+	PinnedAtAfter  *time.Time `json:"pinned_at_after,omitempty"`
+}
+
+type pinnedMessagesResponse struct {
+	Messages []*Message `json:"messages"`
+}
+
+// GetPinnedMessages returns pinned messages of the channel
+func (ch *Channel) GetPinnedMessages(options PinnedMessagesOptions) ([]*Message, error) {
+	data, err := easyjson.Marshal(options)
+	if err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+	values.Set("payload", string(data))
+
+	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID), "pinned_messages")
+
+	var resp pinnedMessagesResponse
+
+	err = ch.client.makeRequest(http.MethodGet, p, values, nil, &resp)
+
+	return resp.Messages, err
+}
+
+type messagesResponse struct {
+	Messages []*Message `json:"messages"`
+}
+
+// GetMessages returns messages of the channel with given IDs
+func (ch *Channel) GetMessages(msgIDs []string) ([]*Message, error) {
+	if len(msgIDs) == 0 {
+		return nil, errors.New("message IDs are empty")
+	}
+
+	params := url.Values{}
+	params.Set("ids", strings.Join(msgIDs, ","))
+
+	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID), "messages")
+
+	var resp messagesResponse
+
+	err := ch.client.makeRequest(http.MethodGet, p, params, nil, &resp)
+
+	return resp.Messages, err
+}
+
+// RepliesOptions are pagination options of message replies
+type RepliesOptions struct {
+	Limit int // number of replies to return
+
+	IDLT  string // replies with ID less than
+	IDLTE string // replies with ID less than or equal
+	IDGT  string // replies with ID greater than
+	IDGTE string // replies with ID greater than or equal
+
+	CreatedAtBefore        *time.Time
+	CreatedAtBeforeOrEqual *time.Time
+	CreatedAtAfter         *time.Time
+	CreatedAtAfterOrEqual  *time.Time
+}
+
+func (o RepliesOptions) validate() error {
+	switch {
+	case o.Limit < 0:
+		return errors.New("replies limit must be not negative")
+	case o.IDLT != "" && o.IDLTE != "", o.IDGT != "" && o.IDGTE != "":
+		return errors.New("replies pagination by both inclusive and exclusive ID is not allowed")
+	case o.CreatedAtBefore != nil && o.CreatedAtBeforeOrEqual != nil,
+		o.CreatedAtAfter != nil && o.CreatedAtAfterOrEqual != nil:
+		return errors.New("replies pagination by both inclusive and exclusive creation time is not allowed")
+	}
+
+	return nil
+}
+
+func (o RepliesOptions) values() url.Values {
+	values := url.Values{}
+
+	if o.Limit > 0 {
+		values.Set("limit", strconv.Itoa(o.Limit))
+	}
+
+	setString := func(key, value string) {
+		if value != "" {
+			values.Set(key, value)
+		}
+	}
+	setString("id_lt", o.IDLT)
+	setString("id_lte", o.IDLTE)
+	setString("id_gt", o.IDGT)
+	setString("id_gte", o.IDGTE)
+
+	setTime := func(key string, value *time.Time) {
+		if value != nil {
+			values.Set(key, value.UTC().Format(time.RFC3339Nano))
+		}
+	}
+	setTime("created_at_before", o.CreatedAtBefore)
+	setTime("created_at_before_or_equal", o.CreatedAtBeforeOrEqual)
+	setTime("created_at_after", o.CreatedAtAfter)
+	setTime("created_at_after_or_equal", o.CreatedAtAfterOrEqual)
+
+	return values
 }
 
-type repliesResponse struct {
+// RepliesResponse is a page of message replies ordered from oldest to newest
+type RepliesResponse struct {
 	Messages []*Message `json:"messages"`
+
+	// pagination cursors; use FirstID as IDLT to get older replies or LastID as IDGT to get newer ones
+	FirstID string `json:"-"`
+	LastID  string `json:"-"`
 }
 
 // GetReplies returns list of the message replies for a parent message
 // options: Pagination params, ie {limit:10, idlte: 10}
 func (ch *Channel) GetReplies(parentID string, options map[string][]string) ([]*Message, error) {
+	resp, err := ch.getReplies(parentID, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Messages, nil
+}
+
+// GetRepliesWithOptions returns a page of the message replies for a parent message
+func (ch *Channel) GetRepliesWithOptions(parentID string, options RepliesOptions) (*RepliesResponse, error) {
+	if err := options.validate(); err != nil {
+		return nil, err
+	}
+
+	return ch.getReplies(parentID, options.values())
+}
+
+func (ch *Channel) getReplies(parentID string, params url.Values) (*RepliesResponse, error) {
 	if parentID == "" {
 		return nil, errors.New("parent ID is empty")
 	}
 
 	p := path.Join("messages", url.PathEscape(parentID), "replies")
 
-	var resp repliesResponse
+	var resp RepliesResponse
 
-	err := ch.client.makeRequest(http.MethodGet, p, options, nil, &resp)
+	err := ch.client.makeRequest(http.MethodGet, p, params, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
 
-	return resp.Messages, err
+	if n := len(resp.Messages); n > 0 {
+		resp.FirstID = resp.Messages[0].ID
+		resp.LastID = resp.Messages[n-1].ID
+	}
+
+	return &resp, nil
+}
+
+// IterateReplies returns an iterator over the message replies from newest to oldest,
+// starting from the page defined by options; pages are fetched on demand.
+// Example of usage:
+//
+//	it := ch.IterateReplies(parentID, RepliesOptions{Limit: 100})
+//	for it.Next() {
+//		reply := it.Message()
+//	}
+//	if err := it.Err(); err != nil {
+//		// handle error
+//	}
+func (ch *Channel) IterateReplies(parentID string, options RepliesOptions) *MessageIterator {
+	if options.Limit <= 0 {
+		options.Limit = defaultPageLimit
+	}
+
+	return newMessageIterator(options.Limit, func(idLT string) ([]*Message, error) {
+		if idLT != "" {
+			options.IDLT = idLT
+		}
+
+		resp, err := ch.GetRepliesWithOptions(parentID, options)
+		if err != nil {
+			return nil, err
+		}
+
+		return resp.Messages, nil
+	})
+}
+
+// IterateMessages returns an iterator over the channel messages from newest to oldest,
+// starting from the page defined by options; pages are fetched on demand and channel state isn't changed.
+// Example of usage:
+//
+//	it := ch.IterateMessages(MessagePaginationParams{Limit: 100})
+//	for it.Next() {
+//		msg := it.Message()
+//	}
+//	if err := it.Err(); err != nil {
+//		// handle error
+//	}
+func (ch *Channel) IterateMessages(options MessagePaginationParams) *MessageIterator {
+	if options.Limit <= 0 {
+		options.Limit = defaultPageLimit
+	}
+
+	return newMessageIterator(options.Limit, func(idLT string) ([]*Message, error) {
+		if idLT != "" {
+			// older pages are selected by the cursor, lower bounds of options are kept
+			options.IDLT = idLT
+			options.IDLTE = ""
+			options.IDAround = ""
+			options.CreatedAtAround = nil
+			options.CreatedAtBefore = nil
+			options.CreatedAtBeforeOrEqual = nil
+		}
+
+		return ch.queryMessages(options)
+	})
+}
+
+// queryMessages returns the page of channel messages without updating the channel state
+func (ch *Channel) queryMessages(options MessagePaginationParams) ([]*Message, error) {
+	req := ChannelQueryRequest{
+		State:    true,
+		Messages: &options,
+		Members:  &PaginationParams{Limit: 1},
+		Data:     map[string]interface{}{},
+	}
+
+	p := path.Join("channels", url.PathEscape(ch.Type), url.PathEscape(ch.ID), "query")
+
+	var resp queryResponse
+
+	err := ch.client.makeRequest(http.MethodPost, p, nil, req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Messages, nil
+}
+
+// MessageIterator iterates over messages from newest to oldest, fetching older pages on demand
+type MessageIterator struct {
+	fetch func(idLT string) ([]*Message, error)
+	limit int
+
+	page   []*Message
+	cursor string
+	done   bool
+
+	msg *Message
+	err error
+}
+
+// newMessageIterator returns iterator which pages backwards by calling fetch with the ID of
+// the oldest fetched message, fetch must return messages ordered from oldest to newest
+func newMessageIterator(limit int, fetch func(idLT string) ([]*Message, error)) *MessageIterator {
+	return &MessageIterator{fetch: fetch, limit: limit}
+}
+
+// Next advances the iterator to the next message, returns false when there are no more messages or on error
+func (it *MessageIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	if len(it.page) == 0 {
+		if it.done {
+			return false
+		}
+
+		page, err := it.fetch(it.cursor)
+		if err != nil {
+			it.err = err
+			return false
+		}
+
+		if len(page) < it.limit {
+			it.done = true
+		}
+		if len(page) == 0 {
+			return false
+		}
+
+		it.page = page
+		it.cursor = page[0].ID
+	}
+
+	it.msg = it.page[len(it.page)-1]
+	it.page = it.page[:len(it.page)-1]
+
+	return true
+}
+
+// Message returns the current message
+func (it *MessageIterator) Message() *Message {
+	return it.msg
+}
+
+// Err returns the error occurred during iteration
+func (it *MessageIterator) Err() error {
+	return it.err
 }
 
 type sendActionRequest struct {
 	MessageID string            `json:"message_id"`
 	FormData  map[string]string `json:"form_data"`
+	UserID    string            `json:"user_id,omitempty"`
+
+	// channel of the message
+	ID   string `json:"id"`
+	Type string `json:"type"`
 }
 
 // SendAction for message
 func (ch *Channel) SendAction(msgID string, formData map[string]string) (*Message, error) {
+	return ch.sendAction(msgID, "", formData)
+}
+
+// SendActionAsUser submits an attachment action of the message on behalf of the user with given ID,
+// ie {"image_action": "shuffle"} for a giphy. Returns the updated message; it's nil if the action removed it.
+func (ch *Channel) SendActionAsUser(msgID, userID string, formData map[string]string) (*Message, error) {
+	if userID == "" {
+		return nil, errors.New("user ID is empty")
+	}
+
+	return ch.sendAction(msgID, userID, formData)
+}
+
+// GiphyAction is an action of an ephemeral giphy message
+type GiphyAction string
+
+const (
+	GiphyActionSend    GiphyAction = "send"    // send the giphy to the channel
+	GiphyActionShuffle GiphyAction = "shuffle" // replace the giphy with another one of the command args
+	GiphyActionCancel  GiphyAction = "cancel"  // remove the ephemeral message
+)
+
+// SendGiphyAction completes the giphy command of the user with given ID, ie a bot choosing a gif:
+//
+//	msg, err := ch.SendMessage(&Message{Text: "/giphy cats"}, userID)
+//	...
+//	msg, err = ch.SendGiphyAction(msg.ID, userID, GiphyActionShuffle)
+//	...
+//	msg, err = ch.SendGiphyAction(msg.ID, userID, GiphyActionSend)
+func (ch *Channel) SendGiphyAction(msgID, userID string, action GiphyAction) (*Message, error) {
+	if action == "" {
+		return nil, errors.New("giphy action is empty")
+	}
+
+	return ch.SendActionAsUser(msgID, userID, map[string]string{"image_action": string(action)})
+}
+
+func (ch *Channel) sendAction(msgID, userID string, formData map[string]string) (*Message, error) {
 	switch {
 	case msgID == "":
 		return nil, errors.New("message ID is empty")
@@ -253,10 +927,59 @@ func (ch *Channel) SendAction(msgID string, formData map[string]string) (*Messag
 
 	p := path.Join("messages", url.PathEscape(msgID), "action")
 
-	data := sendActionRequest{MessageID: msgID, FormData: formData}
+	data := sendActionRequest{
+		MessageID: msgID,
+		FormData:  formData,
+		UserID:    userID,
+		ID:        ch.ID,
+		Type:      ch.Type,
+	}
 
-	var resp messageResponse
+	var resp MessageResponse
 
 	err := ch.client.makeRequest(http.MethodPost, p, nil, data, &resp)
 	return resp.Message, err
 }
+
+// MessageHistoryEntry is a prior version of an edited message, custom fields of the version are in ExtraData
+type MessageHistoryEntry struct {
+	MessageID          string        `json:"message_id"`
+	Text               string        `json:"text"`
+	Attachments        []*Attachment `json:"attachments"`
+	MessageUpdatedByID string        `json:"message_updated_by_id"` // editor of the version
+	MessageUpdatedAt   time.Time     `json:"message_updated_at"`
+
+	ExtraData map[string]interface{} `json:"-,extra"` //nolint: staticcheck
+}
+
+// QueryMessageHistoryRequest filters message history, filter must contain message_id, ie {"message_id": "id"}.
+// Next/Prev are cursors of a previous QueryMessageHistoryResponse.
+type QueryMessageHistoryRequest struct {
+	Filter map[string]interface{} `json:"filter"`
+	Sort   []*SortOption          `json:"sort,omitempty"`
+	Limit  int                    `json:"limit,omitempty"`
+	Next   string                 `json:"next,omitempty"`
+	Prev   string                 `json:"prev,omitempty"`
+}
+
+type QueryMessageHistoryResponse struct {
+	MessageHistory []*MessageHistoryEntry `json:"message_history"`
+	Next           string                 `json:"next"`
+	Prev           string                 `json:"prev"`
+}
+
+// QueryMessageHistory returns the prior versions of edited messages, newest first by default
+func (c *Client) QueryMessageHistory(req QueryMessageHistoryRequest) (*QueryMessageHistoryResponse, error) {
+	if len(req.Filter) == 0 {
+		return nil, errors.New("filter is empty")
+	}
+
+	var resp QueryMessageHistoryResponse
+
+	err := c.makeRequest(http.MethodPost, "messages/history", nil, req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,459 @@
+package stream_chat // nolint: golint
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_FlagMessageByUser(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer func() {
+		mustNoError(t, ch.Delete(), "delete channel")
+	}()
+
+	user := randomUser()
+
+	msg, err := ch.SendMessage(&Message{Text: "test message"}, user.ID)
+	mustNoError(t, err, "send message")
+
+	mustError(t, c.FlagMessageByUser(msg.ID, ""), "flag message without user")
+
+	err = c.FlagMessageByUser(msg.ID, serverUser.ID)
+	mustNoError(t, err, "flag message")
+
+	err = c.UnflagMessageByUser(msg.ID, serverUser.ID)
+	mustNoError(t, err, "unflag message")
+}
+
+func TestClient_MarkAllRead(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer func() {
+		mustNoError(t, ch.Delete(), "delete channel")
+	}()
+
+	reader := testUsers[0]
+
+	msg, err := ch.SendMessage(&Message{Text: "test message"}, testUsers[1].ID)
+	mustNoError(t, err, "send message")
+
+	mustError(t, c.MarkAllRead(""), "mark all read without user")
+	mustNoError(t, c.MarkAllRead(reader.ID), "mark all read")
+
+	mustNoError(t, ch.Refresh(), "refresh channel")
+
+	read := ch.ReadState(reader.ID)
+	if assert.NotNil(t, read, "read state") {
+		assert.True(t, read.HasRead(msg), "message is read")
+		assert.Equal(t, 0, read.UnreadMessages, "unread messages")
+	}
+}
+
+func TestChannel_GetMessages(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer func() {
+		mustNoError(t, ch.Delete(), "delete channel")
+	}()
+
+	ids := make([]string, 0, 2)
+	for i := 0; i < 2; i++ {
+		msg, err := ch.SendMessage(&Message{Text: "test message"}, randomUser().ID)
+		mustNoError(t, err, "send message")
+		ids = append(ids, msg.ID)
+	}
+
+	got, err := ch.GetMessages(ids)
+	mustNoError(t, err, "get messages")
+
+	if assert.Len(t, got, 2, "messages") {
+		assert.ElementsMatch(t, ids, []string{got[0].ID, got[1].ID})
+	}
+}
+
+func TestClient_PinMessage(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer func() {
+		mustNoError(t, ch.Delete(), "delete channel")
+	}()
+
+	msg, err := ch.SendMessage(&Message{Text: "test message"}, randomUser().ID)
+	mustNoError(t, err, "send message")
+
+	expiration := time.Now().Add(time.Hour)
+
+	msg, err = c.PinMessage(msg.ID, serverUser.ID, &expiration)
+	mustNoError(t, err, "pin message")
+	assert.True(t, msg.Pinned, "message is pinned")
+	assert.NotNil(t, msg.PinnedAt, "message has pinned at")
+	assert.NotNil(t, msg.PinExpires, "message has pin expiration")
+	assert.Equal(t, serverUser.ID, msg.PinnedBy.ID, "message pinned by")
+
+	pinned, err := ch.GetPinnedMessages(PinnedMessagesOptions{Limit: 10})
+	mustNoError(t, err, "get pinned messages")
+	if assert.Len(t, pinned, 1, "pinned messages") {
+		assert.Equal(t, msg.ID, pinned[0].ID, "pinned message")
+	}
+
+	msg, err = c.UnpinMessage(msg.ID, serverUser.ID)
+	mustNoError(t, err, "unpin message")
+	assert.False(t, msg.Pinned, "message is unpinned")
+}
+
+func TestClient_TranslateMessage(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer func() {
+		mustNoError(t, ch.Delete(), "delete channel")
+	}()
+
+	msg, err := ch.SendMessage(&Message{Text: "hello world"}, randomUser().ID)
+	mustNoError(t, err, "send message")
+
+	msg, err = c.TranslateMessage(msg.ID, "fr")
+	mustNoError(t, err, "translate message")
+	assert.Contains(t, msg.I18n, "fr_text", "message translation")
+}
+
+func TestChannel_GetRepliesWithOptions(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer func() {
+		mustNoError(t, ch.Delete(), "delete channel")
+	}()
+
+	msg, err := ch.SendMessage(&Message{Text: "test message"}, randomUser().ID)
+	mustNoError(t, err, "send message")
+
+	replies := make([]*Message, 0, 3)
+	for i := 0; i < 3; i++ {
+		reply, err := ch.SendMessage(&Message{Text: "test reply", ParentID: msg.ID}, serverUser.ID)
+		mustNoError(t, err, "send reply")
+		replies = append(replies, reply)
+	}
+
+	resp, err := ch.GetRepliesWithOptions(msg.ID, RepliesOptions{Limit: 2})
+	mustNoError(t, err, "get replies")
+	assert.Len(t, resp.Messages, 2)
+	assert.Equal(t, replies[2].ID, resp.LastID, "newest reply")
+
+	resp, err = ch.GetRepliesWithOptions(msg.ID, RepliesOptions{Limit: 2, IDLT: resp.FirstID})
+	mustNoError(t, err, "get older replies")
+	assert.Len(t, resp.Messages, 1)
+	assert.Equal(t, replies[0].ID, resp.FirstID, "oldest reply")
+
+	var got []string
+	it := ch.IterateReplies(msg.ID, RepliesOptions{Limit: 2})
+	for it.Next() {
+		got = append(got, it.Message().ID)
+	}
+	mustNoError(t, it.Err(), "iterate replies")
+	assert.Equal(t, []string{replies[2].ID, replies[1].ID, replies[0].ID}, got)
+}
+
+func TestChannel_IterateMessages(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer func() {
+		mustNoError(t, ch.Delete(), "delete channel")
+	}()
+
+	ids := make([]string, 0, 5)
+	for i := 0; i < 5; i++ {
+		msg, err := ch.SendMessage(&Message{Text: "test message"}, serverUser.ID)
+		mustNoError(t, err, "send message")
+		ids = append([]string{msg.ID}, ids...)
+	}
+
+	var got []string
+
+	it := ch.IterateMessages(MessagePaginationParams{Limit: 2})
+	for it.Next() {
+		got = append(got, it.Message().ID)
+	}
+	mustNoError(t, it.Err(), "iterate messages")
+
+	assert.Equal(t, ids, got, "messages from newest to oldest")
+}
+
+func TestMessageIterator(t *testing.T) {
+	pages := map[string][]*Message{
+		"":  {{ID: "3"}, {ID: "4"}},
+		"3": {{ID: "1"}, {ID: "2"}},
+		"1": {},
+	}
+
+	var cursors []string
+	it := newMessageIterator(2, func(idLT string) ([]*Message, error) {
+		cursors = append(cursors, idLT)
+		return pages[idLT], nil
+	})
+
+	var got []string
+	for it.Next() {
+		got = append(got, it.Message().ID)
+	}
+
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []string{"4", "3", "2", "1"}, got)
+	assert.Equal(t, []string{"", "3", "1"}, cursors)
+
+	it = newMessageIterator(2, func(string) ([]*Message, error) {
+		return nil, errors.New("fetch error")
+	})
+	assert.False(t, it.Next())
+	assert.Error(t, it.Err())
+}
+
+func TestChannel_SendMessageGeneratesID(t *testing.T) {
+	var ids []string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req), "decode request")
+
+		id, _ := req["message"]["id"].(string)
+		ids = append(ids, id)
+		_, _ = fmt.Fprintf(w, `{"message":{"id":%q}}`, id)
+	}))
+	defer srv.Close()
+
+	c, err := NewClient("key", []byte("secret"), WithBaseURL(srv.URL))
+	mustNoError(t, err, "new client")
+
+	ch := &Channel{Type: "messaging", ID: "general", client: c}
+
+	msg := &Message{Text: "test"}
+	_, err = ch.SendMessage(msg, "user")
+	mustNoError(t, err, "send message")
+
+	_, err = ch.SendMessage(msg, "user")
+	mustNoError(t, err, "send message again")
+
+	assert.Empty(t, msg.ID, "message isn't modified")
+	if assert.Len(t, ids, 2) {
+		uuid := `^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`
+		assert.Regexp(t, uuid, ids[0])
+		assert.Regexp(t, uuid, ids[1])
+		assert.NotEqual(t, ids[0], ids[1], "every send has a new ID")
+	}
+
+	ids = nil
+
+	_, err = ch.SendMessage(&Message{ID: "msg-id", Text: "test"}, "user")
+	mustNoError(t, err, "send message with ID")
+
+	_, err = ch.SendMessage(msg, "user", MessageIdempotencyKey("key"))
+	mustNoError(t, err, "send message with idempotency key")
+
+	_, err = ch.SendMessage(&Message{ID: "msg-id", Text: "test"}, "user", MessageIdempotencyKey("key"))
+	mustNoError(t, err, "idempotency key overrides ID")
+
+	assert.Equal(t, []string{"msg-id", "key", "key"}, ids)
+}
+
+func TestChannel_SendMessageWithResponse(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"message":{"id":"msg-id","text":"hi @bob","user":{"id":"alice"},
+			"mentioned_users":[{"id":"bob","name":"Bob"}],"reaction_counts":{},
+			"thread_participants":[{"id":"alice"}]},"duration":"1.25ms"}`))
+	}))
+	defer srv.Close()
+
+	c, err := NewClient("key", []byte("secret"))
+	mustNoError(t, err, "new client")
+	c.BaseURL = srv.URL
+
+	ch := &Channel{Type: "messaging", ID: "general", client: c}
+
+	msg := &Message{Text: "hi @bob"}
+
+	resp, err := ch.SendMessageWithResponse(msg, "alice")
+	mustNoError(t, err, "send message")
+
+	assert.Nil(t, msg.User, "message isn't modified")
+	assert.Empty(t, msg.ID, "message isn't modified")
+
+	assert.Equal(t, "1.25ms", resp.Duration)
+	assert.Equal(t, "msg-id", resp.Message.ID)
+	assert.Equal(t, "alice", resp.Message.User.ID)
+	if assert.Len(t, resp.Message.MentionedUsers, 1) {
+		assert.Equal(t, "Bob", resp.Message.MentionedUsers[0].Name)
+	}
+	assert.Len(t, resp.Message.ThreadParticipants, 1)
+
+	_, err = ch.SendMessageWithResponse(&Message{Text: "hi", Attachments: []*Attachment{nil}}, "alice")
+	mustError(t, err, "nil attachment")
+}
+
+func TestMessage_UnmarshalJSON(t *testing.T) {
+	data := `{"id":"msg","text":"hi","user":{"id":"bob","age":50},"order_id":"123","command":"giphy","args":"cats"}`
+
+	var msg Message
+	mustNoError(t, msg.UnmarshalJSON([]byte(data)), "unmarshal message")
+
+	assert.Equal(t, "hi", msg.Text)
+	assert.Equal(t, "giphy", msg.Command)
+	assert.Equal(t, "cats", msg.Args)
+	assert.Equal(t, map[string]interface{}{"order_id": "123"}, msg.ExtraData)
+	assert.Equal(t, map[string]interface{}{"age": float64(50)}, msg.User.ExtraData)
+
+	b, err := msg.MarshalJSON()
+	mustNoError(t, err, "marshal message")
+	assert.Contains(t, string(b), `"order_id":"123"`, "custom fields are flattened")
+	assert.NotContains(t, string(b), "ExtraData")
+}
+
+func TestParseMentions(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"hello", nil},
+		{"@bob hi", []string{"bob"}},
+		{"hi @bob and @alice-1, @bob again", []string{"bob", "alice-1"}},
+		{"(@bob_2)", []string{"bob_2"}},
+		{"mail bob@example.com", nil},
+		{"@", nil},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, ParseMentions(tt.text), tt.text)
+	}
+}
+
+func TestChannel_SendSystemMessage(t *testing.T) {
+	var body string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		body = string(b)
+		_, _ = w.Write([]byte(`{"message":{"id":"msg-id","type":"system"}}`))
+	}))
+	defer srv.Close()
+
+	c, err := NewClient("key", []byte("secret"))
+	mustNoError(t, err, "new client")
+	c.BaseURL = srv.URL
+
+	ch := &Channel{Type: "messaging", ID: "general", client: c}
+
+	msg, err := ch.SendSystemMessage("order shipped", "bot")
+	mustNoError(t, err, "send system message")
+	assert.Equal(t, MessageTypeSystem, msg.Type)
+	assert.Contains(t, body, `"type":"system"`)
+	assert.Contains(t, body, `"skip_push":true`)
+
+	_, err = ch.SendSystemMessage("", "bot")
+	mustError(t, err, "empty text")
+}
+
+func TestChannel_SendActionAsUser(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/messages/msg-id/action", r.URL.Path)
+
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"message_id":"msg-id","form_data":{"image_action":"send"},
+			"user_id":"bob","id":"general","type":"messaging"}`, string(b))
+
+		_, _ = w.Write([]byte(`{"message":{"id":"msg-id","type":"regular"}}`))
+	}))
+	defer srv.Close()
+
+	c, err := NewClient("key", []byte("secret"))
+	mustNoError(t, err, "new client")
+	c.BaseURL = srv.URL
+
+	ch := &Channel{Type: "messaging", ID: "general", client: c}
+
+	msg, err := ch.SendActionAsUser("msg-id", "bob", map[string]string{"image_action": "send"})
+	mustNoError(t, err, "send action")
+	assert.Equal(t, MessageTypeRegular, msg.Type)
+
+	_, err = ch.SendActionAsUser("msg-id", "", map[string]string{"image_action": "send"})
+	mustError(t, err, "empty user ID")
+
+	_, err = ch.SendGiphyAction("msg-id", "bob", GiphyActionSend)
+	mustNoError(t, err, "send giphy action")
+}
+
+func TestClient_DeleteMessages(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		deleted []string
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "true", r.URL.Query().Get("hard"), "hard delete")
+
+		id := path.Base(r.URL.Path)
+		if id == "missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		mu.Lock()
+		deleted = append(deleted, id)
+		mu.Unlock()
+
+		_, _ = w.Write([]byte(`{"message":{"id":"` + id + `"}}`))
+	}))
+	defer srv.Close()
+
+	c, err := NewClient("key", []byte("secret"))
+	mustNoError(t, err, "new client")
+	c.BaseURL = srv.URL
+
+	ids := []string{"1", "2", "3", "4", "5", "6"}
+
+	mustNoError(t, c.DeleteMessages(ids, true), "delete messages")
+	assert.ElementsMatch(t, ids, deleted)
+
+	mustError(t, c.DeleteMessages([]string{"missing"}, true), "missing message")
+	mustError(t, c.DeleteMessages(nil, true), "no message IDs")
+}
+
+func TestClient_QueryMessageHistory(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer func() {
+		mustNoError(t, ch.Delete(), "delete channel")
+	}()
+
+	user := randomUser()
+
+	msg, err := ch.SendMessage(&Message{Text: "first version"}, user.ID)
+	mustNoError(t, err, "send message")
+
+	msg.Text = "second version"
+	msg.User = &User{ID: user.ID}
+	_, err = c.UpdateMessage(msg, msg.ID)
+	mustNoError(t, err, "update message")
+
+	resp, err := c.QueryMessageHistory(QueryMessageHistoryRequest{
+		Filter: map[string]interface{}{"message_id": msg.ID},
+	})
+	mustNoError(t, err, "query message history")
+
+	if assert.Len(t, resp.MessageHistory, 1) {
+		entry := resp.MessageHistory[0]
+		assert.Equal(t, "first version", entry.Text)
+		assert.Equal(t, user.ID, entry.MessageUpdatedByID)
+	}
+
+	_, err = c.QueryMessageHistory(QueryMessageHistoryRequest{})
+	mustError(t, err, "empty filter")
+}
@@ -0,0 +1,206 @@
+//go:build ignore
+// +build ignore
+
+// gen generates mocks of StreamClient and StreamChannel interfaces
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"sort"
+	"strings"
+)
+
+const pkgName = "stream_chat"
+
+func main() {
+	fset := token.NewFileSet()
+
+	f, err := parser.ParseFile(fset, "../stream_chat.go", nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	g := generator{imports: map[string]bool{}}
+
+	ast.Inspect(f, func(n ast.Node) bool {
+		spec, ok := n.(*ast.TypeSpec)
+		if !ok {
+			return true
+		}
+
+		iface, ok := spec.Type.(*ast.InterfaceType)
+		if !ok {
+			return false
+		}
+
+		g.mock(strings.TrimPrefix(spec.Name.Name, "Stream"), spec.Name.Name, iface)
+
+		return false
+	})
+
+	if err := ioutil.WriteFile("mock_gen.go", g.source(), 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+type generator struct {
+	imports map[string]bool // standard packages of the types
+	body    bytes.Buffer
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.body, format, args...)
+}
+
+func (g *generator) source() []byte {
+	var b bytes.Buffer
+
+	fmt.Fprintln(&b, "// Code generated by gen.go; DO NOT EDIT.")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "package mock")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "import (")
+
+	std := make([]string, 0, len(g.imports))
+	for imp := range g.imports {
+		std = append(std, imp)
+	}
+	sort.Strings(std)
+
+	for _, imp := range std {
+		fmt.Fprintf(&b, "%q\n", imp)
+	}
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, `stream_chat "github.com/GetStream/stream-chat-go/v2"`)
+	fmt.Fprintln(&b, `"github.com/stretchr/testify/mock"`)
+	fmt.Fprintln(&b, ")")
+
+	b.Write(g.body.Bytes())
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return src
+}
+
+func (g *generator) mock(name, iface string, t *ast.InterfaceType) {
+	g.printf("\n// %s is a mock of stream_chat.%s\n", name, iface)
+	g.printf("type %s struct {\n\tmock.Mock\n}\n", name)
+	g.printf("\nvar _ stream_chat.%s = (*%s)(nil)\n", iface, name)
+
+	for _, m := range t.Methods.List {
+		fn, ok := m.Type.(*ast.FuncType)
+		if !ok || len(m.Names) == 0 {
+			continue
+		}
+
+		g.method(name, m.Names[0].Name, fn)
+	}
+}
+
+func (g *generator) method(recv, name string, fn *ast.FuncType) {
+	var params, args []string
+
+	for i, p := range fn.Params.List {
+		typ := g.typeString(p.Type)
+
+		names := p.Names
+		if len(names) == 0 {
+			names = []*ast.Ident{ast.NewIdent(fmt.Sprintf("arg%d", i))}
+		}
+
+		for _, n := range names {
+			params = append(params, n.Name+" "+typ)
+			args = append(args, n.Name)
+		}
+	}
+
+	var results []string
+	if fn.Results != nil {
+		for _, r := range fn.Results.List {
+			n := len(r.Names)
+			if n == 0 {
+				n = 1
+			}
+			for i := 0; i < n; i++ {
+				results = append(results, g.typeString(r.Type))
+			}
+		}
+	}
+
+	g.printf("\n// %s mocks the method of the same name\n", name)
+	g.printf("func (m *%s) %s(%s)", recv, name, strings.Join(params, ", "))
+
+	switch len(results) {
+	case 0:
+		g.printf(" {\n\tm.Called(%s)\n}\n", strings.Join(args, ", "))
+		return
+	case 1:
+		g.printf(" %s {\n", results[0])
+	default:
+		g.printf(" (%s) {\n", strings.Join(results, ", "))
+	}
+
+	g.printf("\targs := m.Called(%s)\n", strings.Join(args, ", "))
+
+	rets := make([]string, 0, len(results))
+	for i, r := range results {
+		if r == "error" {
+			rets = append(rets, fmt.Sprintf("args.Error(%d)", i))
+			continue
+		}
+
+		g.printf("\tr%d, _ := args.Get(%d).(%s)\n", i, i, r)
+		rets = append(rets, fmt.Sprintf("r%d", i))
+	}
+
+	g.printf("\treturn %s\n}\n", strings.Join(rets, ", "))
+}
+
+// typeString prints the type qualifying the types of stream_chat package
+func (g *generator) typeString(t ast.Expr) string {
+	t = g.qualify(t)
+
+	// positions of the source would break qualified types into lines
+	var b bytes.Buffer
+	if err := printer.Fprint(&b, token.NewFileSet(), t); err != nil {
+		log.Fatal(err)
+	}
+
+	return b.String()
+}
+
+func (g *generator) qualify(t ast.Expr) ast.Expr {
+	switch t := t.(type) {
+	case *ast.Ident:
+		if ast.IsExported(t.Name) {
+			return &ast.SelectorExpr{X: ast.NewIdent(pkgName), Sel: ast.NewIdent(t.Name)}
+		}
+	case *ast.SelectorExpr:
+		if x, ok := t.X.(*ast.Ident); ok {
+			g.imports[x.Name] = true
+		}
+	case *ast.StarExpr:
+		return &ast.StarExpr{X: g.qualify(t.X)}
+	case *ast.ArrayType:
+		return &ast.ArrayType{Len: t.Len, Elt: g.qualify(t.Elt)}
+	case *ast.MapType:
+		return &ast.MapType{Key: g.qualify(t.Key), Value: g.qualify(t.Value)}
+	case *ast.Ellipsis:
+		return &ast.Ellipsis{Elt: g.qualify(t.Elt)}
+	case *ast.ChanType:
+		return &ast.ChanType{Dir: t.Dir, Value: g.qualify(t.Value)}
+	}
+
+	return t
+}
@@ -0,0 +1,10 @@
+//go:generate go run gen.go
+
+// Package mock provides testify mocks of StreamClient and StreamChannel interfaces,
+// so chat logic depending on them can be unit tested without the API:
+//
+//	client := &mock.Client{}
+//	client.On("GetCommand", "location").Return(&stream_chat.Command{Name: "location"}, nil)
+//	...
+//	client.AssertExpectations(t)
+package mock
@@ -0,0 +1,1479 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package mock
+
+import (
+	"context"
+	"io"
+	"time"
+
+	stream_chat "github.com/GetStream/stream-chat-go/v2"
+	"github.com/stretchr/testify/mock"
+)
+
+// Client is a mock of stream_chat.StreamClient
+type Client struct {
+	mock.Mock
+}
+
+var _ stream_chat.StreamClient = (*Client)(nil)
+
+// GetAppConfig mocks the method of the same name
+func (m *Client) GetAppConfig() (*stream_chat.AppConfig, error) {
+	args := m.Called()
+	r0, _ := args.Get(0).(*stream_chat.AppConfig)
+	return r0, args.Error(1)
+}
+
+// UpdateAppSettings mocks the method of the same name
+func (m *Client) UpdateAppSettings(settings *stream_chat.AppSettings) error {
+	args := m.Called(settings)
+	return args.Error(0)
+}
+
+// CheckSQS mocks the method of the same name
+func (m *Client) CheckSQS(req *stream_chat.CheckSQSRequest) (*stream_chat.CheckResponse, error) {
+	args := m.Called(req)
+	r0, _ := args.Get(0).(*stream_chat.CheckResponse)
+	return r0, args.Error(1)
+}
+
+// CheckSNS mocks the method of the same name
+func (m *Client) CheckSNS(req *stream_chat.CheckSNSRequest) (*stream_chat.CheckResponse, error) {
+	args := m.Called(req)
+	r0, _ := args.Get(0).(*stream_chat.CheckResponse)
+	return r0, args.Error(1)
+}
+
+// GetRateLimits mocks the method of the same name
+func (m *Client) GetRateLimits(options stream_chat.GetRateLimitsOptions) (*stream_chat.GetRateLimitsResponse, error) {
+	args := m.Called(options)
+	r0, _ := args.Get(0).(*stream_chat.GetRateLimitsResponse)
+	return r0, args.Error(1)
+}
+
+// CreateBlocklist mocks the method of the same name
+func (m *Client) CreateBlocklist(name string, words []string) error {
+	args := m.Called(name, words)
+	return args.Error(0)
+}
+
+// DeleteBlocklist mocks the method of the same name
+func (m *Client) DeleteBlocklist(name string) error {
+	args := m.Called(name)
+	return args.Error(0)
+}
+
+// GetBlocklist mocks the method of the same name
+func (m *Client) GetBlocklist(name string) (*stream_chat.Blocklist, error) {
+	args := m.Called(name)
+	r0, _ := args.Get(0).(*stream_chat.Blocklist)
+	return r0, args.Error(1)
+}
+
+// ListBlocklists mocks the method of the same name
+func (m *Client) ListBlocklists() ([]*stream_chat.Blocklist, error) {
+	args := m.Called()
+	r0, _ := args.Get(0).([]*stream_chat.Blocklist)
+	return r0, args.Error(1)
+}
+
+// UpdateBlocklist mocks the method of the same name
+func (m *Client) UpdateBlocklist(name string, words []string) error {
+	args := m.Called(name, words)
+	return args.Error(0)
+}
+
+// CreateCampaign mocks the method of the same name
+func (m *Client) CreateCampaign(campaign *stream_chat.Campaign) (*stream_chat.Campaign, error) {
+	args := m.Called(campaign)
+	r0, _ := args.Get(0).(*stream_chat.Campaign)
+	return r0, args.Error(1)
+}
+
+// GetCampaign mocks the method of the same name
+func (m *Client) GetCampaign(id string) (*stream_chat.Campaign, error) {
+	args := m.Called(id)
+	r0, _ := args.Get(0).(*stream_chat.Campaign)
+	return r0, args.Error(1)
+}
+
+// GetCampaignStats mocks the method of the same name
+func (m *Client) GetCampaignStats(id string) (*stream_chat.CampaignStats, error) {
+	args := m.Called(id)
+	r0, _ := args.Get(0).(*stream_chat.CampaignStats)
+	return r0, args.Error(1)
+}
+
+// StartCampaign mocks the method of the same name
+func (m *Client) StartCampaign(id string) (*stream_chat.Campaign, error) {
+	args := m.Called(id)
+	r0, _ := args.Get(0).(*stream_chat.Campaign)
+	return r0, args.Error(1)
+}
+
+// StartCampaignWithOptions mocks the method of the same name
+func (m *Client) StartCampaignWithOptions(id string, options stream_chat.StartCampaignOptions) (*stream_chat.Campaign, error) {
+	args := m.Called(id, options)
+	r0, _ := args.Get(0).(*stream_chat.Campaign)
+	return r0, args.Error(1)
+}
+
+// StopCampaign mocks the method of the same name
+func (m *Client) StopCampaign(id string) (*stream_chat.Campaign, error) {
+	args := m.Called(id)
+	r0, _ := args.Get(0).(*stream_chat.Campaign)
+	return r0, args.Error(1)
+}
+
+// TestCampaign mocks the method of the same name
+func (m *Client) TestCampaign(id string, userIDs []string) (*stream_chat.Campaign, error) {
+	args := m.Called(id, userIDs)
+	r0, _ := args.Get(0).(*stream_chat.Campaign)
+	return r0, args.Error(1)
+}
+
+// QueryCampaigns mocks the method of the same name
+func (m *Client) QueryCampaigns(req stream_chat.QueryCampaignsRequest) (*stream_chat.QueryCampaignsResponse, error) {
+	args := m.Called(req)
+	r0, _ := args.Get(0).(*stream_chat.QueryCampaignsResponse)
+	return r0, args.Error(1)
+}
+
+// AddDevice mocks the method of the same name
+func (m *Client) AddDevice(device *stream_chat.Device) error {
+	args := m.Called(device)
+	return args.Error(0)
+}
+
+// DeleteDevice mocks the method of the same name
+func (m *Client) DeleteDevice(userID string, deviceID string) error {
+	args := m.Called(userID, deviceID)
+	return args.Error(0)
+}
+
+// GetDevices mocks the method of the same name
+func (m *Client) GetDevices(userID string) ([]*stream_chat.Device, error) {
+	args := m.Called(userID)
+	r0, _ := args.Get(0).([]*stream_chat.Device)
+	return r0, args.Error(1)
+}
+
+// CreateChannel mocks the method of the same name
+func (m *Client) CreateChannel(chanType string, chanID string, userID string, data map[string]interface{}) (*stream_chat.Channel, error) {
+	args := m.Called(chanType, chanID, userID, data)
+	r0, _ := args.Get(0).(*stream_chat.Channel)
+	return r0, args.Error(1)
+}
+
+// CreateChannelWithOptions mocks the method of the same name
+func (m *Client) CreateChannelWithOptions(chanType string, chanID string, userID string, options stream_chat.CreateChannelOptions) (*stream_chat.Channel, error) {
+	args := m.Called(chanType, chanID, userID, options)
+	r0, _ := args.Get(0).(*stream_chat.Channel)
+	return r0, args.Error(1)
+}
+
+// CreateDistinctChannel mocks the method of the same name
+func (m *Client) CreateDistinctChannel(chanType string, members []string, createdBy string, data map[string]interface{}) (*stream_chat.Channel, error) {
+	args := m.Called(chanType, members, createdBy, data)
+	r0, _ := args.Get(0).(*stream_chat.Channel)
+	return r0, args.Error(1)
+}
+
+// ChannelByCID mocks the method of the same name
+func (m *Client) ChannelByCID(cid string) (*stream_chat.Channel, error) {
+	args := m.Called(cid)
+	r0, _ := args.Get(0).(*stream_chat.Channel)
+	return r0, args.Error(1)
+}
+
+// DeleteChannels mocks the method of the same name
+func (m *Client) DeleteChannels(cids []string, hardDelete bool) (string, error) {
+	args := m.Called(cids, hardDelete)
+	r0, _ := args.Get(0).(string)
+	return r0, args.Error(1)
+}
+
+// RestoreChannels mocks the method of the same name
+func (m *Client) RestoreChannels(cids []string) error {
+	args := m.Called(cids)
+	return args.Error(0)
+}
+
+// CreateChannelType mocks the method of the same name
+func (m *Client) CreateChannelType(chType *stream_chat.ChannelType) (*stream_chat.ChannelType, error) {
+	args := m.Called(chType)
+	r0, _ := args.Get(0).(*stream_chat.ChannelType)
+	return r0, args.Error(1)
+}
+
+// DeleteChannelType mocks the method of the same name
+func (m *Client) DeleteChannelType(chType string) error {
+	args := m.Called(chType)
+	return args.Error(0)
+}
+
+// GetChannelType mocks the method of the same name
+func (m *Client) GetChannelType(chanType string) (*stream_chat.ChannelType, error) {
+	args := m.Called(chanType)
+	r0, _ := args.Get(0).(*stream_chat.ChannelType)
+	return r0, args.Error(1)
+}
+
+// ListChannelTypes mocks the method of the same name
+func (m *Client) ListChannelTypes() (map[string]*stream_chat.ChannelType, error) {
+	args := m.Called()
+	r0, _ := args.Get(0).(map[string]*stream_chat.ChannelType)
+	return r0, args.Error(1)
+}
+
+// UpdateChannelType mocks the method of the same name
+func (m *Client) UpdateChannelType(name string, options map[string]interface{}) error {
+	args := m.Called(name, options)
+	return args.Error(0)
+}
+
+// UpdateChannelTypeModeration mocks the method of the same name
+func (m *Client) UpdateChannelTypeModeration(name string, moderation stream_chat.ChannelTypeModeration) error {
+	args := m.Called(name, moderation)
+	return args.Error(0)
+}
+
+// CreateToken mocks the method of the same name
+func (m *Client) CreateToken(userID string, expire time.Time) ([]byte, error) {
+	args := m.Called(userID, expire)
+	r0, _ := args.Get(0).([]byte)
+	return r0, args.Error(1)
+}
+
+// SetLogger mocks the method of the same name
+func (m *Client) SetLogger(logger stream_chat.Logger, level stream_chat.LogLevel) {
+	m.Called(logger, level)
+}
+
+// Use mocks the method of the same name
+func (m *Client) Use(middleware ...stream_chat.RequestMiddleware) {
+	m.Called(middleware)
+}
+
+// VerifyWebhook mocks the method of the same name
+func (m *Client) VerifyWebhook(body []byte, signature []byte) bool {
+	args := m.Called(body, signature)
+	r0, _ := args.Get(0).(bool)
+	return r0
+}
+
+// WithTimeout mocks the method of the same name
+func (m *Client) WithTimeout(timeout time.Duration) stream_chat.StreamClient {
+	args := m.Called(timeout)
+	r0, _ := args.Get(0).(stream_chat.StreamClient)
+	return r0
+}
+
+// CreateCommand mocks the method of the same name
+func (m *Client) CreateCommand(cmd *stream_chat.Command) (*stream_chat.Command, error) {
+	args := m.Called(cmd)
+	r0, _ := args.Get(0).(*stream_chat.Command)
+	return r0, args.Error(1)
+}
+
+// DeleteCommand mocks the method of the same name
+func (m *Client) DeleteCommand(name string) error {
+	args := m.Called(name)
+	return args.Error(0)
+}
+
+// GetCommand mocks the method of the same name
+func (m *Client) GetCommand(name string) (*stream_chat.Command, error) {
+	args := m.Called(name)
+	r0, _ := args.Get(0).(*stream_chat.Command)
+	return r0, args.Error(1)
+}
+
+// ListCommands mocks the method of the same name
+func (m *Client) ListCommands() ([]*stream_chat.Command, error) {
+	args := m.Called()
+	r0, _ := args.Get(0).([]*stream_chat.Command)
+	return r0, args.Error(1)
+}
+
+// UpdateCommand mocks the method of the same name
+func (m *Client) UpdateCommand(name string, options map[string]interface{}) (*stream_chat.Command, error) {
+	args := m.Called(name, options)
+	r0, _ := args.Get(0).(*stream_chat.Command)
+	return r0, args.Error(1)
+}
+
+// SendUserEvent mocks the method of the same name
+func (m *Client) SendUserEvent(userID string, event *stream_chat.Event) error {
+	args := m.Called(userID, event)
+	return args.Error(0)
+}
+
+// ExportChannels mocks the method of the same name
+func (m *Client) ExportChannels(channels []*stream_chat.ExportableChannel, options *stream_chat.ExportChannelOptions) (string, error) {
+	args := m.Called(channels, options)
+	r0, _ := args.Get(0).(string)
+	return r0, args.Error(1)
+}
+
+// GetExportChannelsStatus mocks the method of the same name
+func (m *Client) GetExportChannelsStatus(taskID string) (*stream_chat.ExportChannelsStatus, error) {
+	args := m.Called(taskID)
+	r0, _ := args.Get(0).(*stream_chat.ExportChannelsStatus)
+	return r0, args.Error(1)
+}
+
+// CreateImportURL mocks the method of the same name
+func (m *Client) CreateImportURL(filename string) (*stream_chat.ImportURL, error) {
+	args := m.Called(filename)
+	r0, _ := args.Get(0).(*stream_chat.ImportURL)
+	return r0, args.Error(1)
+}
+
+// CreateImport mocks the method of the same name
+func (m *Client) CreateImport(filePath string, mode stream_chat.ImportMode) (*stream_chat.ImportTask, error) {
+	args := m.Called(filePath, mode)
+	r0, _ := args.Get(0).(*stream_chat.ImportTask)
+	return r0, args.Error(1)
+}
+
+// GetImport mocks the method of the same name
+func (m *Client) GetImport(id string) (*stream_chat.ImportTask, error) {
+	args := m.Called(id)
+	r0, _ := args.Get(0).(*stream_chat.ImportTask)
+	return r0, args.Error(1)
+}
+
+// ListImports mocks the method of the same name
+func (m *Client) ListImports(limit int, offset int) ([]*stream_chat.ImportTask, error) {
+	args := m.Called(limit, offset)
+	r0, _ := args.Get(0).([]*stream_chat.ImportTask)
+	return r0, args.Error(1)
+}
+
+// IterateUsers mocks the method of the same name
+func (m *Client) IterateUsers(q *stream_chat.QueryOption, sort ...*stream_chat.SortOption) *stream_chat.UserIterator {
+	args := m.Called(q, sort)
+	r0, _ := args.Get(0).(*stream_chat.UserIterator)
+	return r0
+}
+
+// IterateChannels mocks the method of the same name
+func (m *Client) IterateChannels(q *stream_chat.QueryOption, sort ...*stream_chat.SortOption) *stream_chat.ChannelIterator {
+	args := m.Called(q, sort)
+	r0, _ := args.Get(0).(*stream_chat.ChannelIterator)
+	return r0
+}
+
+// IterateSearch mocks the method of the same name
+func (m *Client) IterateSearch(request stream_chat.SearchRequest) *stream_chat.SearchIterator {
+	args := m.Called(request)
+	r0, _ := args.Get(0).(*stream_chat.SearchIterator)
+	return r0
+}
+
+// DeleteMessage mocks the method of the same name
+func (m *Client) DeleteMessage(msgID string) error {
+	args := m.Called(msgID)
+	return args.Error(0)
+}
+
+// HardDeleteMessage mocks the method of the same name
+func (m *Client) HardDeleteMessage(msgID string) error {
+	args := m.Called(msgID)
+	return args.Error(0)
+}
+
+// DeleteMessages mocks the method of the same name
+func (m *Client) DeleteMessages(msgIDs []string, hard bool) error {
+	args := m.Called(msgIDs, hard)
+	return args.Error(0)
+}
+
+// GetMessage mocks the method of the same name
+func (m *Client) GetMessage(msgID string) (*stream_chat.Message, error) {
+	args := m.Called(msgID)
+	r0, _ := args.Get(0).(*stream_chat.Message)
+	return r0, args.Error(1)
+}
+
+// MarkAllRead mocks the method of the same name
+func (m *Client) MarkAllRead(userID string) error {
+	args := m.Called(userID)
+	return args.Error(0)
+}
+
+// UpdateMessage mocks the method of the same name
+func (m *Client) UpdateMessage(msg *stream_chat.Message, msgID string) (*stream_chat.Message, error) {
+	args := m.Called(msg, msgID)
+	r0, _ := args.Get(0).(*stream_chat.Message)
+	return r0, args.Error(1)
+}
+
+// FlagMessage mocks the method of the same name
+func (m *Client) FlagMessage(msgID string) error {
+	args := m.Called(msgID)
+	return args.Error(0)
+}
+
+// UnflagMessage mocks the method of the same name
+func (m *Client) UnflagMessage(msgID string) error {
+	args := m.Called(msgID)
+	return args.Error(0)
+}
+
+// FlagMessageByUser mocks the method of the same name
+func (m *Client) FlagMessageByUser(msgID string, userID string) error {
+	args := m.Called(msgID, userID)
+	return args.Error(0)
+}
+
+// PinMessage mocks the method of the same name
+func (m *Client) PinMessage(msgID string, userID string, expiration *time.Time) (*stream_chat.Message, error) {
+	args := m.Called(msgID, userID, expiration)
+	r0, _ := args.Get(0).(*stream_chat.Message)
+	return r0, args.Error(1)
+}
+
+// UnpinMessage mocks the method of the same name
+func (m *Client) UnpinMessage(msgID string, userID string) (*stream_chat.Message, error) {
+	args := m.Called(msgID, userID)
+	r0, _ := args.Get(0).(*stream_chat.Message)
+	return r0, args.Error(1)
+}
+
+// TranslateMessage mocks the method of the same name
+func (m *Client) TranslateMessage(msgID string, language string) (*stream_chat.Message, error) {
+	args := m.Called(msgID, language)
+	r0, _ := args.Get(0).(*stream_chat.Message)
+	return r0, args.Error(1)
+}
+
+// UnflagMessageByUser mocks the method of the same name
+func (m *Client) UnflagMessageByUser(msgID string, userID string) error {
+	args := m.Called(msgID, userID)
+	return args.Error(0)
+}
+
+// QueryMessageHistory mocks the method of the same name
+func (m *Client) QueryMessageHistory(req stream_chat.QueryMessageHistoryRequest) (*stream_chat.QueryMessageHistoryResponse, error) {
+	args := m.Called(req)
+	r0, _ := args.Get(0).(*stream_chat.QueryMessageHistoryResponse)
+	return r0, args.Error(1)
+}
+
+// QueryReviewQueue mocks the method of the same name
+func (m *Client) QueryReviewQueue(req stream_chat.QueryReviewQueueRequest) (*stream_chat.QueryReviewQueueResponse, error) {
+	args := m.Called(req)
+	r0, _ := args.Get(0).(*stream_chat.QueryReviewQueueResponse)
+	return r0, args.Error(1)
+}
+
+// SubmitModerationAction mocks the method of the same name
+func (m *Client) SubmitModerationAction(req *stream_chat.SubmitModerationActionRequest) (*stream_chat.ReviewQueueItem, error) {
+	args := m.Called(req)
+	r0, _ := args.Get(0).(*stream_chat.ReviewQueueItem)
+	return r0, args.Error(1)
+}
+
+// UpsertModerationConfig mocks the method of the same name
+func (m *Client) UpsertModerationConfig(config *stream_chat.ModerationConfig) (*stream_chat.ModerationConfig, error) {
+	args := m.Called(config)
+	r0, _ := args.Get(0).(*stream_chat.ModerationConfig)
+	return r0, args.Error(1)
+}
+
+// GetModerationConfig mocks the method of the same name
+func (m *Client) GetModerationConfig(key string) (*stream_chat.ModerationConfig, error) {
+	args := m.Called(key)
+	r0, _ := args.Get(0).(*stream_chat.ModerationConfig)
+	return r0, args.Error(1)
+}
+
+// DeleteModerationConfig mocks the method of the same name
+func (m *Client) DeleteModerationConfig(key string) error {
+	args := m.Called(key)
+	return args.Error(0)
+}
+
+// QueryModerationConfigs mocks the method of the same name
+func (m *Client) QueryModerationConfigs(req stream_chat.QueryModerationConfigsRequest) (*stream_chat.QueryModerationConfigsResponse, error) {
+	args := m.Called(req)
+	r0, _ := args.Get(0).(*stream_chat.QueryModerationConfigsResponse)
+	return r0, args.Error(1)
+}
+
+// FlagMessageWithOptions mocks the method of the same name
+func (m *Client) FlagMessageWithOptions(msgID string, options stream_chat.FlagOptions) error {
+	args := m.Called(msgID, options)
+	return args.Error(0)
+}
+
+// FlagUserWithOptions mocks the method of the same name
+func (m *Client) FlagUserWithOptions(targetID string, options stream_chat.FlagOptions) error {
+	args := m.Called(targetID, options)
+	return args.Error(0)
+}
+
+// QueryFlagReports mocks the method of the same name
+func (m *Client) QueryFlagReports(req stream_chat.QueryFlagReportsRequest) ([]*stream_chat.FlagReport, error) {
+	args := m.Called(req)
+	r0, _ := args.Get(0).([]*stream_chat.FlagReport)
+	return r0, args.Error(1)
+}
+
+// ReviewFlagReport mocks the method of the same name
+func (m *Client) ReviewFlagReport(reportID string, userID string, result string, details map[string]interface{}) (*stream_chat.FlagReport, error) {
+	args := m.Called(reportID, userID, result, details)
+	r0, _ := args.Get(0).(*stream_chat.FlagReport)
+	return r0, args.Error(1)
+}
+
+// CreateRole mocks the method of the same name
+func (m *Client) CreateRole(name string) (*stream_chat.Role, error) {
+	args := m.Called(name)
+	r0, _ := args.Get(0).(*stream_chat.Role)
+	return r0, args.Error(1)
+}
+
+// DeleteRole mocks the method of the same name
+func (m *Client) DeleteRole(name string) error {
+	args := m.Called(name)
+	return args.Error(0)
+}
+
+// ListRoles mocks the method of the same name
+func (m *Client) ListRoles() ([]*stream_chat.Role, error) {
+	args := m.Called()
+	r0, _ := args.Get(0).([]*stream_chat.Role)
+	return r0, args.Error(1)
+}
+
+// GetPermission mocks the method of the same name
+func (m *Client) GetPermission(id string) (*stream_chat.Permission, error) {
+	args := m.Called(id)
+	r0, _ := args.Get(0).(*stream_chat.Permission)
+	return r0, args.Error(1)
+}
+
+// CreatePermission mocks the method of the same name
+func (m *Client) CreatePermission(perm *stream_chat.Permission) error {
+	args := m.Called(perm)
+	return args.Error(0)
+}
+
+// UpdatePermission mocks the method of the same name
+func (m *Client) UpdatePermission(id string, perm *stream_chat.Permission) error {
+	args := m.Called(id, perm)
+	return args.Error(0)
+}
+
+// DeletePermission mocks the method of the same name
+func (m *Client) DeletePermission(id string) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+// ListPermissions mocks the method of the same name
+func (m *Client) ListPermissions() ([]*stream_chat.Permission, error) {
+	args := m.Called()
+	r0, _ := args.Get(0).([]*stream_chat.Permission)
+	return r0, args.Error(1)
+}
+
+// CreatePoll mocks the method of the same name
+func (m *Client) CreatePoll(userID string, poll *stream_chat.Poll) (*stream_chat.Poll, error) {
+	args := m.Called(userID, poll)
+	r0, _ := args.Get(0).(*stream_chat.Poll)
+	return r0, args.Error(1)
+}
+
+// GetPoll mocks the method of the same name
+func (m *Client) GetPoll(pollID string, userID string) (*stream_chat.Poll, error) {
+	args := m.Called(pollID, userID)
+	r0, _ := args.Get(0).(*stream_chat.Poll)
+	return r0, args.Error(1)
+}
+
+// UpdatePoll mocks the method of the same name
+func (m *Client) UpdatePoll(userID string, poll *stream_chat.Poll) (*stream_chat.Poll, error) {
+	args := m.Called(userID, poll)
+	r0, _ := args.Get(0).(*stream_chat.Poll)
+	return r0, args.Error(1)
+}
+
+// ClosePoll mocks the method of the same name
+func (m *Client) ClosePoll(pollID string, userID string) (*stream_chat.Poll, error) {
+	args := m.Called(pollID, userID)
+	r0, _ := args.Get(0).(*stream_chat.Poll)
+	return r0, args.Error(1)
+}
+
+// DeletePoll mocks the method of the same name
+func (m *Client) DeletePoll(pollID string, userID string) error {
+	args := m.Called(pollID, userID)
+	return args.Error(0)
+}
+
+// CastVote mocks the method of the same name
+func (m *Client) CastVote(messageID string, pollID string, userID string, vote stream_chat.PollVoteRequest) (*stream_chat.PollVote, error) {
+	args := m.Called(messageID, pollID, userID, vote)
+	r0, _ := args.Get(0).(*stream_chat.PollVote)
+	return r0, args.Error(1)
+}
+
+// RemoveVote mocks the method of the same name
+func (m *Client) RemoveVote(messageID string, pollID string, voteID string, userID string) error {
+	args := m.Called(messageID, pollID, voteID, userID)
+	return args.Error(0)
+}
+
+// QueryPolls mocks the method of the same name
+func (m *Client) QueryPolls(userID string, req stream_chat.QueryPollsRequest) (*stream_chat.QueryPollsResponse, error) {
+	args := m.Called(userID, req)
+	r0, _ := args.Get(0).(*stream_chat.QueryPollsResponse)
+	return r0, args.Error(1)
+}
+
+// UpsertPushProvider mocks the method of the same name
+func (m *Client) UpsertPushProvider(provider *stream_chat.PushProvider) (*stream_chat.PushProvider, error) {
+	args := m.Called(provider)
+	r0, _ := args.Get(0).(*stream_chat.PushProvider)
+	return r0, args.Error(1)
+}
+
+// ListPushProviders mocks the method of the same name
+func (m *Client) ListPushProviders() ([]*stream_chat.PushProvider, error) {
+	args := m.Called()
+	r0, _ := args.Get(0).([]*stream_chat.PushProvider)
+	return r0, args.Error(1)
+}
+
+// DeletePushProvider mocks the method of the same name
+func (m *Client) DeletePushProvider(providerType string, name string) error {
+	args := m.Called(providerType, name)
+	return args.Error(0)
+}
+
+// UpsertPushPreferences mocks the method of the same name
+func (m *Client) UpsertPushPreferences(preferences []*stream_chat.PushPreferenceInput) (*stream_chat.UpsertPushPreferencesResponse, error) {
+	args := m.Called(preferences)
+	r0, _ := args.Get(0).(*stream_chat.UpsertPushPreferencesResponse)
+	return r0, args.Error(1)
+}
+
+// QueryUsers mocks the method of the same name
+func (m *Client) QueryUsers(q *stream_chat.QueryOption, sort ...*stream_chat.SortOption) ([]*stream_chat.User, error) {
+	args := m.Called(q, sort)
+	r0, _ := args.Get(0).([]*stream_chat.User)
+	return r0, args.Error(1)
+}
+
+// QueryChannels mocks the method of the same name
+func (m *Client) QueryChannels(q *stream_chat.QueryOption, sort ...*stream_chat.SortOption) ([]*stream_chat.Channel, error) {
+	args := m.Called(q, sort)
+	r0, _ := args.Get(0).([]*stream_chat.Channel)
+	return r0, args.Error(1)
+}
+
+// QueryInvites mocks the method of the same name
+func (m *Client) QueryInvites(userID string, status stream_chat.InviteStatus, sort ...*stream_chat.SortOption) ([]*stream_chat.Channel, error) {
+	args := m.Called(userID, status, sort)
+	r0, _ := args.Get(0).([]*stream_chat.Channel)
+	return r0, args.Error(1)
+}
+
+// Search mocks the method of the same name
+func (m *Client) Search(request stream_chat.SearchRequest) ([]*stream_chat.Message, error) {
+	args := m.Called(request)
+	r0, _ := args.Get(0).([]*stream_chat.Message)
+	return r0, args.Error(1)
+}
+
+// QueryMessageFlags mocks the method of the same name
+func (m *Client) QueryMessageFlags(q *stream_chat.QueryOption) ([]*stream_chat.MessageFlag, error) {
+	args := m.Called(q)
+	r0, _ := args.Get(0).([]*stream_chat.MessageFlag)
+	return r0, args.Error(1)
+}
+
+// QueryBannedUsers mocks the method of the same name
+func (m *Client) QueryBannedUsers(q *stream_chat.QueryOption, sort ...*stream_chat.SortOption) ([]*stream_chat.Ban, error) {
+	args := m.Called(q, sort)
+	r0, _ := args.Get(0).([]*stream_chat.Ban)
+	return r0, args.Error(1)
+}
+
+// CreateReminder mocks the method of the same name
+func (m *Client) CreateReminder(messageID string, userID string, remindAt *time.Time) (*stream_chat.Reminder, error) {
+	args := m.Called(messageID, userID, remindAt)
+	r0, _ := args.Get(0).(*stream_chat.Reminder)
+	return r0, args.Error(1)
+}
+
+// UpdateReminder mocks the method of the same name
+func (m *Client) UpdateReminder(messageID string, userID string, remindAt *time.Time) (*stream_chat.Reminder, error) {
+	args := m.Called(messageID, userID, remindAt)
+	r0, _ := args.Get(0).(*stream_chat.Reminder)
+	return r0, args.Error(1)
+}
+
+// DeleteReminder mocks the method of the same name
+func (m *Client) DeleteReminder(messageID string, userID string) error {
+	args := m.Called(messageID, userID)
+	return args.Error(0)
+}
+
+// QueryReminders mocks the method of the same name
+func (m *Client) QueryReminders(req stream_chat.QueryRemindersRequest) (*stream_chat.QueryRemindersResponse, error) {
+	args := m.Called(req)
+	r0, _ := args.Get(0).(*stream_chat.QueryRemindersResponse)
+	return r0, args.Error(1)
+}
+
+// CreateSegment mocks the method of the same name
+func (m *Client) CreateSegment(segment *stream_chat.Segment) (*stream_chat.Segment, error) {
+	args := m.Called(segment)
+	r0, _ := args.Get(0).(*stream_chat.Segment)
+	return r0, args.Error(1)
+}
+
+// GetSegment mocks the method of the same name
+func (m *Client) GetSegment(id string) (*stream_chat.Segment, error) {
+	args := m.Called(id)
+	r0, _ := args.Get(0).(*stream_chat.Segment)
+	return r0, args.Error(1)
+}
+
+// QuerySegments mocks the method of the same name
+func (m *Client) QuerySegments(req stream_chat.QuerySegmentsRequest) (*stream_chat.QuerySegmentsResponse, error) {
+	args := m.Called(req)
+	r0, _ := args.Get(0).(*stream_chat.QuerySegmentsResponse)
+	return r0, args.Error(1)
+}
+
+// DeleteSegment mocks the method of the same name
+func (m *Client) DeleteSegment(id string) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+// AddSegmentTargets mocks the method of the same name
+func (m *Client) AddSegmentTargets(id string, targetIDs []string) error {
+	args := m.Called(id, targetIDs)
+	return args.Error(0)
+}
+
+// RemoveSegmentTargets mocks the method of the same name
+func (m *Client) RemoveSegmentTargets(id string, targetIDs []string) error {
+	args := m.Called(id, targetIDs)
+	return args.Error(0)
+}
+
+// GetTask mocks the method of the same name
+func (m *Client) GetTask(taskID string) (*stream_chat.Task, error) {
+	args := m.Called(taskID)
+	r0, _ := args.Get(0).(*stream_chat.Task)
+	return r0, args.Error(1)
+}
+
+// WaitForTask mocks the method of the same name
+func (m *Client) WaitForTask(taskID string, timeout time.Duration) (*stream_chat.Task, error) {
+	args := m.Called(taskID, timeout)
+	r0, _ := args.Get(0).(*stream_chat.Task)
+	return r0, args.Error(1)
+}
+
+// QueryThreads mocks the method of the same name
+func (m *Client) QueryThreads(userID string, req stream_chat.QueryThreadsRequest) (*stream_chat.QueryThreadsResponse, error) {
+	args := m.Called(userID, req)
+	r0, _ := args.Get(0).(*stream_chat.QueryThreadsResponse)
+	return r0, args.Error(1)
+}
+
+// GetThread mocks the method of the same name
+func (m *Client) GetThread(messageID string, options stream_chat.ThreadOptions) (*stream_chat.Thread, error) {
+	args := m.Called(messageID, options)
+	r0, _ := args.Get(0).(*stream_chat.Thread)
+	return r0, args.Error(1)
+}
+
+// BanUser mocks the method of the same name
+func (m *Client) BanUser(targetID string, userID string, options map[string]interface{}) error {
+	args := m.Called(targetID, userID, options)
+	return args.Error(0)
+}
+
+// BanUserWithOptions mocks the method of the same name
+func (m *Client) BanUserWithOptions(targetID string, userID string, options stream_chat.BanOptions) error {
+	args := m.Called(targetID, userID, options)
+	return args.Error(0)
+}
+
+// DeactivateUser mocks the method of the same name
+func (m *Client) DeactivateUser(targetID string, options map[string]interface{}) error {
+	args := m.Called(targetID, options)
+	return args.Error(0)
+}
+
+// ReactivateUser mocks the method of the same name
+func (m *Client) ReactivateUser(targetID string, options map[string]interface{}) error {
+	args := m.Called(targetID, options)
+	return args.Error(0)
+}
+
+// DeactivateUsers mocks the method of the same name
+func (m *Client) DeactivateUsers(userIDs []string, options stream_chat.DeactivateUserOptions) (string, error) {
+	args := m.Called(userIDs, options)
+	r0, _ := args.Get(0).(string)
+	return r0, args.Error(1)
+}
+
+// ReactivateUsers mocks the method of the same name
+func (m *Client) ReactivateUsers(userIDs []string, options stream_chat.ReactivateUserOptions) (string, error) {
+	args := m.Called(userIDs, options)
+	r0, _ := args.Get(0).(string)
+	return r0, args.Error(1)
+}
+
+// DeleteUser mocks the method of the same name
+func (m *Client) DeleteUser(targetID string, options map[string][]string) error {
+	args := m.Called(targetID, options)
+	return args.Error(0)
+}
+
+// DeleteUserWithOptions mocks the method of the same name
+func (m *Client) DeleteUserWithOptions(targetID string, options stream_chat.DeleteSingleUserOptions) error {
+	args := m.Called(targetID, options)
+	return args.Error(0)
+}
+
+// DeleteUsers mocks the method of the same name
+func (m *Client) DeleteUsers(userIDs []string, options stream_chat.DeleteUserOptions) (string, error) {
+	args := m.Called(userIDs, options)
+	r0, _ := args.Get(0).(string)
+	return r0, args.Error(1)
+}
+
+// RestoreUsers mocks the method of the same name
+func (m *Client) RestoreUsers(userIDs []string) error {
+	args := m.Called(userIDs)
+	return args.Error(0)
+}
+
+// BlockUser mocks the method of the same name
+func (m *Client) BlockUser(targetID string, userID string) error {
+	args := m.Called(targetID, userID)
+	return args.Error(0)
+}
+
+// UnblockUser mocks the method of the same name
+func (m *Client) UnblockUser(targetID string, userID string) error {
+	args := m.Called(targetID, userID)
+	return args.Error(0)
+}
+
+// GetBlockedUsers mocks the method of the same name
+func (m *Client) GetBlockedUsers(userID string) ([]*stream_chat.UserBlock, error) {
+	args := m.Called(userID)
+	r0, _ := args.Get(0).([]*stream_chat.UserBlock)
+	return r0, args.Error(1)
+}
+
+// ExportUser mocks the method of the same name
+func (m *Client) ExportUser(targetID string, options map[string][]string) (*stream_chat.User, error) {
+	args := m.Called(targetID, options)
+	r0, _ := args.Get(0).(*stream_chat.User)
+	return r0, args.Error(1)
+}
+
+// ExportUserData mocks the method of the same name
+func (m *Client) ExportUserData(targetID string, options map[string][]string) (*stream_chat.UserExport, error) {
+	args := m.Called(targetID, options)
+	r0, _ := args.Get(0).(*stream_chat.UserExport)
+	return r0, args.Error(1)
+}
+
+// ExportUsers mocks the method of the same name
+func (m *Client) ExportUsers(userIDs []string) (string, error) {
+	args := m.Called(userIDs)
+	r0, _ := args.Get(0).(string)
+	return r0, args.Error(1)
+}
+
+// FlagUser mocks the method of the same name
+func (m *Client) FlagUser(targetID string, options map[string]interface{}) error {
+	args := m.Called(targetID, options)
+	return args.Error(0)
+}
+
+// MuteUser mocks the method of the same name
+func (m *Client) MuteUser(targetID string, userID string) error {
+	args := m.Called(targetID, userID)
+	return args.Error(0)
+}
+
+// MuteUsers mocks the method of the same name
+func (m *Client) MuteUsers(targetIDs []string, userID string) error {
+	args := m.Called(targetIDs, userID)
+	return args.Error(0)
+}
+
+// MuteUserWithExpiration mocks the method of the same name
+func (m *Client) MuteUserWithExpiration(targetID string, userID string, expiration time.Duration) (*stream_chat.Mute, error) {
+	args := m.Called(targetID, userID, expiration)
+	r0, _ := args.Get(0).(*stream_chat.Mute)
+	return r0, args.Error(1)
+}
+
+// MuteUsersWithExpiration mocks the method of the same name
+func (m *Client) MuteUsersWithExpiration(targetIDs []string, userID string, expiration time.Duration) ([]*stream_chat.Mute, error) {
+	args := m.Called(targetIDs, userID, expiration)
+	r0, _ := args.Get(0).([]*stream_chat.Mute)
+	return r0, args.Error(1)
+}
+
+// UnBanUser mocks the method of the same name
+func (m *Client) UnBanUser(targetID string, options map[string]string) error {
+	args := m.Called(targetID, options)
+	return args.Error(0)
+}
+
+// UnFlagUser mocks the method of the same name
+func (m *Client) UnFlagUser(targetID string, options map[string]interface{}) error {
+	args := m.Called(targetID, options)
+	return args.Error(0)
+}
+
+// UnmuteUser mocks the method of the same name
+func (m *Client) UnmuteUser(targetID string, userID string) error {
+	args := m.Called(targetID, userID)
+	return args.Error(0)
+}
+
+// UnmuteUsers mocks the method of the same name
+func (m *Client) UnmuteUsers(targetIDs []string, userID string) error {
+	args := m.Called(targetIDs, userID)
+	return args.Error(0)
+}
+
+// CreateGuestUser mocks the method of the same name
+func (m *Client) CreateGuestUser(user *stream_chat.User) (*stream_chat.User, string, error) {
+	args := m.Called(user)
+	r0, _ := args.Get(0).(*stream_chat.User)
+	r1, _ := args.Get(1).(string)
+	return r0, r1, args.Error(2)
+}
+
+// UpdateUser mocks the method of the same name
+func (m *Client) UpdateUser(user *stream_chat.User) (*stream_chat.User, error) {
+	args := m.Called(user)
+	r0, _ := args.Get(0).(*stream_chat.User)
+	return r0, args.Error(1)
+}
+
+// UpdateUsers mocks the method of the same name
+func (m *Client) UpdateUsers(users ...*stream_chat.User) (map[string]*stream_chat.User, error) {
+	args := m.Called(users)
+	r0, _ := args.Get(0).(map[string]*stream_chat.User)
+	return r0, args.Error(1)
+}
+
+// UpsertUser mocks the method of the same name
+func (m *Client) UpsertUser(user *stream_chat.User) (*stream_chat.User, error) {
+	args := m.Called(user)
+	r0, _ := args.Get(0).(*stream_chat.User)
+	return r0, args.Error(1)
+}
+
+// UpsertUsers mocks the method of the same name
+func (m *Client) UpsertUsers(users ...*stream_chat.User) (map[string]*stream_chat.User, error) {
+	args := m.Called(users)
+	r0, _ := args.Get(0).(map[string]*stream_chat.User)
+	return r0, args.Error(1)
+}
+
+// PartialUpdateUser mocks the method of the same name
+func (m *Client) PartialUpdateUser(update stream_chat.PartialUserUpdate) (*stream_chat.User, error) {
+	args := m.Called(update)
+	r0, _ := args.Get(0).(*stream_chat.User)
+	return r0, args.Error(1)
+}
+
+// PartialUpdateUsers mocks the method of the same name
+func (m *Client) PartialUpdateUsers(updates []stream_chat.PartialUserUpdate) (map[string]*stream_chat.User, error) {
+	args := m.Called(updates)
+	r0, _ := args.Get(0).(map[string]*stream_chat.User)
+	return r0, args.Error(1)
+}
+
+// ConnectWebSocket mocks the method of the same name
+func (m *Client) ConnectWebSocket(userID string) (*stream_chat.WebSocket, error) {
+	args := m.Called(userID)
+	r0, _ := args.Get(0).(*stream_chat.WebSocket)
+	return r0, args.Error(1)
+}
+
+// Channel is a mock of stream_chat.StreamChannel
+type Channel struct {
+	mock.Mock
+}
+
+var _ stream_chat.StreamChannel = (*Channel)(nil)
+
+// AddMembers mocks the method of the same name
+func (m *Channel) AddMembers(userIDs []string, message *stream_chat.Message) error {
+	args := m.Called(userIDs, message)
+	return args.Error(0)
+}
+
+// AddModerators mocks the method of the same name
+func (m *Channel) AddModerators(userIDs ...string) error {
+	args := m.Called(userIDs)
+	return args.Error(0)
+}
+
+// AddModeratorsWithMessage mocks the method of the same name
+func (m *Channel) AddModeratorsWithMessage(userIDs []string, msg *stream_chat.Message) error {
+	args := m.Called(userIDs, msg)
+	return args.Error(0)
+}
+
+// AssignRoles mocks the method of the same name
+func (m *Channel) AssignRoles(assignments []*stream_chat.RoleAssignment, msg *stream_chat.Message) error {
+	args := m.Called(assignments, msg)
+	return args.Error(0)
+}
+
+// BanUser mocks the method of the same name
+func (m *Channel) BanUser(targetID string, userID string, options map[string]interface{}) error {
+	args := m.Called(targetID, userID, options)
+	return args.Error(0)
+}
+
+// BanUserWithOptions mocks the method of the same name
+func (m *Channel) BanUserWithOptions(targetID string, userID string, options stream_chat.BanOptions) error {
+	args := m.Called(targetID, userID, options)
+	return args.Error(0)
+}
+
+// Delete mocks the method of the same name
+func (m *Channel) Delete() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+// HardDelete mocks the method of the same name
+func (m *Channel) HardDelete() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+// Freeze mocks the method of the same name
+func (m *Channel) Freeze(message *stream_chat.Message) error {
+	args := m.Called(message)
+	return args.Error(0)
+}
+
+// Unfreeze mocks the method of the same name
+func (m *Channel) Unfreeze(message *stream_chat.Message) error {
+	args := m.Called(message)
+	return args.Error(0)
+}
+
+// DemoteModerators mocks the method of the same name
+func (m *Channel) DemoteModerators(userIDs ...string) error {
+	args := m.Called(userIDs)
+	return args.Error(0)
+}
+
+// DemoteModeratorsWithMessage mocks the method of the same name
+func (m *Channel) DemoteModeratorsWithMessage(userIDs []string, msg *stream_chat.Message) error {
+	args := m.Called(userIDs, msg)
+	return args.Error(0)
+}
+
+// MarkRead mocks the method of the same name
+func (m *Channel) MarkRead(userID string, options map[string]interface{}) error {
+	args := m.Called(userID, options)
+	return args.Error(0)
+}
+
+// MarkReadWithOptions mocks the method of the same name
+func (m *Channel) MarkReadWithOptions(userID string, options stream_chat.MarkReadOptions) error {
+	args := m.Called(userID, options)
+	return args.Error(0)
+}
+
+// MarkUnread mocks the method of the same name
+func (m *Channel) MarkUnread(userID string, messageID string) error {
+	args := m.Called(userID, messageID)
+	return args.Error(0)
+}
+
+// RemoveMembers mocks the method of the same name
+func (m *Channel) RemoveMembers(userIDs []string, message *stream_chat.Message) error {
+	args := m.Called(userIDs, message)
+	return args.Error(0)
+}
+
+// Truncate mocks the method of the same name
+func (m *Channel) Truncate() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+// TruncateWithOptions mocks the method of the same name
+func (m *Channel) TruncateWithOptions(options stream_chat.TruncateOptions) error {
+	args := m.Called(options)
+	return args.Error(0)
+}
+
+// UnBanUser mocks the method of the same name
+func (m *Channel) UnBanUser(targetID string, options map[string]string) error {
+	args := m.Called(targetID, options)
+	return args.Error(0)
+}
+
+// Update mocks the method of the same name
+func (m *Channel) Update(options map[string]interface{}, message *stream_chat.Message) error {
+	args := m.Called(options, message)
+	return args.Error(0)
+}
+
+// Query mocks the method of the same name
+func (m *Channel) Query(data map[string]interface{}) error {
+	args := m.Called(data)
+	return args.Error(0)
+}
+
+// QueryWithOptions mocks the method of the same name
+func (m *Channel) QueryWithOptions(req *stream_chat.ChannelQueryRequest) error {
+	args := m.Called(req)
+	return args.Error(0)
+}
+
+// Refresh mocks the method of the same name
+func (m *Channel) Refresh() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+// RefreshContext mocks the method of the same name
+func (m *Channel) RefreshContext(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+// Snapshot mocks the method of the same name
+func (m *Channel) Snapshot() *stream_chat.Channel {
+	args := m.Called()
+	r0, _ := args.Get(0).(*stream_chat.Channel)
+	return r0
+}
+
+// ReadState mocks the method of the same name
+func (m *Channel) ReadState(userID string) *stream_chat.ChannelRead {
+	args := m.Called(userID)
+	r0, _ := args.Get(0).(*stream_chat.ChannelRead)
+	return r0
+}
+
+// QueryWatchers mocks the method of the same name
+func (m *Channel) QueryWatchers(limit int, offset int) ([]*stream_chat.User, error) {
+	args := m.Called(limit, offset)
+	r0, _ := args.Get(0).([]*stream_chat.User)
+	return r0, args.Error(1)
+}
+
+// StopWatching mocks the method of the same name
+func (m *Channel) StopWatching(userID string, connectionID string) error {
+	args := m.Called(userID, connectionID)
+	return args.Error(0)
+}
+
+// Show mocks the method of the same name
+func (m *Channel) Show(userID string) error {
+	args := m.Called(userID)
+	return args.Error(0)
+}
+
+// Hide mocks the method of the same name
+func (m *Channel) Hide(userID string) error {
+	args := m.Called(userID)
+	return args.Error(0)
+}
+
+// HideWithHistoryClear mocks the method of the same name
+func (m *Channel) HideWithHistoryClear(userID string) error {
+	args := m.Called(userID)
+	return args.Error(0)
+}
+
+// PartialUpdateMember mocks the method of the same name
+func (m *Channel) PartialUpdateMember(userID string, set map[string]interface{}, unset []string) (*stream_chat.ChannelMember, error) {
+	args := m.Called(userID, set, unset)
+	r0, _ := args.Get(0).(*stream_chat.ChannelMember)
+	return r0, args.Error(1)
+}
+
+// Pin mocks the method of the same name
+func (m *Channel) Pin(userID string) (*stream_chat.ChannelMember, error) {
+	args := m.Called(userID)
+	r0, _ := args.Get(0).(*stream_chat.ChannelMember)
+	return r0, args.Error(1)
+}
+
+// Unpin mocks the method of the same name
+func (m *Channel) Unpin(userID string) (*stream_chat.ChannelMember, error) {
+	args := m.Called(userID)
+	r0, _ := args.Get(0).(*stream_chat.ChannelMember)
+	return r0, args.Error(1)
+}
+
+// Archive mocks the method of the same name
+func (m *Channel) Archive(userID string) (*stream_chat.ChannelMember, error) {
+	args := m.Called(userID)
+	r0, _ := args.Get(0).(*stream_chat.ChannelMember)
+	return r0, args.Error(1)
+}
+
+// Unarchive mocks the method of the same name
+func (m *Channel) Unarchive(userID string) (*stream_chat.ChannelMember, error) {
+	args := m.Called(userID)
+	r0, _ := args.Get(0).(*stream_chat.ChannelMember)
+	return r0, args.Error(1)
+}
+
+// InviteMembers mocks the method of the same name
+func (m *Channel) InviteMembers(userIDs ...string) error {
+	args := m.Called(userIDs)
+	return args.Error(0)
+}
+
+// InviteMembersWithMessage mocks the method of the same name
+func (m *Channel) InviteMembersWithMessage(userIDs []string, msg *stream_chat.Message) error {
+	args := m.Called(userIDs, msg)
+	return args.Error(0)
+}
+
+// SendFile mocks the method of the same name
+func (m *Channel) SendFile(request stream_chat.SendFileRequest) (string, error) {
+	args := m.Called(request)
+	r0, _ := args.Get(0).(string)
+	return r0, args.Error(1)
+}
+
+// SendLargeFile mocks the method of the same name
+func (m *Channel) SendLargeFile(request stream_chat.SendFileRequest, retries int) (string, error) {
+	args := m.Called(request, retries)
+	r0, _ := args.Get(0).(string)
+	return r0, args.Error(1)
+}
+
+// SendImage mocks the method of the same name
+func (m *Channel) SendImage(request stream_chat.SendFileRequest) (string, error) {
+	args := m.Called(request)
+	r0, _ := args.Get(0).(string)
+	return r0, args.Error(1)
+}
+
+// DeleteFile mocks the method of the same name
+func (m *Channel) DeleteFile(location string) error {
+	args := m.Called(location)
+	return args.Error(0)
+}
+
+// DeleteImage mocks the method of the same name
+func (m *Channel) DeleteImage(location string) error {
+	args := m.Called(location)
+	return args.Error(0)
+}
+
+// AcceptInvite mocks the method of the same name
+func (m *Channel) AcceptInvite(userID string, message *stream_chat.Message) error {
+	args := m.Called(userID, message)
+	return args.Error(0)
+}
+
+// RejectInvite mocks the method of the same name
+func (m *Channel) RejectInvite(userID string, message *stream_chat.Message) error {
+	args := m.Called(userID, message)
+	return args.Error(0)
+}
+
+// Mute mocks the method of the same name
+func (m *Channel) Mute(userID string, expiration *time.Duration) (*stream_chat.ChannelMute, error) {
+	args := m.Called(userID, expiration)
+	r0, _ := args.Get(0).(*stream_chat.ChannelMute)
+	return r0, args.Error(1)
+}
+
+// SetAutoTranslation mocks the method of the same name
+func (m *Channel) SetAutoTranslation(enabled bool, language string) error {
+	args := m.Called(enabled, language)
+	return args.Error(0)
+}
+
+// Unmute mocks the method of the same name
+func (m *Channel) Unmute(userID string) error {
+	args := m.Called(userID)
+	return args.Error(0)
+}
+
+// SendEvent mocks the method of the same name
+func (m *Channel) SendEvent(event *stream_chat.Event, userID string) error {
+	args := m.Called(event, userID)
+	return args.Error(0)
+}
+
+// SendTypingStart mocks the method of the same name
+func (m *Channel) SendTypingStart(userID string, parentID string) error {
+	args := m.Called(userID, parentID)
+	return args.Error(0)
+}
+
+// SendTypingStop mocks the method of the same name
+func (m *Channel) SendTypingStop(userID string, parentID string) error {
+	args := m.Called(userID, parentID)
+	return args.Error(0)
+}
+
+// Export mocks the method of the same name
+func (m *Channel) Export(w io.Writer, options stream_chat.ChannelExportOptions) error {
+	args := m.Called(w, options)
+	return args.Error(0)
+}
+
+// DecodeExtraData mocks the method of the same name
+func (m *Channel) DecodeExtraData(dst interface{}) error {
+	args := m.Called(dst)
+	return args.Error(0)
+}
+
+// IterateMembers mocks the method of the same name
+func (m *Channel) IterateMembers(q *stream_chat.QueryOption, sort ...*stream_chat.SortOption) *stream_chat.MemberIterator {
+	args := m.Called(q, sort)
+	r0, _ := args.Get(0).(*stream_chat.MemberIterator)
+	return r0
+}
+
+// SendMessage mocks the method of the same name
+func (m *Channel) SendMessage(message *stream_chat.Message, userID string, options ...stream_chat.SendMessageOption) (*stream_chat.Message, error) {
+	args := m.Called(message, userID, options)
+	r0, _ := args.Get(0).(*stream_chat.Message)
+	return r0, args.Error(1)
+}
+
+// SendMessageWithResponse mocks the method of the same name
+func (m *Channel) SendMessageWithResponse(message *stream_chat.Message, userID string, options ...stream_chat.SendMessageOption) (*stream_chat.MessageResponse, error) {
+	args := m.Called(message, userID, options)
+	r0, _ := args.Get(0).(*stream_chat.MessageResponse)
+	return r0, args.Error(1)
+}
+
+// SendSystemMessage mocks the method of the same name
+func (m *Channel) SendSystemMessage(text string, userID string, options ...stream_chat.SendMessageOption) (*stream_chat.Message, error) {
+	args := m.Called(text, userID, options)
+	r0, _ := args.Get(0).(*stream_chat.Message)
+	return r0, args.Error(1)
+}
+
+// GetMessages mocks the method of the same name
+func (m *Channel) GetMessages(msgIDs []string) ([]*stream_chat.Message, error) {
+	args := m.Called(msgIDs)
+	r0, _ := args.Get(0).([]*stream_chat.Message)
+	return r0, args.Error(1)
+}
+
+// GetReplies mocks the method of the same name
+func (m *Channel) GetReplies(parentID string, options map[string][]string) ([]*stream_chat.Message, error) {
+	args := m.Called(parentID, options)
+	r0, _ := args.Get(0).([]*stream_chat.Message)
+	return r0, args.Error(1)
+}
+
+// GetRepliesWithOptions mocks the method of the same name
+func (m *Channel) GetRepliesWithOptions(parentID string, options stream_chat.RepliesOptions) (*stream_chat.RepliesResponse, error) {
+	args := m.Called(parentID, options)
+	r0, _ := args.Get(0).(*stream_chat.RepliesResponse)
+	return r0, args.Error(1)
+}
+
+// IterateReplies mocks the method of the same name
+func (m *Channel) IterateReplies(parentID string, options stream_chat.RepliesOptions) *stream_chat.MessageIterator {
+	args := m.Called(parentID, options)
+	r0, _ := args.Get(0).(*stream_chat.MessageIterator)
+	return r0
+}
+
+// IterateMessages mocks the method of the same name
+func (m *Channel) IterateMessages(options stream_chat.MessagePaginationParams) *stream_chat.MessageIterator {
+	args := m.Called(options)
+	r0, _ := args.Get(0).(*stream_chat.MessageIterator)
+	return r0
+}
+
+// SendAction mocks the method of the same name
+func (m *Channel) SendAction(msgID string, formData map[string]string) (*stream_chat.Message, error) {
+	args := m.Called(msgID, formData)
+	r0, _ := args.Get(0).(*stream_chat.Message)
+	return r0, args.Error(1)
+}
+
+// SendActionAsUser mocks the method of the same name
+func (m *Channel) SendActionAsUser(msgID string, userID string, formData map[string]string) (*stream_chat.Message, error) {
+	args := m.Called(msgID, userID, formData)
+	r0, _ := args.Get(0).(*stream_chat.Message)
+	return r0, args.Error(1)
+}
+
+// SendGiphyAction mocks the method of the same name
+func (m *Channel) SendGiphyAction(msgID string, userID string, action stream_chat.GiphyAction) (*stream_chat.Message, error) {
+	args := m.Called(msgID, userID, action)
+	r0, _ := args.Get(0).(*stream_chat.Message)
+	return r0, args.Error(1)
+}
+
+// GetPinnedMessages mocks the method of the same name
+func (m *Channel) GetPinnedMessages(options stream_chat.PinnedMessagesOptions) ([]*stream_chat.Message, error) {
+	args := m.Called(options)
+	r0, _ := args.Get(0).([]*stream_chat.Message)
+	return r0, args.Error(1)
+}
+
+// QueryMembers mocks the method of the same name
+func (m *Channel) QueryMembers(q *stream_chat.QueryOption, sort ...*stream_chat.SortOption) ([]*stream_chat.ChannelMember, error) {
+	args := m.Called(q, sort)
+	r0, _ := args.Get(0).([]*stream_chat.ChannelMember)
+	return r0, args.Error(1)
+}
+
+// DeleteReaction mocks the method of the same name
+func (m *Channel) DeleteReaction(messageID string, reactionType string, userID string) (*stream_chat.Message, error) {
+	args := m.Called(messageID, reactionType, userID)
+	r0, _ := args.Get(0).(*stream_chat.Message)
+	return r0, args.Error(1)
+}
+
+// GetReactions mocks the method of the same name
+func (m *Channel) GetReactions(messageID string, options map[string][]string) ([]*stream_chat.Reaction, error) {
+	args := m.Called(messageID, options)
+	r0, _ := args.Get(0).([]*stream_chat.Reaction)
+	return r0, args.Error(1)
+}
+
+// GetReactionsWithOptions mocks the method of the same name
+func (m *Channel) GetReactionsWithOptions(messageID string, options stream_chat.ReactionsOptions) (*stream_chat.ReactionsResponse, error) {
+	args := m.Called(messageID, options)
+	r0, _ := args.Get(0).(*stream_chat.ReactionsResponse)
+	return r0, args.Error(1)
+}
+
+// SendReaction mocks the method of the same name
+func (m *Channel) SendReaction(reaction *stream_chat.Reaction, messageID string, userID string, options ...stream_chat.SendReactionOption) (*stream_chat.Message, error) {
+	args := m.Called(reaction, messageID, userID, options)
+	r0, _ := args.Get(0).(*stream_chat.Message)
+	return r0, args.Error(1)
+}
@@ -0,0 +1,53 @@
+package mock
+
+import (
+	"errors"
+	"testing"
+
+	stream_chat "github.com/GetStream/stream-chat-go/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// notifyOrder is an example of application logic depending on the client
+func notifyOrder(client stream_chat.StreamClient, userID, orderID string) error {
+	ch, err := client.CreateChannel("messaging", "orders-"+userID, userID, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.QueryChannels(&stream_chat.QueryOption{
+		Filter: map[string]interface{}{"cid": ch.CID},
+	})
+
+	return err
+}
+
+func TestMockClient(t *testing.T) {
+	client := &Client{}
+	client.On("CreateChannel", "messaging", "orders-bob", "bob", map[string]interface{}(nil)).
+		Return(&stream_chat.Channel{Type: "messaging", ID: "orders-bob", CID: "messaging:orders-bob"}, nil)
+	client.On("QueryChannels", mock.Anything, []*stream_chat.SortOption(nil)).
+		Return(nil, errors.New("boom"))
+
+	err := notifyOrder(client, "bob", "42")
+	assert.EqualError(t, err, "boom")
+
+	client.AssertExpectations(t)
+}
+
+func TestMockChannel(t *testing.T) {
+	ch := &Channel{}
+	ch.On("SendMessage", mock.Anything, "bob", []stream_chat.SendMessageOption(nil)).
+		Return(&stream_chat.Message{ID: "msg"}, nil)
+	ch.On("Delete").Return(nil)
+
+	var c stream_chat.StreamChannel = ch
+
+	msg, err := c.SendMessage(&stream_chat.Message{Text: "hi"}, "bob")
+	assert.NoError(t, err)
+	assert.Equal(t, "msg", msg.ID)
+	assert.NoError(t, c.Delete())
+
+	ch.AssertExpectations(t)
+}
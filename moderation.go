@@ -0,0 +1,390 @@
+package stream_chat // nolint: golint
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+)
+
+type ModerationActionType string
+
+const (
+	ModerationActionMarkReviewed  ModerationActionType = "mark_reviewed"
+	ModerationActionDeleteMessage ModerationActionType = "delete_message"
+	ModerationActionDeleteUser    ModerationActionType = "delete_user"
+	ModerationActionBan           ModerationActionType = "ban"
+	ModerationActionUnban         ModerationActionType = "unban"
+	ModerationActionRestore       ModerationActionType = "restore"
+	ModerationActionUnblock       ModerationActionType = "unblock"
+	ModerationActionCustom        ModerationActionType = "custom"
+)
+
+// ModerationFlag is a flag of a review queue item, raised by a user or a moderation rule
+type ModerationFlag struct {
+	Type      string    `json:"type"`
+	Reason    string    `json:"reason,omitempty"`
+	UserID    string    `json:"user_id,omitempty"`
+	User      *User     `json:"user,omitempty"`
+	Labels    []string  `json:"labels,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+
+	ExtraData map[string]interface{} `json:"-,extra"` //nolint: staticcheck
+}
+
+// ReviewQueueItem is a flagged or blocked entity, ie a message, waiting for a moderator
+type ReviewQueueItem struct {
+	ID                string            `json:"id"`
+	EntityType        string            `json:"entity_type"` // ie "stream:chat:v1:message"
+	EntityID          string            `json:"entity_id"`
+	EntityCreatorID   string            `json:"entity_creator_id"`
+	EntityCreator     *User             `json:"entity_creator,omitempty"`
+	Message           *Message          `json:"message,omitempty"`
+	Status            string            `json:"status"`
+	RecommendedAction string            `json:"recommended_action"`
+	Languages         []string          `json:"languages,omitempty"`
+	Severity          int               `json:"severity"`
+	Flags             []*ModerationFlag `json:"flags"`
+	FlagsCount        int               `json:"flags_count"`
+
+	ReviewedBy string     `json:"reviewed_by,omitempty"`
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+// QueryReviewQueueRequest filters review queue items, ie {"status": "pending"}.
+// Next/Prev are cursors of a previous QueryReviewQueueResponse
+type QueryReviewQueueRequest struct {
+	Filter map[string]interface{} `json:"filter"`
+	Sort   []*SortOption          `json:"sort,omitempty"`
+	Limit  int                    `json:"limit,omitempty"`
+	Next   string                 `json:"next,omitempty"`
+	Prev   string                 `json:"prev,omitempty"`
+}
+
+type QueryReviewQueueResponse struct {
+	Items []*ReviewQueueItem `json:"items"`
+	Next  string             `json:"next"`
+	Prev  string             `json:"prev"`
+}
+
+// ModerationBan are the options of ModerationActionBan
+type ModerationBan struct {
+	Reason         string `json:"reason,omitempty"`
+	Timeout        int    `json:"timeout,omitempty"` // minutes, zero bans forever
+	ChannelBanOnly bool   `json:"channel_ban_only,omitempty"`
+}
+
+// ModerationDeleteMessage are the options of ModerationActionDeleteMessage
+type ModerationDeleteMessage struct {
+	HardDelete bool `json:"hard_delete,omitempty"`
+}
+
+// ModerationDeleteUser are the options of ModerationActionDeleteUser
+type ModerationDeleteUser struct {
+	HardDelete          bool `json:"hard_delete,omitempty"`
+	MarkMessagesDeleted bool `json:"mark_messages_deleted,omitempty"`
+}
+
+// ModerationCustomAction is a custom action of ModerationActionCustom, handled by webhooks
+type ModerationCustomAction struct {
+	ID      string                 `json:"id"`
+	Options map[string]interface{} `json:"options,omitempty"`
+}
+
+// SubmitModerationActionRequest is the action of a moderator on a review queue item,
+// options of the action type are set in the field of the same name
+type SubmitModerationActionRequest struct {
+	ItemID     string               `json:"item_id"`
+	ActionType ModerationActionType `json:"action_type"`
+	UserID     string               `json:"user_id"` // moderator
+
+	Ban           *ModerationBan           `json:"ban,omitempty"`
+	DeleteMessage *ModerationDeleteMessage `json:"delete_message,omitempty"`
+	DeleteUser    *ModerationDeleteUser    `json:"delete_user,omitempty"`
+	Custom        *ModerationCustomAction  `json:"custom,omitempty"`
+}
+
+type submitModerationActionResponse struct {
+	Item *ReviewQueueItem `json:"item"`
+}
+
+// ModerationRule is the action to take for a label of a moderation engine, ie {"SPAM", "flag"}
+type ModerationRule struct {
+	Label  string `json:"label"`
+	Action string `json:"action"` // flag, block, shadow or remove
+}
+
+// ModerationEngineConfig is the configuration of a moderation engine of a ModerationConfig
+type ModerationEngineConfig struct {
+	Enabled bool              `json:"enabled"`
+	Rules   []*ModerationRule `json:"rules,omitempty"`
+}
+
+// ModerationConfig is a moderation policy, Key is either a channel type ("chat:messaging"),
+// a channel ("chat:messaging:general") or a custom key
+type ModerationConfig struct {
+	Key   string `json:"key"`
+	Async bool   `json:"async,omitempty"` // moderate after the message is sent
+
+	AITextConfig    *ModerationEngineConfig `json:"ai_text_config,omitempty"`
+	AIImageConfig   *ModerationEngineConfig `json:"ai_image_config,omitempty"`
+	BlockListConfig *ModerationEngineConfig `json:"block_list_config,omitempty"`
+
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+type moderationConfigResponse struct {
+	Config *ModerationConfig `json:"config"`
+}
+
+// QueryModerationConfigsRequest filters moderation configs, Next/Prev are cursors of a previous QueryModerationConfigsResponse
+type QueryModerationConfigsRequest struct {
+	Filter map[string]interface{} `json:"filter"`
+	Sort   []*SortOption          `json:"sort,omitempty"`
+	Limit  int                    `json:"limit,omitempty"`
+	Next   string                 `json:"next,omitempty"`
+	Prev   string                 `json:"prev,omitempty"`
+}
+
+type QueryModerationConfigsResponse struct {
+	Configs []*ModerationConfig `json:"configs"`
+	Next    string              `json:"next"`
+	Prev    string              `json:"prev"`
+}
+
+// QueryReviewQueue returns the review queue items matching the request
+func (c *Client) QueryReviewQueue(req QueryReviewQueueRequest) (*QueryReviewQueueResponse, error) {
+	if req.Filter == nil {
+		req.Filter = map[string]interface{}{}
+	}
+
+	var resp QueryReviewQueueResponse
+
+	err := c.makeRequest(http.MethodPost, "api/v2/moderation/review_queue", nil, req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// SubmitModerationAction takes the action on the review queue item, returns the reviewed item
+func (c *Client) SubmitModerationAction(req *SubmitModerationActionRequest) (*ReviewQueueItem, error) {
+	switch {
+	case req == nil:
+		return nil, errors.New("moderation action is nil")
+	case req.ItemID == "":
+		return nil, errors.New("review queue item ID is empty")
+	case req.ActionType == "":
+		return nil, errors.New("moderation action type is empty")
+	case req.UserID == "":
+		return nil, errors.New("user ID is empty")
+	case req.ActionType == ModerationActionCustom && (req.Custom == nil || req.Custom.ID == ""):
+		return nil, errors.New("custom moderation action ID is empty")
+	case req.Ban != nil && req.Ban.Timeout < 0:
+		return nil, errors.New("ban timeout must be not negative")
+	}
+
+	var resp submitModerationActionResponse
+
+	err := c.makeRequest(http.MethodPost, "api/v2/moderation/submit_action", nil, req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Item, nil
+}
+
+// UpsertModerationConfig creates or replaces the moderation config with the key
+func (c *Client) UpsertModerationConfig(config *ModerationConfig) (*ModerationConfig, error) {
+	switch {
+	case config == nil:
+		return nil, errors.New("moderation config is nil")
+	case config.Key == "":
+		return nil, errors.New("moderation config key is empty")
+	}
+
+	var resp moderationConfigResponse
+
+	err := c.makeRequest(http.MethodPost, "api/v2/moderation/config", nil, config, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Config, nil
+}
+
+// GetModerationConfig returns the moderation config with given key
+func (c *Client) GetModerationConfig(key string) (*ModerationConfig, error) {
+	if key == "" {
+		return nil, errors.New("moderation config key is empty")
+	}
+
+	p := path.Join("api/v2/moderation/config", url.PathEscape(key))
+
+	var resp moderationConfigResponse
+
+	err := c.makeRequest(http.MethodGet, p, nil, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Config, nil
+}
+
+// DeleteModerationConfig deletes the moderation config with given key
+func (c *Client) DeleteModerationConfig(key string) error {
+	if key == "" {
+		return errors.New("moderation config key is empty")
+	}
+
+	p := path.Join("api/v2/moderation/config", url.PathEscape(key))
+
+	return c.makeRequest(http.MethodDelete, p, nil, nil, nil)
+}
+
+// QueryModerationConfigs returns the moderation configs matching the request
+func (c *Client) QueryModerationConfigs(req QueryModerationConfigsRequest) (*QueryModerationConfigsResponse, error) {
+	if req.Filter == nil {
+		req.Filter = map[string]interface{}{}
+	}
+
+	var resp QueryModerationConfigsResponse
+
+	err := c.makeRequest(http.MethodPost, "api/v2/moderation/configs", nil, req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// FlagOptions are the options of a flag
+type FlagOptions struct {
+	UserID string                 // reporter, required for client side flags
+	Reason string                 // ie "spam", custom reasons are allowed
+	Custom map[string]interface{} // custom data of the flag, ie reporter notes
+}
+
+type flagRequest struct {
+	TargetMessageID string                 `json:"target_message_id,omitempty"`
+	TargetUserID    string                 `json:"target_user_id,omitempty"`
+	UserID          string                 `json:"user_id,omitempty"`
+	Reason          string                 `json:"reason,omitempty"`
+	Custom          map[string]interface{} `json:"custom,omitempty"`
+}
+
+// FlagMessageWithOptions flags the message with given ID for moderation with a reason
+func (c *Client) FlagMessageWithOptions(msgID string, options FlagOptions) error {
+	if msgID == "" {
+		return errors.New("message ID is empty")
+	}
+
+	req := flagRequest{
+		TargetMessageID: msgID,
+		UserID:          options.UserID,
+		Reason:          options.Reason,
+		Custom:          options.Custom,
+	}
+
+	return c.makeRequest(http.MethodPost, "moderation/flag", nil, req, nil)
+}
+
+// FlagUserWithOptions flags the user with given ID for moderation with a reason
+func (c *Client) FlagUserWithOptions(targetID string, options FlagOptions) error {
+	if targetID == "" {
+		return errors.New("target ID is empty")
+	}
+
+	req := flagRequest{
+		TargetUserID: targetID,
+		UserID:       options.UserID,
+		Reason:       options.Reason,
+		Custom:       options.Custom,
+	}
+
+	return c.makeRequest(http.MethodPost, "moderation/flag", nil, req, nil)
+}
+
+// FlagReport groups the flags of a message for review
+type FlagReport struct {
+	ID            string   `json:"id"`
+	Message       *Message `json:"message"`
+	MessageUserID string   `json:"message_user_id"`
+	FlagsCount    int      `json:"flags_count"`
+
+	ReviewResult  string                 `json:"review_result,omitempty"`
+	ReviewDetails map[string]interface{} `json:"review_details,omitempty"`
+	ReviewedBy    *User                  `json:"reviewed_by,omitempty"`
+	ReviewedAt    *time.Time             `json:"reviewed_at,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// QueryFlagReportsRequest filters flag reports, ie {"review_result": nil} for reports not reviewed yet
+type QueryFlagReportsRequest struct {
+	Filter map[string]interface{} `json:"filter_conditions"`
+	Limit  int                    `json:"limit,omitempty"`
+	Offset int                    `json:"offset,omitempty"`
+}
+
+type flagReportsResponse struct {
+	FlagReports []*FlagReport `json:"flag_reports"`
+}
+
+type reviewFlagReportRequest struct {
+	UserID        string                 `json:"user_id"`
+	ReviewResult  string                 `json:"review_result"`
+	ReviewDetails map[string]interface{} `json:"review_details,omitempty"`
+}
+
+type flagReportResponse struct {
+	FlagReport *FlagReport `json:"flag_report"`
+}
+
+// QueryFlagReports returns the flag reports matching the request
+func (c *Client) QueryFlagReports(req QueryFlagReportsRequest) ([]*FlagReport, error) {
+	if req.Filter == nil {
+		req.Filter = map[string]interface{}{}
+	}
+
+	var resp flagReportsResponse
+
+	err := c.makeRequest(http.MethodPost, "moderation/reports", nil, req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.FlagReports, nil
+}
+
+// ReviewFlagReport sets the review result of the flag report on behalf of the moderator with given ID,
+// result is custom, ie "reviewed" or "deleted", details are stored with it
+func (c *Client) ReviewFlagReport(reportID, userID, result string, details map[string]interface{}) (*FlagReport, error) {
+	switch {
+	case reportID == "":
+		return nil, errors.New("flag report ID is empty")
+	case userID == "":
+		return nil, errors.New("user ID is empty")
+	case result == "":
+		return nil, errors.New("review result is empty")
+	}
+
+	p := path.Join("moderation/reports", url.PathEscape(reportID))
+
+	req := reviewFlagReportRequest{UserID: userID, ReviewResult: result, ReviewDetails: details}
+
+	var resp flagReportResponse
+
+	err := c.makeRequest(http.MethodPatch, p, nil, req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.FlagReport, nil
+}
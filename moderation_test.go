@@ -0,0 +1,95 @@
+package stream_chat // nolint: golint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_ModerationConfig(t *testing.T) {
+	c := initClient(t)
+
+	ch := initChannel(t, c)
+	defer func() {
+		mustNoError(t, ch.Delete(), "delete channel")
+	}()
+
+	key := "chat:" + ch.Type + ":" + ch.ID
+
+	config, err := c.UpsertModerationConfig(&ModerationConfig{
+		Key: key,
+		BlockListConfig: &ModerationEngineConfig{
+			Enabled: true,
+			Rules:   []*ModerationRule{{Label: "profanity_en_2020_v1", Action: "flag"}},
+		},
+	})
+	mustNoError(t, err, "upsert moderation config")
+	assert.Equal(t, key, config.Key)
+	defer func() {
+		mustNoError(t, c.DeleteModerationConfig(key), "delete moderation config")
+	}()
+
+	got, err := c.GetModerationConfig(key)
+	mustNoError(t, err, "get moderation config")
+	if assert.NotNil(t, got.BlockListConfig) {
+		assert.True(t, got.BlockListConfig.Enabled)
+	}
+
+	resp, err := c.QueryModerationConfigs(QueryModerationConfigsRequest{
+		Filter: map[string]interface{}{"key": key},
+	})
+	mustNoError(t, err, "query moderation configs")
+	assert.Len(t, resp.Configs, 1)
+}
+
+func TestClient_ReviewQueue(t *testing.T) {
+	c := initClient(t)
+
+	resp, err := c.QueryReviewQueue(QueryReviewQueueRequest{
+		Filter: map[string]interface{}{"status": "pending"},
+		Limit:  10,
+	})
+	mustNoError(t, err, "query review queue")
+
+	for _, item := range resp.Items {
+		assert.NotEmpty(t, item.ID, "item ID")
+	}
+
+	_, err = c.SubmitModerationAction(&SubmitModerationActionRequest{
+		ItemID:     "item",
+		ActionType: ModerationActionCustom,
+		UserID:     serverUser.ID,
+	})
+	mustError(t, err, "custom action without ID")
+}
+
+func TestClient_FlagReports(t *testing.T) {
+	c := initClient(t)
+
+	ch := initChannel(t, c)
+	defer func() {
+		mustNoError(t, ch.Delete(), "delete channel")
+	}()
+
+	msg, err := ch.SendMessage(&Message{Text: "buy cheap watches"}, testUsers[0].ID)
+	mustNoError(t, err, "send message")
+
+	err = c.FlagMessageWithOptions(msg.ID, FlagOptions{
+		UserID: testUsers[1].ID,
+		Reason: "spam",
+		Custom: map[string]interface{}{"note": "third time today"},
+	})
+	mustNoError(t, err, "flag message")
+
+	reports, err := c.QueryFlagReports(QueryFlagReportsRequest{
+		Filter: map[string]interface{}{"message_id": msg.ID},
+	})
+	mustNoError(t, err, "query flag reports")
+	if assert.Len(t, reports, 1) {
+		report, err := c.ReviewFlagReport(reports[0].ID, serverUser.ID, "reviewed", map[string]interface{}{"action": "none"})
+		mustNoError(t, err, "review flag report")
+		assert.Equal(t, "reviewed", report.ReviewResult)
+	}
+
+	mustError(t, c.FlagUserWithOptions("", FlagOptions{Reason: "spam"}), "empty target ID")
+}
@@ -0,0 +1,161 @@
+package stream_chat // nolint: golint
+
+import (
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/http"
+	"os"
+	"time"
+)
+
+// Region is a region of the API
+type Region string
+
+const (
+	RegionUSEast    Region = "us-east"
+	RegionEUWest    Region = "eu-west"
+	RegionSingapore Region = "singapore"
+)
+
+// nolint: gochecknoglobals
+var regionBaseURLs = map[Region]string{
+	RegionUSEast:    defaultBaseURL,
+	RegionEUWest:    "https://chat-proxy-dublin.stream-io-api.com",
+	RegionSingapore: "https://chat-proxy-singapore.stream-io-api.com",
+}
+
+// BaseURL returns the base URL of the region, empty for unknown regions
+func (r Region) BaseURL() string {
+	return regionBaseURLs[r]
+}
+
+// Environment variables of NewClientFromEnv
+const (
+	EnvKey    = "STREAM_KEY"
+	EnvSecret = "STREAM_SECRET"
+	EnvURL    = "STREAM_CHAT_URL" // optional base URL
+)
+
+// ClientOption configures the client created by NewClient
+type ClientOption func(c *Client) error
+
+// WithBaseURL sets the base URL of the API, ie of a proxy
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		if baseURL == "" {
+			return errors.New("base URL is empty")
+		}
+
+		c.BaseURL = baseURL
+		return nil
+	}
+}
+
+// WithRegion sets the base URL of the API to the one of the region
+func WithRegion(region Region) ClientOption {
+	return func(c *Client) error {
+		baseURL := region.BaseURL()
+		if baseURL == "" {
+			return errors.New("unknown region: " + string(region))
+		}
+
+		c.BaseURL = baseURL
+		return nil
+	}
+}
+
+// WithHTTPClient sets the HTTP client of the requests, its timeout is the request timeout
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) error {
+		if httpClient == nil {
+			return errors.New("HTTP client is nil")
+		}
+
+		c.HTTP = httpClient
+		return nil
+	}
+}
+
+// WithRequestTimeout sets the timeout of the requests, 6s by default. Timeouts of single calls
+// can be overridden by Client.WithTimeout.
+func WithRequestTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) error {
+		if timeout < 0 {
+			return errors.New("request timeout is negative")
+		}
+
+		httpClient := *c.HTTP
+		httpClient.Timeout = timeout
+
+		c.HTTP = &httpClient
+		return nil
+	}
+}
+
+// TransportOptions tunes the connection pool of the HTTP transport, zero values keep the defaults
+// of http.DefaultTransport. High throughput clients should raise MaxIdleConnsPerHost
+// so that connections are reused instead of exhausting ephemeral ports.
+type TransportOptions struct {
+	MaxIdleConns        int           // idle connections across hosts, 100 by default
+	MaxIdleConnsPerHost int           // idle connections kept to the API, 2 by default
+	MaxConnsPerHost     int           // connections to the API, unlimited by default
+	IdleConnTimeout     time.Duration // idle connections are closed after it, 90s by default
+	KeepAlive           time.Duration // TCP keep-alive period, 30s by default
+	TLSClientConfig     *tls.Config   `json:"-"` // skipped by the generated marshalers, easyjson can't generate tls.Config
+}
+
+func (o TransportOptions) transport() *http.Transport {
+	keepAlive := o.KeepAlive
+	if keepAlive == 0 {
+		keepAlive = 30 * time.Second
+	}
+
+	t := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: keepAlive,
+		}).DialContext,
+		MaxIdleConns:          100,
+		MaxIdleConnsPerHost:   o.MaxIdleConnsPerHost,
+		MaxConnsPerHost:       o.MaxConnsPerHost,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+		TLSClientConfig:       o.TLSClientConfig,
+	}
+
+	if o.MaxIdleConns != 0 {
+		t.MaxIdleConns = o.MaxIdleConns
+	}
+	if o.IdleConnTimeout != 0 {
+		t.IdleConnTimeout = o.IdleConnTimeout
+	}
+
+	enableHTTP2(t)
+
+	return t
+}
+
+// WithTransport sets the transport of the HTTP client tuned by the options,
+// HTTP/2 is negotiated when built with Go 1.13 or later
+func WithTransport(options TransportOptions) ClientOption {
+	return func(c *Client) error {
+		httpClient := *c.HTTP
+		httpClient.Transport = options.transport()
+
+		c.HTTP = &httpClient
+		return nil
+	}
+}
+
+// NewClientFromEnv creates a new client with the API key, secret and optional base URL
+// of STREAM_KEY, STREAM_SECRET and STREAM_CHAT_URL environment variables, options override them
+func NewClientFromEnv(options ...ClientOption) (*Client, error) {
+	if baseURL := os.Getenv(EnvURL); baseURL != "" {
+		options = append([]ClientOption{WithBaseURL(baseURL)}, options...)
+	}
+
+	return NewClient(os.Getenv(EnvKey), []byte(os.Getenv(EnvSecret)), options...)
+}
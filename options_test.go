@@ -0,0 +1,133 @@
+package stream_chat // nolint: golint
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewClient_Options(t *testing.T) {
+	c, err := NewClient("key", []byte("secret"), WithRegion(RegionEUWest))
+	mustNoError(t, err, "new client")
+	assert.Equal(t, "https://chat-proxy-dublin.stream-io-api.com", c.BaseURL)
+
+	c, err = NewClient("key", []byte("secret"), WithRegion(RegionSingapore), WithBaseURL("http://localhost:3030"))
+	mustNoError(t, err, "new client")
+	assert.Equal(t, "http://localhost:3030", c.BaseURL, "last option wins")
+
+	_, err = NewClient("key", []byte("secret"), WithRegion("mars"))
+	mustError(t, err, "unknown region")
+}
+
+func TestNewClientFromEnv(t *testing.T) {
+	for _, env := range []string{EnvKey, EnvSecret, EnvURL} {
+		if old, ok := os.LookupEnv(env); ok {
+			defer os.Setenv(env, old)
+		} else {
+			defer os.Unsetenv(env)
+		}
+	}
+
+	mustNoError(t, os.Unsetenv(EnvKey), "unset key")
+	_, err := NewClientFromEnv()
+	mustError(t, err, "missing key")
+
+	mustNoError(t, os.Setenv(EnvKey, "key"), "set key")
+	mustNoError(t, os.Setenv(EnvSecret, "secret"), "set secret")
+	mustNoError(t, os.Setenv(EnvURL, "http://localhost:3030"), "set url")
+
+	c, err := NewClientFromEnv()
+	mustNoError(t, err, "new client from env")
+	assert.Equal(t, "key", c.apiKey)
+	assert.Equal(t, []byte("secret"), c.apiSecret)
+	assert.Equal(t, "http://localhost:3030", c.BaseURL)
+
+	c, err = NewClientFromEnv(WithRegion(RegionUSEast))
+	mustNoError(t, err, "new client from env")
+	assert.Equal(t, defaultBaseURL, c.BaseURL, "options override env")
+}
+
+func TestNewClient_Transport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"name":"location"}`))
+	}))
+	defer srv.Close()
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	c, err := NewClient("key", []byte("secret"), WithBaseURL(srv.URL), WithTransport(TransportOptions{
+		MaxIdleConnsPerHost: 64,
+		IdleConnTimeout:     time.Minute,
+		TLSClientConfig:     tlsConfig,
+	}))
+	mustNoError(t, err, "new client")
+
+	assert.Equal(t, defaultTimeout, c.HTTP.Timeout, "timeout is kept")
+
+	transport, ok := c.HTTP.Transport.(*http.Transport)
+	if assert.True(t, ok, "transport type: %T", c.HTTP.Transport) {
+		assert.Equal(t, 64, transport.MaxIdleConnsPerHost)
+		assert.Equal(t, 100, transport.MaxIdleConns, "default")
+		assert.Equal(t, time.Minute, transport.IdleConnTimeout)
+		assert.Equal(t, tlsConfig, transport.TLSClientConfig)
+	}
+
+	_, err = c.GetCommand("location")
+	mustNoError(t, err, "get command")
+
+	httpClient := &http.Client{Timeout: time.Second}
+	c, err = NewClient("key", []byte("secret"), WithHTTPClient(httpClient))
+	mustNoError(t, err, "new client")
+	assert.Equal(t, httpClient, c.HTTP)
+}
+
+func TestClient_WithTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+		_, _ = w.Write([]byte(`{"name":"location"}`))
+	}))
+	defer srv.Close()
+
+	c, err := NewClient("key", []byte("secret"), WithBaseURL(srv.URL), WithRequestTimeout(10*time.Millisecond))
+	mustNoError(t, err, "new client")
+
+	_, err = c.GetCommand("location")
+	mustError(t, err, "request timeout")
+
+	slow := c.WithTimeout(time.Second).(*Client)
+	_, err = slow.GetCommand("location")
+	mustNoError(t, err, "overridden timeout")
+
+	assert.Equal(t, 10*time.Millisecond, c.HTTP.Timeout, "client is not changed")
+	assert.Equal(t, c.HTTP.Transport, slow.HTTP.Transport, "connections are shared")
+
+	var calls []string
+	middleware := func(name string) RequestMiddleware {
+		return func(next RequestHandler) RequestHandler {
+			return func(r *http.Request) (*http.Response, error) {
+				calls = append(calls, name)
+				return next(r)
+			}
+		}
+	}
+
+	c.HTTP.Timeout = time.Second
+	c.Use(middleware("shared"), middleware("client"))
+	c.middlewares = c.middlewares[:1] // spare capacity an append could overwrite
+
+	copied := c.WithTimeout(time.Second)
+	copied.Use(middleware("copy"))
+	c.Use(middleware("client"))
+
+	_, err = copied.GetCommand("location")
+	mustNoError(t, err, "copy with middlewares")
+	assert.Equal(t, []string{"shared", "copy"}, calls, "middlewares aren't shared")
+
+	_, err = NewClient("key", []byte("secret"), WithRequestTimeout(-time.Second))
+	mustError(t, err, "negative timeout")
+}
@@ -0,0 +1,155 @@
+package stream_chat // nolint: golint
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+)
+
+type Permission struct {
+	Name   string `json:"name"`   // required
+	Action string `json:"action"` // one of: Deny Allow
+
+	Resources []string `json:"resources"` // required
+	Roles     []string `json:"roles"`
+	Owner     bool     `json:"owner"`
+	Priority  int      `json:"priority"` // required
+
+	// permission system v2 fields
+	ID          string                 `json:"id,omitempty"`
+	Description string                 `json:"description,omitempty"`
+	SameTeam    bool                   `json:"same_team,omitempty"`
+	Condition   map[string]interface{} `json:"condition,omitempty"`
+	Custom      bool                   `json:"custom,omitempty"`
+	Level       string                 `json:"level,omitempty"` // one of: app channel
+}
+
+type Role struct {
+	Name      string    `json:"name"`
+	Custom    bool      `json:"custom"`
+	Scopes    []string  `json:"scopes"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type roleResponse struct {
+	Role *Role `json:"role"`
+}
+
+type rolesResponse struct {
+	Roles []*Role `json:"roles"`
+}
+
+type permissionResponse struct {
+	Permission *Permission `json:"permission"`
+}
+
+type permissionsResponse struct {
+	Permissions []*Permission `json:"permissions"`
+}
+
+// CreateRole creates new custom role with given name
+func (c *Client) CreateRole(name string) (*Role, error) {
+	if name == "" {
+		return nil, errors.New("role name is empty")
+	}
+
+	data := map[string]interface{}{
+		"name": name,
+	}
+
+	var resp roleResponse
+
+	err := c.makeRequest(http.MethodPost, "roles", nil, data, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Role, nil
+}
+
+// DeleteRole removes custom role with given name
+func (c *Client) DeleteRole(name string) error {
+	if name == "" {
+		return errors.New("role name is empty")
+	}
+
+	p := path.Join("roles", url.PathEscape(name))
+
+	return c.makeRequest(http.MethodDelete, p, nil, nil, nil)
+}
+
+// ListRoles returns all builtin and custom roles
+func (c *Client) ListRoles() ([]*Role, error) {
+	var resp rolesResponse
+
+	err := c.makeRequest(http.MethodGet, "roles", nil, nil, &resp)
+
+	return resp.Roles, err
+}
+
+// GetPermission returns permission with given ID
+func (c *Client) GetPermission(id string) (*Permission, error) {
+	if id == "" {
+		return nil, errors.New("permission ID is empty")
+	}
+
+	p := path.Join("permissions", url.PathEscape(id))
+
+	var resp permissionResponse
+
+	err := c.makeRequest(http.MethodGet, p, nil, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Permission, nil
+}
+
+// CreatePermission creates new custom permission
+func (c *Client) CreatePermission(perm *Permission) error {
+	switch {
+	case perm == nil:
+		return errors.New("permission is nil")
+	case perm.ID == "":
+		return errors.New("permission ID is empty")
+	}
+
+	return c.makeRequest(http.MethodPost, "permissions", nil, perm, nil)
+}
+
+// UpdatePermission updates custom permission with given ID
+func (c *Client) UpdatePermission(id string, perm *Permission) error {
+	switch {
+	case id == "":
+		return errors.New("permission ID is empty")
+	case perm == nil:
+		return errors.New("permission is nil")
+	}
+
+	p := path.Join("permissions", url.PathEscape(id))
+
+	return c.makeRequest(http.MethodPut, p, nil, perm, nil)
+}
+
+// DeletePermission removes custom permission with given ID
+func (c *Client) DeletePermission(id string) error {
+	if id == "" {
+		return errors.New("permission ID is empty")
+	}
+
+	p := path.Join("permissions", url.PathEscape(id))
+
+	return c.makeRequest(http.MethodDelete, p, nil, nil, nil)
+}
+
+// ListPermissions returns all builtin and custom permissions
+func (c *Client) ListPermissions() ([]*Permission, error) {
+	var resp permissionsResponse
+
+	err := c.makeRequest(http.MethodGet, "permissions", nil, nil, &resp)
+
+	return resp.Permissions, err
+}
@@ -0,0 +1,60 @@
+package stream_chat // nolint: golint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Roles(t *testing.T) {
+	c := initClient(t)
+
+	name := randomString(12)
+
+	role, err := c.CreateRole(name)
+	mustNoError(t, err, "create role")
+	assert.Equal(t, name, role.Name)
+	assert.True(t, role.Custom, "role is custom")
+
+	roles, err := c.ListRoles()
+	mustNoError(t, err, "list roles")
+	assert.Condition(t, func() bool {
+		for _, r := range roles {
+			if r.Name == name {
+				return true
+			}
+		}
+		return false
+	}, "role exists")
+
+	mustNoError(t, c.DeleteRole(name), "delete role")
+}
+
+func TestClient_Permissions(t *testing.T) {
+	c := initClient(t)
+
+	perm := &Permission{
+		ID:          randomString(12),
+		Name:        "Read channel",
+		Description: "read channel for custom role",
+		Action:      "ReadChannel",
+		Level:       "channel",
+	}
+
+	mustNoError(t, c.CreatePermission(perm), "create permission")
+	defer func() {
+		mustNoError(t, c.DeletePermission(perm.ID), "delete permission")
+	}()
+
+	got, err := c.GetPermission(perm.ID)
+	mustNoError(t, err, "get permission")
+	assert.Equal(t, perm.Name, got.Name)
+	assert.True(t, got.Custom, "permission is custom")
+
+	perm.Description = "updated description"
+	mustNoError(t, c.UpdatePermission(perm.ID, perm), "update permission")
+
+	perms, err := c.ListPermissions()
+	mustNoError(t, err, "list permissions")
+	assert.NotEmpty(t, perms, "permissions exist")
+}
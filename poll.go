@@ -0,0 +1,326 @@
+package stream_chat // nolint: golint
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+)
+
+type PollVotingVisibility string
+
+const (
+	PollVotingVisibilityPublic    PollVotingVisibility = "public"
+	PollVotingVisibilityAnonymous PollVotingVisibility = "anonymous"
+)
+
+type PollOption struct {
+	ID   string `json:"id,omitempty"`
+	Text string `json:"text"`
+
+	ExtraData map[string]interface{} `json:"-,extra"` //nolint: staticcheck
+}
+
+// PollVote is a vote for a poll option, or an answer when IsAnswer is set
+type PollVote struct {
+	ID         string    `json:"id"`
+	PollID     string    `json:"poll_id"`
+	OptionID   string    `json:"option_id,omitempty"`
+	AnswerText string    `json:"answer_text,omitempty"`
+	IsAnswer   bool      `json:"is_answer,omitempty"`
+	UserID     string    `json:"user_id,omitempty"`
+	User       *User     `json:"user,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// Poll is a poll, it's sent to a channel by setting Message.PollID
+type Poll struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+
+	VotingVisibility          PollVotingVisibility `json:"voting_visibility,omitempty"`
+	EnforceUniqueVote         bool                 `json:"enforce_unique_vote"`
+	MaxVotesAllowed           int                  `json:"max_votes_allowed,omitempty"`
+	AllowUserSuggestedOptions bool                 `json:"allow_user_suggested_options"`
+	AllowAnswers              bool                 `json:"allow_answers"`
+	IsClosed                  bool                 `json:"is_closed"`
+
+	Options            []*PollOption  `json:"options"`
+	VoteCount          int            `json:"vote_count"`
+	VoteCountsByOption map[string]int `json:"vote_counts_by_option"`
+	AnswersCount       int            `json:"answers_count"`
+	LatestAnswers      []*PollVote    `json:"latest_answers"`
+	OwnVotes           []*PollVote    `json:"own_votes"`
+
+	CreatedByID string    `json:"created_by_id"`
+	CreatedBy   *User     `json:"created_by"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+
+	ExtraData map[string]interface{} `json:"-,extra"` //nolint: staticcheck
+}
+
+type pollRequest struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+
+	VotingVisibility          PollVotingVisibility `json:"voting_visibility,omitempty"`
+	EnforceUniqueVote         bool                 `json:"enforce_unique_vote"`
+	MaxVotesAllowed           int                  `json:"max_votes_allowed,omitempty"`
+	AllowUserSuggestedOptions bool                 `json:"allow_user_suggested_options"`
+	AllowAnswers              bool                 `json:"allow_answers"`
+	IsClosed                  bool                 `json:"is_closed"`
+	Options                   []*PollOption        `json:"options,omitempty"`
+
+	UserID string `json:"user_id"`
+
+	ExtraData map[string]interface{} `json:"-,extra"` //nolint: staticcheck
+}
+
+func newPollRequest(userID string, poll *Poll) (*pollRequest, error) {
+	switch {
+	case poll == nil:
+		return nil, errors.New("poll is nil")
+	case poll.Name == "":
+		return nil, errors.New("poll name is empty")
+	case userID == "":
+		return nil, errors.New("user ID is empty")
+	case poll.EnforceUniqueVote && poll.MaxVotesAllowed > 1:
+		return nil, errors.New("poll with unique vote can't allow multiple votes")
+	}
+
+	return &pollRequest{
+		ID:                        poll.ID,
+		Name:                      poll.Name,
+		Description:               poll.Description,
+		VotingVisibility:          poll.VotingVisibility,
+		EnforceUniqueVote:         poll.EnforceUniqueVote,
+		MaxVotesAllowed:           poll.MaxVotesAllowed,
+		AllowUserSuggestedOptions: poll.AllowUserSuggestedOptions,
+		AllowAnswers:              poll.AllowAnswers,
+		IsClosed:                  poll.IsClosed,
+		Options:                   poll.Options,
+		UserID:                    userID,
+		ExtraData:                 poll.ExtraData,
+	}, nil
+}
+
+type pollPartialUpdateRequest struct {
+	Set    map[string]interface{} `json:"set,omitempty"`
+	Unset  []string               `json:"unset,omitempty"`
+	UserID string                 `json:"user_id"`
+}
+
+type pollResponse struct {
+	Poll *Poll `json:"poll"`
+}
+
+// PollVoteRequest is a vote for the option with OptionID, or an answer with AnswerText
+type PollVoteRequest struct {
+	OptionID   string `json:"option_id,omitempty"`
+	AnswerText string `json:"answer_text,omitempty"`
+}
+
+type castVoteRequest struct {
+	Vote   PollVoteRequest `json:"vote"`
+	UserID string          `json:"user_id"`
+}
+
+type pollVoteResponse struct {
+	Vote *PollVote `json:"vote"`
+}
+
+// QueryPollsRequest filters polls, Next/Prev are cursors of a previous QueryPollsResponse
+type QueryPollsRequest struct {
+	Filter map[string]interface{} `json:"filter"`
+	Sort   []*SortOption          `json:"sort,omitempty"`
+	Limit  int                    `json:"limit,omitempty"`
+	Next   string                 `json:"next,omitempty"`
+	Prev   string                 `json:"prev,omitempty"`
+}
+
+type QueryPollsResponse struct {
+	Polls []*Poll `json:"polls"`
+	Next  string  `json:"next"`
+	Prev  string  `json:"prev"`
+}
+
+// CreatePoll creates a poll owned by user with given ID, send it by setting Message.PollID:
+//
+//	poll, err := client.CreatePoll(userID, &Poll{Name: "Lunch?", Options: []*PollOption{{Text: "Pizza"}, {Text: "Sushi"}}})
+//	...
+//	msg, err := channel.SendMessage(&Message{PollID: poll.ID}, userID)
+func (c *Client) CreatePoll(userID string, poll *Poll) (*Poll, error) {
+	req, err := newPollRequest(userID, poll)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp pollResponse
+
+	err = c.makeRequest(http.MethodPost, "polls", nil, req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Poll, nil
+}
+
+// GetPoll returns the poll with given ID, own votes are of the user with given ID
+func (c *Client) GetPoll(pollID, userID string) (*Poll, error) {
+	if pollID == "" {
+		return nil, errors.New("poll ID is empty")
+	}
+
+	p := path.Join("polls", url.PathEscape(pollID))
+
+	params := url.Values{}
+	if userID != "" {
+		params.Set("user_id", userID)
+	}
+
+	var resp pollResponse
+
+	err := c.makeRequest(http.MethodGet, p, params, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Poll, nil
+}
+
+// UpdatePoll replaces the poll with given poll, fields not set are reset
+func (c *Client) UpdatePoll(userID string, poll *Poll) (*Poll, error) {
+	req, err := newPollRequest(userID, poll)
+	if err != nil {
+		return nil, err
+	}
+	if req.ID == "" {
+		return nil, errors.New("poll ID is empty")
+	}
+
+	var resp pollResponse
+
+	err = c.makeRequest(http.MethodPut, "polls", nil, req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Poll, nil
+}
+
+// ClosePoll closes the poll with given ID, no more votes are accepted
+func (c *Client) ClosePoll(pollID, userID string) (*Poll, error) {
+	switch {
+	case pollID == "":
+		return nil, errors.New("poll ID is empty")
+	case userID == "":
+		return nil, errors.New("user ID is empty")
+	}
+
+	p := path.Join("polls", url.PathEscape(pollID))
+
+	req := pollPartialUpdateRequest{
+		Set:    map[string]interface{}{"is_closed": true},
+		UserID: userID,
+	}
+
+	var resp pollResponse
+
+	err := c.makeRequest(http.MethodPatch, p, nil, req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Poll, nil
+}
+
+// DeletePoll deletes the poll with given ID
+func (c *Client) DeletePoll(pollID, userID string) error {
+	switch {
+	case pollID == "":
+		return errors.New("poll ID is empty")
+	case userID == "":
+		return errors.New("user ID is empty")
+	}
+
+	p := path.Join("polls", url.PathEscape(pollID))
+
+	params := url.Values{}
+	params.Set("user_id", userID)
+
+	return c.makeRequest(http.MethodDelete, p, params, nil, nil)
+}
+
+// CastVote casts the vote of user with given ID on the poll sent with the message
+func (c *Client) CastVote(messageID, pollID, userID string, vote PollVoteRequest) (*PollVote, error) {
+	switch {
+	case messageID == "":
+		return nil, errors.New("message ID is empty")
+	case pollID == "":
+		return nil, errors.New("poll ID is empty")
+	case userID == "":
+		return nil, errors.New("user ID is empty")
+	case (vote.OptionID == "") == (vote.AnswerText == ""):
+		return nil, errors.New("either vote option ID or answer text must be set")
+	}
+
+	p := path.Join("messages", url.PathEscape(messageID), "polls", url.PathEscape(pollID), "vote")
+
+	req := castVoteRequest{Vote: vote, UserID: userID}
+
+	var resp pollVoteResponse
+
+	err := c.makeRequest(http.MethodPost, p, nil, req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Vote, nil
+}
+
+// RemoveVote removes the vote with given ID of user with given ID
+func (c *Client) RemoveVote(messageID, pollID, voteID, userID string) error {
+	switch {
+	case messageID == "":
+		return errors.New("message ID is empty")
+	case pollID == "":
+		return errors.New("poll ID is empty")
+	case voteID == "":
+		return errors.New("vote ID is empty")
+	case userID == "":
+		return errors.New("user ID is empty")
+	}
+
+	p := path.Join("messages", url.PathEscape(messageID), "polls", url.PathEscape(pollID), "vote", url.PathEscape(voteID))
+
+	params := url.Values{}
+	params.Set("user_id", userID)
+
+	return c.makeRequest(http.MethodDelete, p, params, nil, nil)
+}
+
+// QueryPolls returns polls matching the request, own votes are of the user with given ID
+func (c *Client) QueryPolls(userID string, req QueryPollsRequest) (*QueryPollsResponse, error) {
+	if req.Filter == nil {
+		req.Filter = map[string]interface{}{}
+	}
+
+	params := url.Values{}
+	if userID != "" {
+		params.Set("user_id", userID)
+	}
+
+	var resp QueryPollsResponse
+
+	err := c.makeRequest(http.MethodPost, "polls/query", params, req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,62 @@
+package stream_chat // nolint: golint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Polls(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer func() {
+		mustNoError(t, ch.Delete(), "delete channel")
+	}()
+
+	owner := serverUser.ID
+
+	poll, err := c.CreatePoll(owner, &Poll{
+		Name:         "Lunch?",
+		AllowAnswers: true,
+		Options:      []*PollOption{{Text: "Pizza"}, {Text: "Sushi"}},
+	})
+	mustNoError(t, err, "create poll")
+	require.Len(t, poll.Options, 2)
+
+	msg, err := ch.SendMessage(&Message{Text: "vote!", PollID: poll.ID}, owner)
+	mustNoError(t, err, "send poll message")
+	if assert.NotNil(t, msg.Poll, "message poll") {
+		assert.Equal(t, poll.ID, msg.Poll.ID)
+	}
+
+	voter := testUsers[0].ID
+
+	vote, err := c.CastVote(msg.ID, poll.ID, voter, PollVoteRequest{OptionID: poll.Options[0].ID})
+	mustNoError(t, err, "cast vote")
+
+	_, err = c.CastVote(msg.ID, poll.ID, voter, PollVoteRequest{})
+	mustError(t, err, "vote without option or answer")
+
+	got, err := c.GetPoll(poll.ID, voter)
+	mustNoError(t, err, "get poll")
+	assert.Equal(t, 1, got.VoteCount, "vote count")
+	assert.Len(t, got.OwnVotes, 1, "own votes")
+
+	mustNoError(t, c.RemoveVote(msg.ID, poll.ID, vote.ID, voter), "remove vote")
+
+	poll.Description = "where do we eat"
+	updated, err := c.UpdatePoll(owner, poll)
+	mustNoError(t, err, "update poll")
+	assert.Equal(t, "where do we eat", updated.Description)
+
+	closed, err := c.ClosePoll(poll.ID, owner)
+	mustNoError(t, err, "close poll")
+	assert.True(t, closed.IsClosed, "poll closed")
+
+	resp, err := c.QueryPolls(owner, QueryPollsRequest{Filter: map[string]interface{}{"id": poll.ID}})
+	mustNoError(t, err, "query polls")
+	assert.Len(t, resp.Polls, 1)
+
+	mustNoError(t, c.DeletePoll(poll.ID, owner), "delete poll")
+}
@@ -0,0 +1,196 @@
+package stream_chat // nolint: golint
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+)
+
+// APNPushConfig are the credentials and template of an APN push provider
+type APNPushConfig struct {
+	APNAuthType    string `json:"apn_auth_type,omitempty"` // "token" or "certificate"
+	APNAuthKey     string `json:"apn_auth_key,omitempty"`  // p8 key of token auth
+	APNKeyID       string `json:"apn_key_id,omitempty"`
+	APNTeamID      string `json:"apn_team_id,omitempty"`
+	APNTopic       string `json:"apn_topic,omitempty"`    // bundle ID of the app
+	APNP12Cert     string `json:"apn_p12_cert,omitempty"` // base64 encoded p12 certificate of certificate auth
+	APNDevelopment bool   `json:"apn_development,omitempty"`
+
+	APNNotificationTemplate string `json:"apn_notification_template,omitempty"`
+}
+
+// FirebasePushConfig are the credentials and templates of a Firebase push provider
+type FirebasePushConfig struct {
+	FirebaseCredentials string `json:"firebase_credentials,omitempty"` // service account JSON
+
+	FirebaseNotificationTemplate string `json:"firebase_notification_template,omitempty"`
+	FirebaseAPNTemplate          string `json:"firebase_apn_template,omitempty"`
+	FirebaseDataTemplate         string `json:"firebase_data_template,omitempty"`
+}
+
+// HuaweiPushConfig are the credentials of a Huawei push provider
+type HuaweiPushConfig struct {
+	HuaweiAppID     string `json:"huawei_app_id,omitempty"`
+	HuaweiAppSecret string `json:"huawei_app_secret,omitempty"`
+}
+
+// XiaomiPushConfig are the credentials of a Xiaomi push provider
+type XiaomiPushConfig struct {
+	XiaomiPackageName string `json:"xiaomi_package_name,omitempty"`
+	XiaomiAppSecret   string `json:"xiaomi_app_secret,omitempty"`
+}
+
+// PushProvider is a named push configuration, an app can have many providers of the same type,
+// ie for different bundles. Only the config of the provider type is used, its fields are promoted:
+//
+//	provider := &PushProvider{
+//		Type:             PushProviderHuawei,
+//		Name:             "huawei-prod",
+//		HuaweiPushConfig: HuaweiPushConfig{HuaweiAppID: id, HuaweiAppSecret: secret},
+//	}
+type PushProvider struct {
+	Type        pushProvider `json:"type"` // one of PushProvider* constants
+	Name        string       `json:"name"`
+	Description string       `json:"description,omitempty"`
+
+	DisabledAt     *time.Time `json:"disabled_at,omitempty"`
+	DisabledReason string     `json:"disabled_reason,omitempty"`
+
+	APNPushConfig
+	FirebasePushConfig
+	HuaweiPushConfig
+	XiaomiPushConfig
+
+	// set by the server, not sent on upsert
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+type pushProviderRequest struct {
+	PushProvider *PushProvider `json:"push_provider"`
+}
+
+type pushProviderResponse struct {
+	PushProvider *PushProvider `json:"push_provider"`
+}
+
+type pushProvidersResponse struct {
+	PushProviders []*PushProvider `json:"push_providers"`
+}
+
+// UpsertPushProvider creates or updates the push provider with the type and name of the given one,
+// ie to rotate its credentials
+func (c *Client) UpsertPushProvider(provider *PushProvider) (*PushProvider, error) {
+	switch {
+	case provider == nil:
+		return nil, errors.New("push provider is nil")
+	case provider.Type == "":
+		return nil, errors.New("push provider type is empty")
+	case provider.Name == "":
+		return nil, errors.New("push provider name is empty")
+	}
+
+	var resp pushProviderResponse
+
+	err := c.makeRequest(http.MethodPost, "push_providers", nil, pushProviderRequest{PushProvider: provider}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.PushProvider, nil
+}
+
+// ListPushProviders returns the push providers of the app
+func (c *Client) ListPushProviders() ([]*PushProvider, error) {
+	var resp pushProvidersResponse
+
+	err := c.makeRequest(http.MethodGet, "push_providers", nil, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.PushProviders, nil
+}
+
+// DeletePushProvider deletes the push provider with given type and name
+func (c *Client) DeletePushProvider(providerType, name string) error {
+	switch {
+	case providerType == "":
+		return errors.New("push provider type is empty")
+	case name == "":
+		return errors.New("push provider name is empty")
+	}
+
+	p := path.Join("push_providers", url.PathEscape(providerType), url.PathEscape(name))
+
+	return c.makeRequest(http.MethodDelete, p, nil, nil, nil)
+}
+
+type PushLevel string
+
+const (
+	PushLevelAll      PushLevel = "all"
+	PushLevelMentions PushLevel = "mentions" // only messages mentioning the user
+	PushLevelNone     PushLevel = "none"
+	PushLevelDefault  PushLevel = "default" // removes the preference, the user or app level is used
+)
+
+// PushPreferenceInput sets the push level of a user, or of a channel member when ChannelCID is set
+type PushPreferenceInput struct {
+	UserID     string    `json:"user_id"`
+	ChannelCID string    `json:"channel_cid,omitempty"`
+	ChatLevel  PushLevel `json:"chat_level,omitempty"`
+
+	DisabledUntil *time.Time `json:"disabled_until,omitempty"` // snoozes push notifications until the time
+	RemoveDisable bool       `json:"remove_disable,omitempty"` // removes the snooze
+}
+
+// PushPreferences are the push preferences of a user or a channel member
+type PushPreferences struct {
+	ChatLevel     PushLevel  `json:"chat_level,omitempty"`
+	DisabledUntil *time.Time `json:"disabled_until,omitempty"`
+}
+
+type pushPreferencesRequest struct {
+	Preferences []*PushPreferenceInput `json:"preferences"`
+}
+
+// UpsertPushPreferencesResponse are the resulting preferences of the users
+type UpsertPushPreferencesResponse struct {
+	UserPreferences        map[string]*PushPreferences            `json:"user_preferences"`         // by user ID
+	UserChannelPreferences map[string]map[string]*PushPreferences `json:"user_channel_preferences"` // by user ID and channel CID
+}
+
+// UpsertPushPreferences sets the push preferences of the users and channel members:
+//
+//	resp, err := client.UpsertPushPreferences([]*PushPreferenceInput{
+//		{UserID: "bob", ChatLevel: PushLevelMentions},
+//		{UserID: "bob", ChannelCID: "messaging:general", ChatLevel: PushLevelNone},
+//	})
+func (c *Client) UpsertPushPreferences(preferences []*PushPreferenceInput) (*UpsertPushPreferencesResponse, error) {
+	if len(preferences) == 0 {
+		return nil, errors.New("push preferences are empty")
+	}
+
+	for _, p := range preferences {
+		switch {
+		case p == nil:
+			return nil, errors.New("push preference is nil")
+		case p.UserID == "":
+			return nil, errors.New("push preference user ID is empty")
+		case p.DisabledUntil != nil && p.RemoveDisable:
+			return nil, errors.New("push preference can't be both disabled and enabled")
+		}
+	}
+
+	var resp UpsertPushPreferencesResponse
+
+	err := c.makeRequest(http.MethodPost, "push_preferences", nil, pushPreferencesRequest{Preferences: preferences}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,85 @@
+package stream_chat // nolint: golint
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_PushProviders(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/push_providers":
+			b, _ := ioutil.ReadAll(r.Body)
+			assert.JSONEq(t, `{"push_provider":{"type":"xiaomi","name":"china","xiaomi_package_name":"io.example",`+
+				`"xiaomi_app_secret":"secret"}}`, string(b))
+
+			_, _ = w.Write([]byte(`{"push_provider":{"type":"xiaomi","name":"china","xiaomi_package_name":"io.example",` +
+				`"created_at":"2021-01-01T00:00:00Z","updated_at":"2021-01-01T00:00:00Z"}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/push_providers":
+			_, _ = w.Write([]byte(`{"push_providers":[{"type":"firebase","name":"android",` +
+				`"firebase_notification_template":"{}"},{"type":"xiaomi","name":"china"}]}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/push_providers/xiaomi/china":
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := NewClient("key", []byte("secret"), WithBaseURL(srv.URL))
+	mustNoError(t, err, "new client")
+
+	provider, err := c.UpsertPushProvider(&PushProvider{
+		Type:             PushProviderXiaomi,
+		Name:             "china",
+		XiaomiPushConfig: XiaomiPushConfig{XiaomiPackageName: "io.example", XiaomiAppSecret: "secret"},
+	})
+	mustNoError(t, err, "upsert push provider")
+	assert.Equal(t, "io.example", provider.XiaomiPackageName)
+	if assert.NotNil(t, provider.CreatedAt, "created at is returned") {
+		assert.Equal(t, 2021, provider.CreatedAt.Year())
+	}
+
+	providers, err := c.ListPushProviders()
+	mustNoError(t, err, "list push providers")
+	if assert.Len(t, providers, 2) {
+		assert.Equal(t, PushProviderFirebase, providers[0].Type)
+		assert.Equal(t, "{}", providers[0].FirebaseNotificationTemplate)
+	}
+
+	mustNoError(t, c.DeletePushProvider(PushProviderXiaomi, "china"), "delete push provider")
+
+	_, err = c.UpsertPushProvider(&PushProvider{Type: PushProviderAPNS})
+	mustError(t, err, "empty name")
+}
+
+func TestClient_UpsertPushPreferences(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer func() {
+		mustNoError(t, ch.Delete(), "delete channel")
+	}()
+
+	user := randomUser()
+	mustNoError(t, ch.AddMembers([]string{user.ID}, nil), "add members")
+
+	resp, err := c.UpsertPushPreferences([]*PushPreferenceInput{
+		{UserID: user.ID, ChatLevel: PushLevelMentions},
+		{UserID: user.ID, ChannelCID: ch.CID, ChatLevel: PushLevelNone},
+	})
+	mustNoError(t, err, "upsert push preferences")
+
+	if assert.Contains(t, resp.UserPreferences, user.ID) {
+		assert.Equal(t, PushLevelMentions, resp.UserPreferences[user.ID].ChatLevel)
+	}
+	if assert.Contains(t, resp.UserChannelPreferences[user.ID], ch.CID) {
+		assert.Equal(t, PushLevelNone, resp.UserChannelPreferences[user.ID][ch.CID].ChatLevel)
+	}
+
+	_, err = c.UpsertPushPreferences([]*PushPreferenceInput{{ChatLevel: PushLevelAll}})
+	mustError(t, err, "empty user ID")
+}
@@ -1,9 +1,11 @@
 package stream_chat // nolint: golint
 
 import (
+	"errors"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/getstream/easyjson"
 )
@@ -14,6 +16,12 @@ type QueryOption struct {
 
 	Limit  int `json:"limit,omitempty"`  // pagination option: limit number of results
 	Offset int `json:"offset,omitempty"` // pagination option: offset to return items from
+
+	// user to run the query for; required by user specific filters, ie {"muted": true}
+	UserID string `json:"-"`
+
+	// return online presence of users, ie User.Online and User.LastActive; only used by QueryUsers
+	Presence bool `json:"-"`
 }
 
 type SortOption struct {
@@ -24,6 +32,7 @@ type SortOption struct {
 type queryUsersRequest struct {
 	FilterConditions *QueryOption  `json:"filter_conditions,omitempty"`
 	Sort             []*SortOption `json:"sort,omitempty"`
+	Presence         bool          `json:"presence,omitempty"`
 }
 
 type queryUsersResponse struct {
@@ -37,6 +46,9 @@ func (c *Client) QueryUsers(q *QueryOption, sort ...*SortOption) ([]*User, error
 		FilterConditions: q,
 		Sort:             sort,
 	}
+	if q != nil {
+		qp.Presence = q.Presence
+	}
 
 	data, err := easyjson.Marshal(&qp)
 	if err != nil {
@@ -53,9 +65,10 @@ func (c *Client) QueryUsers(q *QueryOption, sort ...*SortOption) ([]*User, error
 }
 
 type queryChannelRequest struct {
-	Watch    bool `json:"watch"`
-	State    bool `json:"state"`
-	Presence bool `json:"presence"`
+	Watch    bool   `json:"watch"`
+	State    bool   `json:"state"`
+	Presence bool   `json:"presence"`
+	UserID   string `json:"user_id,omitempty"`
 
 	FilterConditions *QueryOption  `json:"filter_conditions,omitempty"`
 	Sort             []*SortOption `json:"sort,omitempty"`
@@ -81,6 +94,10 @@ func (c *Client) QueryChannels(q *QueryOption, sort ...*SortOption) ([]*Channel,
 		Sort:             sort,
 	}
 
+	if q != nil {
+		qp.UserID = q.UserID
+	}
+
 	data, err := easyjson.Marshal(&qp)
 	if err != nil {
 		return nil, err
@@ -104,6 +121,78 @@ func (c *Client) QueryChannels(q *QueryOption, sort ...*SortOption) ([]*Channel,
 	return result, err
 }
 
+type queryMembersRequest struct {
+	Type             string                 `json:"type"`
+	ID               string                 `json:"id"`
+	FilterConditions map[string]interface{} `json:"filter_conditions"`
+	Sort             []*SortOption          `json:"sort,omitempty"`
+	Limit            int                    `json:"limit,omitempty"`
+	Offset           int                    `json:"offset,omitempty"`
+}
+
+type queryMembersResponse struct {
+	Members []*ChannelMember `json:"members"`
+}
+
+// QueryMembers returns list of channel members that match QueryOption, an empty filter matches all members.
+// If any number of SortOption are set, result will be sorted by field and direction in oder of sort options.
+func (ch *Channel) QueryMembers(q *QueryOption, sort ...*SortOption) ([]*ChannelMember, error) {
+	qp := queryMembersRequest{
+		Type:             ch.Type,
+		ID:               ch.ID,
+		FilterConditions: map[string]interface{}{},
+		Sort:             sort,
+	}
+
+	if q != nil {
+		if q.Filter != nil {
+			qp.FilterConditions = q.Filter
+		}
+		qp.Limit = q.Limit
+		qp.Offset = q.Offset
+	}
+
+	data, err := easyjson.Marshal(&qp)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(url.Values)
+	values.Set("payload", string(data))
+
+	var resp queryMembersResponse
+	err = ch.client.makeRequest(http.MethodGet, "members", values, nil, &resp)
+
+	return resp.Members, err
+}
+
+type InviteStatus string
+
+const (
+	InviteStatusPending  InviteStatus = "pending"
+	InviteStatusAccepted InviteStatus = "accepted"
+	InviteStatusRejected InviteStatus = "rejected"
+)
+
+// QueryInvites returns channels with invites of userID in given status, one of InviteStatus* constants
+func (c *Client) QueryInvites(userID string, status InviteStatus, sort ...*SortOption) ([]*Channel, error) {
+	switch {
+	case userID == "":
+		return nil, errors.New("user ID is empty")
+	case status == "":
+		return nil, errors.New("invite status is empty")
+	}
+
+	q := &QueryOption{
+		Filter: map[string]interface{}{
+			"invite": status,
+		},
+		UserID: userID,
+	}
+
+	return c.QueryChannels(q, sort...)
+}
+
 type SearchRequest struct {
 	// Required
 	Query   string                 `json:"query"`
@@ -144,3 +233,104 @@ func (c *Client) Search(request SearchRequest) ([]*Message, error) {
 
 	return messages, err
 }
+
+type MessageFlag struct {
+	CreatedByAutomod bool     `json:"created_by_automod"`
+	User             *User    `json:"user"`    // user who flagged the message
+	Message          *Message `json:"message"` // flagged message
+
+	ReviewedBy *User      `json:"reviewed_by,omitempty"`
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	ApprovedAt *time.Time `json:"approved_at,omitempty"`
+	RejectedAt *time.Time `json:"rejected_at,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type queryMessageFlagsRequest struct {
+	FilterConditions map[string]interface{} `json:"filter_conditions,omitempty"`
+	Limit            int                    `json:"limit,omitempty"`
+	Offset           int                    `json:"offset,omitempty"`
+}
+
+type queryMessageFlagsResponse struct {
+	Flags []*MessageFlag `json:"flags"`
+}
+
+// QueryMessageFlags returns list of message flags that match QueryOption,
+// ie {"channel_cid": "messaging:general"} or {"user_id": "reporter-id"}.
+func (c *Client) QueryMessageFlags(q *QueryOption) ([]*MessageFlag, error) {
+	qp := queryMessageFlagsRequest{}
+	if q != nil {
+		qp.FilterConditions = q.Filter
+		qp.Limit = q.Limit
+		qp.Offset = q.Offset
+	}
+
+	data, err := easyjson.Marshal(&qp)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(url.Values)
+	values.Set("payload", string(data))
+
+	var resp queryMessageFlagsResponse
+	err = c.makeRequest(http.MethodGet, "moderation/flags/message", values, nil, &resp)
+
+	return resp.Flags, err
+}
+
+// Ban is a ban of a user, app wide or in the channel when Channel is set
+type Ban struct {
+	User     *User      `json:"user"`      // banned user
+	BannedBy *User      `json:"banned_by"` // user who created the ban
+	Channel  *Channel   `json:"channel,omitempty"`
+	Reason   string     `json:"reason,omitempty"`
+	Expires  *time.Time `json:"expires,omitempty"`
+	Shadow   bool       `json:"shadow,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type queryBannedUsersRequest struct {
+	FilterConditions map[string]interface{} `json:"filter_conditions"`
+	Sort             []*SortOption          `json:"sort,omitempty"`
+	Limit            int                    `json:"limit,omitempty"`
+	Offset           int                    `json:"offset,omitempty"`
+}
+
+type queryBannedUsersResponse struct {
+	Bans []*Ban `json:"bans"`
+}
+
+// QueryBannedUsers returns list of bans that match QueryOption,
+// ie {"channel_cid": "messaging:general"} or {"banned_by_id": "moderator-id"}.
+// If any number of SortOption are set, result will be sorted by field and direction in oder of sort options.
+func (c *Client) QueryBannedUsers(q *QueryOption, sort ...*SortOption) ([]*Ban, error) {
+	qp := queryBannedUsersRequest{
+		FilterConditions: map[string]interface{}{},
+		Sort:             sort,
+	}
+	if q != nil {
+		if q.Filter != nil {
+			qp.FilterConditions = q.Filter
+		}
+		qp.Limit = q.Limit
+		qp.Offset = q.Offset
+	}
+
+	data, err := easyjson.Marshal(&qp)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(url.Values)
+	values.Set("payload", string(data))
+
+	var resp queryBannedUsersResponse
+	err = c.makeRequest(http.MethodGet, "query_banned_users", values, nil, &resp)
+
+	return resp.Bans, err
+}
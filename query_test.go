@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestClient_QueryUsers(t *testing.T) {
@@ -24,6 +25,27 @@ func TestClient_QueryUsers(t *testing.T) {
 	}
 }
 
+func TestClient_QueryUsersPresence(t *testing.T) {
+	c := initClient(t)
+
+	user := randomUser()
+
+	ws, err := c.ConnectWebSocket(user.ID)
+	mustNoError(t, err, "connect websocket")
+	defer ws.Close()
+
+	users, err := c.QueryUsers(&QueryOption{
+		Filter:   map[string]interface{}{"id": user.ID},
+		Presence: true,
+	})
+	mustNoError(t, err, "query users")
+
+	if assert.Len(t, users, 1, "query users") {
+		assert.True(t, users[0].Online, "user is online")
+		assert.NotNil(t, users[0].LastActive, "user last active")
+	}
+}
+
 func TestClient_QueryChannels(t *testing.T) {
 	c := initClient(t)
 	ch := initChannel(t, c)
@@ -41,6 +63,30 @@ func TestClient_QueryChannels(t *testing.T) {
 	}
 }
 
+func TestClient_QueryInvites(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+
+	user := &User{ID: randomString(10)}
+	_, err := c.UpdateUser(user)
+	mustNoError(t, err, "update user")
+
+	err = ch.InviteMembersWithMessage([]string{user.ID}, &Message{Text: "join us", User: &User{ID: serverUser.ID}})
+	mustNoError(t, err, "invite members")
+
+	got, err := c.QueryInvites(user.ID, InviteStatusPending)
+	mustNoError(t, err, "query invites")
+
+	require.Len(t, got, 1, "pending invites")
+	assert.Equal(t, ch.CID, got[0].CID, "invited channel")
+
+	mustNoError(t, got[0].AcceptInvite(user.ID, nil), "accept invite")
+
+	got, err = c.QueryInvites(user.ID, InviteStatusAccepted)
+	mustNoError(t, err, "query invites")
+	assert.Len(t, got, 1, "accepted invites")
+}
+
 func TestClient_Search(t *testing.T) {
 	c := initClient(t)
 	ch := initChannel(t, c)
@@ -65,3 +111,81 @@ func TestClient_Search(t *testing.T) {
 
 	assert.Len(t, got, 2)
 }
+
+func TestClient_QueryMessageFlags(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+
+	msg, err := ch.SendMessage(&Message{Text: "test message"}, randomUser().ID)
+	mustNoError(t, err, "send message")
+
+	mustNoError(t, c.FlagMessageByUser(msg.ID, serverUser.ID), "flag message")
+
+	got, err := c.QueryMessageFlags(&QueryOption{Filter: map[string]interface{}{
+		"channel_cid": ch.CID,
+	}})
+	mustNoError(t, err, "query message flags")
+
+	if assert.Len(t, got, 1, "message flags") {
+		assert.Equal(t, msg.ID, got[0].Message.ID, "flagged message")
+		assert.Equal(t, serverUser.ID, got[0].User.ID, "flag reporter")
+	}
+}
+
+func TestClient_QueryBannedUsers(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer func() {
+		mustNoError(t, ch.Delete(), "delete channel")
+	}()
+
+	target := testUsers[0]
+
+	err := ch.BanUser(target.ID, serverUser.ID, map[string]interface{}{"reason": "spam"})
+	mustNoError(t, err, "ban user")
+
+	got, err := c.QueryBannedUsers(&QueryOption{
+		Filter: map[string]interface{}{"channel_cid": ch.CID},
+		Limit:  10,
+	}, &SortOption{Field: "created_at", Direction: -1})
+	mustNoError(t, err, "query banned users")
+
+	if assert.Len(t, got, 1, "bans") {
+		assert.Equal(t, target.ID, got[0].User.ID, "banned user")
+		assert.Equal(t, "spam", got[0].Reason, "ban reason")
+		assert.Equal(t, ch.CID, got[0].Channel.CID, "ban channel")
+	}
+}
+
+func TestClient_QueryTeams(t *testing.T) {
+	c := initClient(t)
+
+	app, err := c.GetAppConfig()
+	mustNoError(t, err, "get app")
+	if !app.MultiTenantEnabled {
+		t.Skip("app is not multi-tenant")
+	}
+
+	team := randomString(10)
+
+	user := &User{ID: randomString(10), Teams: []string{team}}
+	_, err = c.UpdateUser(user)
+	mustNoError(t, err, "update user")
+
+	ch, err := c.CreateChannel("team", randomString(12), user.ID, map[string]interface{}{"team": team})
+	mustNoError(t, err, "create channel")
+	defer func() {
+		mustNoError(t, ch.Delete(), "delete channel")
+	}()
+	assert.Equal(t, team, ch.Team, "channel team")
+
+	users, err := c.QueryUsers(&QueryOption{Filter: map[string]interface{}{"teams": map[string]interface{}{"$in": []string{team}}}})
+	mustNoError(t, err, "query users")
+	if assert.Len(t, users, 1) {
+		assert.Equal(t, []string{team}, users[0].Teams)
+	}
+
+	channels, err := c.QueryChannels(&QueryOption{Filter: map[string]interface{}{"team": team}})
+	mustNoError(t, err, "query channels")
+	assert.Len(t, channels, 1)
+}
@@ -5,12 +5,16 @@ import (
 	"net/http"
 	"net/url"
 	"path"
+	"strconv"
 )
 
+const maxReactionsPageSize = 300
+
 type Reaction struct {
 	MessageID string `json:"message_id"`
 	UserID    string `json:"user_id"`
 	Type      string `json:"type"`
+	Score     int    `json:"score,omitempty"` // cumulative reactions, ie 10 claps; 1 by default
 
 	// any other fields the user wants to attach a reaction
 	ExtraData map[string]interface{} `json:"-,extra"` //nolint: staticcheck
@@ -22,11 +26,34 @@ type reactionResponse struct {
 }
 
 type reactionRequest struct {
-	Reaction *Reaction `json:"reaction"`
+	Reaction      *Reaction `json:"reaction"`
+	EnforceUnique bool      `json:"enforce_unique,omitempty"`
+	SkipPush      bool      `json:"skip_push,omitempty"`
+}
+
+// SendReactionOption is an option of SendReaction request
+type SendReactionOption func(*reactionRequest)
+
+// ReactionEnforceUnique replaces the other reactions of the user to the message,
+// ie to allow a single reaction per user
+func ReactionEnforceUnique() SendReactionOption {
+	return func(r *reactionRequest) {
+		r.EnforceUnique = true
+	}
 }
 
-// SendReaction sends a reaction to message with given ID
-func (ch *Channel) SendReaction(reaction *Reaction, messageID, userID string) (*Message, error) {
+// ReactionSkipPush disables push notifications for the reaction
+func ReactionSkipPush() SendReactionOption {
+	return func(r *reactionRequest) {
+		r.SkipPush = true
+	}
+}
+
+// SendReaction sends a reaction to message with given ID.
+// Reactions with a score are cumulative, sending the same type again updates the score:
+//
+//	msg, err := ch.SendReaction(&Reaction{Type: "clap", Score: 10}, msgID, userID)
+func (ch *Channel) SendReaction(reaction *Reaction, messageID, userID string, options ...SendReactionOption) (*Message, error) {
 	switch {
 	case reaction == nil:
 		return nil, errors.New("reaction is nil")
@@ -34,6 +61,8 @@ func (ch *Channel) SendReaction(reaction *Reaction, messageID, userID string) (*
 		return nil, errors.New("message ID must be not empty")
 	case userID == "":
 		return nil, errors.New("user ID must be not empty")
+	case reaction.Score < 0:
+		return nil, errors.New("reaction score must be not negative")
 	}
 
 	var resp reactionResponse
@@ -43,6 +72,10 @@ func (ch *Channel) SendReaction(reaction *Reaction, messageID, userID string) (*
 	p := path.Join("messages", url.PathEscape(messageID), "reaction")
 
 	req := reactionRequest{Reaction: reaction}
+	for _, option := range options {
+		option(&req)
+	}
+
 	err := ch.client.makeRequest(http.MethodPost, p, nil, req, &resp)
 
 	return resp.Message, err
@@ -96,3 +129,86 @@ func (ch *Channel) GetReactions(messageID string, options map[string][]string) (
 
 	return resp.Reactions, err
 }
+
+// ReactionsOptions are offset pagination options of message reactions, newest reactions come first
+type ReactionsOptions struct {
+	Limit  int // reactions per page up to 300, server default if 0
+	Offset int
+
+	Totals bool // also get the message to report the reaction totals, one more request
+}
+
+// ReactionsResponse is a page of message reactions
+type ReactionsResponse struct {
+	Reactions []*Reaction `json:"reactions"`
+
+	NextOffset int `json:"-"` // offset of the next page, 0 for the last page
+
+	// reactions of the message in total and by type, only set with Totals option
+	Total          int            `json:"-"`
+	ReactionCounts map[string]int `json:"-"`
+}
+
+// GetReactionsWithOptions returns a page of the reactions for message with given ID:
+//
+//	options := ReactionsOptions{Limit: 100}
+//	for {
+//		page, err := ch.GetReactionsWithOptions(msgID, options)
+//		...
+//		if page.NextOffset == 0 {
+//			break
+//		}
+//		options.Offset = page.NextOffset
+//	}
+func (ch *Channel) GetReactionsWithOptions(messageID string, options ReactionsOptions) (*ReactionsResponse, error) {
+	switch {
+	case messageID == "":
+		return nil, errors.New("message ID is empty")
+	case options.Limit < 0 || options.Offset < 0:
+		return nil, errors.New("limit and offset must be not negative")
+	case options.Limit > maxReactionsPageSize:
+		return nil, errors.New("limit must be at most " + strconv.Itoa(maxReactionsPageSize))
+	}
+
+	params := url.Values{}
+	if options.Limit > 0 {
+		params.Set("limit", strconv.Itoa(options.Limit))
+	}
+	if options.Offset > 0 {
+		params.Set("offset", strconv.Itoa(options.Offset))
+	}
+
+	p := path.Join("messages", url.PathEscape(messageID), "reactions")
+
+	var resp ReactionsResponse
+
+	err := ch.client.makeRequest(http.MethodGet, p, params, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if !options.Totals {
+		// a full page may be followed by more reactions, server default limit is unknown
+		if n := len(resp.Reactions); n > 0 && (options.Limit == 0 || n == options.Limit) {
+			resp.NextOffset = options.Offset + n
+		}
+
+		return &resp, nil
+	}
+
+	msg, err := ch.client.GetMessage(messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.ReactionCounts = msg.ReactionCounts
+	for _, count := range msg.ReactionCounts {
+		resp.Total += count
+	}
+
+	if next := options.Offset + len(resp.Reactions); len(resp.Reactions) > 0 && next < resp.Total {
+		resp.NextOffset = next
+	}
+
+	return &resp, nil
+}
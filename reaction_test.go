@@ -1,6 +1,8 @@
 package stream_chat // nolint: golint
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -31,6 +33,32 @@ func TestChannel_SendReaction(t *testing.T) {
 	assert.Condition(t, reactionExistsCondition(msg.LatestReactions, reaction.Type), "latest reaction exists")
 }
 
+func TestChannel_SendReactionWithScore(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer func() {
+		mustNoError(t, ch.Delete(), "delete channel")
+	}()
+
+	msg, err := ch.SendMessage(&Message{Text: "great talk"}, serverUser.ID)
+	mustNoError(t, err, "send message")
+
+	user := randomUser()
+
+	clap := &Reaction{Type: "clap", Score: 10, ExtraData: map[string]interface{}{"emoji": "👏"}}
+	msg, err = ch.SendReaction(clap, msg.ID, user.ID)
+	mustNoError(t, err, "send clap")
+	assert.Equal(t, 10, msg.ReactionScores["clap"], "cumulative score")
+
+	msg, err = ch.SendReaction(&Reaction{Type: "love"}, msg.ID, user.ID, ReactionEnforceUnique(), ReactionSkipPush())
+	mustNoError(t, err, "send unique reaction")
+	assert.Equal(t, 1, msg.ReactionCounts["love"])
+	assert.Zero(t, msg.ReactionCounts["clap"], "other reactions are replaced")
+
+	_, err = ch.SendReaction(&Reaction{Type: "clap", Score: -1}, msg.ID, user.ID)
+	mustError(t, err, "negative score")
+}
+
 func reactionExistsCondition(reactions []*Reaction, searchType string) func() bool {
 	return func() bool {
 		for _, r := range reactions {
@@ -98,3 +126,70 @@ func TestChannel_GetReactions(t *testing.T) {
 
 	assert.Condition(t, reactionExistsCondition(reactions, reaction.Type), "reaction exists")
 }
+
+func TestChannel_GetReactionsWithOptions(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/messages/msg-id/reactions", r.URL.Path)
+		assert.Equal(t, "2", r.URL.Query().Get("limit"))
+
+		switch r.URL.Query().Get("offset") {
+		case "":
+			_, _ = w.Write([]byte(`{"reactions":[{"type":"like","user_id":"a"},{"type":"clap","user_id":"b","score":3}]}`))
+		case "2":
+			_, _ = w.Write([]byte(`{"reactions":[{"type":"like","user_id":"c"}]}`))
+		default:
+			t.Errorf("unexpected offset: %s", r.URL.Query().Get("offset"))
+		}
+	}))
+	defer srv.Close()
+
+	c, err := NewClient("key", []byte("secret"), WithBaseURL(srv.URL))
+	mustNoError(t, err, "new client")
+
+	ch := &Channel{Type: "messaging", ID: "general", client: c}
+
+	page, err := ch.GetReactionsWithOptions("msg-id", ReactionsOptions{Limit: 2})
+	mustNoError(t, err, "first page")
+	assert.Len(t, page.Reactions, 2)
+	assert.Equal(t, 3, page.Reactions[1].Score)
+	assert.Equal(t, 2, page.NextOffset)
+
+	page, err = ch.GetReactionsWithOptions("msg-id", ReactionsOptions{Limit: 2, Offset: page.NextOffset})
+	mustNoError(t, err, "last page")
+	assert.Len(t, page.Reactions, 1)
+	assert.Zero(t, page.NextOffset, "no more pages")
+
+	_, err = ch.GetReactionsWithOptions("msg-id", ReactionsOptions{Limit: 1000})
+	mustError(t, err, "too large limit")
+}
+
+func TestChannel_GetReactionsWithTotals(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/messages/msg-id/reactions":
+			_, _ = w.Write([]byte(`{"reactions":[{"type":"like","user_id":"a"},{"type":"clap","user_id":"b"}]}`))
+		case "/messages/msg-id":
+			_, _ = w.Write([]byte(`{"message":{"id":"msg-id","reaction_counts":{"like":1,"clap":1}}}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := NewClient("key", []byte("secret"), WithBaseURL(srv.URL))
+	mustNoError(t, err, "new client")
+
+	ch := &Channel{Type: "messaging", ID: "general", client: c}
+
+	page, err := ch.GetReactionsWithOptions("msg-id", ReactionsOptions{Limit: 2, Totals: true})
+	mustNoError(t, err, "get reactions with totals")
+	assert.Len(t, page.Reactions, 2)
+	assert.Equal(t, 2, page.Total)
+	assert.Equal(t, map[string]int{"like": 1, "clap": 1}, page.ReactionCounts)
+	assert.Zero(t, page.NextOffset, "a full page is the last one when totals are known")
+
+	page, err = ch.GetReactionsWithOptions("msg-id", ReactionsOptions{Limit: 2})
+	mustNoError(t, err, "get reactions")
+	assert.Zero(t, page.Total, "totals aren't requested")
+	assert.Equal(t, 2, page.NextOffset)
+}
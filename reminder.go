@@ -0,0 +1,116 @@
+package stream_chat // nolint: golint
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+)
+
+// Reminder is a "remind me later" of a message for a user
+type Reminder struct {
+	ChannelCID string     `json:"channel_cid"`
+	MessageID  string     `json:"message_id"`
+	Message    *Message   `json:"message,omitempty"`
+	UserID     string     `json:"user_id"`
+	User       *User      `json:"user,omitempty"`
+	RemindAt   *time.Time `json:"remind_at,omitempty"` // nil for reminders without a due time, ie bookmarks
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+type reminderRequest struct {
+	UserID   string     `json:"user_id"`
+	RemindAt *time.Time `json:"remind_at"`
+}
+
+type reminderResponse struct {
+	Reminder *Reminder `json:"reminder"`
+}
+
+// QueryRemindersRequest filters reminders of a user, Next/Prev are cursors of a previous QueryRemindersResponse
+type QueryRemindersRequest struct {
+	UserID string                 `json:"user_id"`
+	Filter map[string]interface{} `json:"filter"`
+	Sort   []*SortOption          `json:"sort,omitempty"`
+	Limit  int                    `json:"limit,omitempty"`
+	Next   string                 `json:"next,omitempty"`
+	Prev   string                 `json:"prev,omitempty"`
+}
+
+type QueryRemindersResponse struct {
+	Reminders []*Reminder `json:"reminders"`
+	Next      string      `json:"next"`
+	Prev      string      `json:"prev"`
+}
+
+// CreateReminder creates a reminder of the message with given ID for the user with given ID
+// remindAt: time of the reminder; optional, nil creates a reminder without a due time
+func (c *Client) CreateReminder(messageID, userID string, remindAt *time.Time) (*Reminder, error) {
+	return c.reminderRequest(http.MethodPost, messageID, userID, remindAt)
+}
+
+// UpdateReminder changes the time of the reminder of the message for the user
+func (c *Client) UpdateReminder(messageID, userID string, remindAt *time.Time) (*Reminder, error) {
+	return c.reminderRequest(http.MethodPatch, messageID, userID, remindAt)
+}
+
+func (c *Client) reminderRequest(method, messageID, userID string, remindAt *time.Time) (*Reminder, error) {
+	switch {
+	case messageID == "":
+		return nil, errors.New("message ID is empty")
+	case userID == "":
+		return nil, errors.New("user ID is empty")
+	}
+
+	p := path.Join("messages", url.PathEscape(messageID), "reminders")
+
+	req := reminderRequest{UserID: userID, RemindAt: remindAt}
+
+	var resp reminderResponse
+
+	err := c.makeRequest(method, p, nil, req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Reminder, nil
+}
+
+// DeleteReminder deletes the reminder of the message for the user
+func (c *Client) DeleteReminder(messageID, userID string) error {
+	switch {
+	case messageID == "":
+		return errors.New("message ID is empty")
+	case userID == "":
+		return errors.New("user ID is empty")
+	}
+
+	p := path.Join("messages", url.PathEscape(messageID), "reminders")
+
+	params := url.Values{}
+	params.Set("user_id", userID)
+
+	return c.makeRequest(http.MethodDelete, p, params, nil, nil)
+}
+
+// QueryReminders returns reminders of the user matching the request,
+// ie {"remind_at": {"$lte": time.Now()}} for due reminders
+func (c *Client) QueryReminders(req QueryRemindersRequest) (*QueryRemindersResponse, error) {
+	if req.UserID == "" {
+		return nil, errors.New("user ID is empty")
+	}
+	if req.Filter == nil {
+		req.Filter = map[string]interface{}{}
+	}
+
+	var resp QueryRemindersResponse
+
+	err := c.makeRequest(http.MethodPost, "reminders/query", nil, req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
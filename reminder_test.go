@@ -0,0 +1,47 @@
+package stream_chat // nolint: golint
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Reminders(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer func() {
+		mustNoError(t, ch.Delete(), "delete channel")
+	}()
+
+	user := testUsers[0].ID
+
+	msg, err := ch.SendMessage(&Message{Text: "remind me"}, testUsers[1].ID)
+	mustNoError(t, err, "send message")
+
+	remindAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	reminder, err := c.CreateReminder(msg.ID, user, &remindAt)
+	mustNoError(t, err, "create reminder")
+	assert.Equal(t, msg.ID, reminder.MessageID)
+	assert.Equal(t, ch.CID, reminder.ChannelCID)
+
+	later := remindAt.Add(time.Hour)
+	reminder, err = c.UpdateReminder(msg.ID, user, &later)
+	mustNoError(t, err, "update reminder")
+	if assert.NotNil(t, reminder.RemindAt) {
+		assert.True(t, later.Equal(*reminder.RemindAt), "reminder time updated")
+	}
+
+	resp, err := c.QueryReminders(QueryRemindersRequest{
+		UserID: user,
+		Filter: map[string]interface{}{"channel_cid": ch.CID},
+	})
+	mustNoError(t, err, "query reminders")
+	assert.Len(t, resp.Reminders, 1)
+
+	mustNoError(t, c.DeleteReminder(msg.ID, user), "delete reminder")
+
+	_, err = c.QueryReminders(QueryRemindersRequest{})
+	mustError(t, err, "query without user")
+}
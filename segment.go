@@ -0,0 +1,161 @@
+package stream_chat // nolint: golint
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+)
+
+type SegmentType string
+
+const (
+	SegmentTypeUser    SegmentType = "user"
+	SegmentTypeChannel SegmentType = "channel"
+)
+
+// Segment is an audience of users or channels, targeted by campaigns.
+// Targets are either matched by Filter or added explicitly by ID.
+type Segment struct {
+	ID          string                 `json:"id"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Type        SegmentType            `json:"type"`
+	Filter      map[string]interface{} `json:"filter"`
+	Size        int                    `json:"size"`
+	CreatedAt   time.Time              `json:"created_at"`
+	UpdatedAt   time.Time              `json:"updated_at"`
+}
+
+type segmentRequest struct {
+	ID          string                 `json:"id,omitempty"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description,omitempty"`
+	Type        SegmentType            `json:"type"`
+	Filter      map[string]interface{} `json:"filter,omitempty"`
+}
+
+type segmentResponse struct {
+	Segment *Segment `json:"segment"`
+}
+
+type segmentTargetsRequest struct {
+	TargetIDs []string `json:"target_ids"`
+}
+
+// QuerySegmentsRequest filters segments, Next/Prev are cursors of a previous QuerySegmentsResponse
+type QuerySegmentsRequest struct {
+	Filter map[string]interface{} `json:"filter"`
+	Sort   []*SortOption          `json:"sort,omitempty"`
+	Limit  int                    `json:"limit,omitempty"`
+	Next   string                 `json:"next,omitempty"`
+	Prev   string                 `json:"prev,omitempty"`
+}
+
+type QuerySegmentsResponse struct {
+	Segments []*Segment `json:"segments"`
+	Next     string     `json:"next"`
+	Prev     string     `json:"prev"`
+}
+
+// CreateSegment creates a new segment, type defaults to SegmentTypeUser
+func (c *Client) CreateSegment(segment *Segment) (*Segment, error) {
+	switch {
+	case segment == nil:
+		return nil, errors.New("segment is nil")
+	case segment.Name == "":
+		return nil, errors.New("segment name is empty")
+	}
+
+	req := segmentRequest{
+		ID:          segment.ID,
+		Name:        segment.Name,
+		Description: segment.Description,
+		Type:        segment.Type,
+		Filter:      segment.Filter,
+	}
+	if req.Type == "" {
+		req.Type = SegmentTypeUser
+	}
+
+	var resp segmentResponse
+
+	err := c.makeRequest(http.MethodPost, "segments", nil, req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Segment, nil
+}
+
+// GetSegment returns the segment with given ID
+func (c *Client) GetSegment(id string) (*Segment, error) {
+	if id == "" {
+		return nil, errors.New("segment ID is empty")
+	}
+
+	p := path.Join("segments", url.PathEscape(id))
+
+	var resp segmentResponse
+
+	err := c.makeRequest(http.MethodGet, p, nil, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Segment, nil
+}
+
+// QuerySegments returns segments matching the request
+func (c *Client) QuerySegments(req QuerySegmentsRequest) (*QuerySegmentsResponse, error) {
+	if req.Filter == nil {
+		req.Filter = map[string]interface{}{}
+	}
+
+	var resp QuerySegmentsResponse
+
+	err := c.makeRequest(http.MethodPost, "segments/query", nil, req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// DeleteSegment deletes the segment with given ID
+func (c *Client) DeleteSegment(id string) error {
+	if id == "" {
+		return errors.New("segment ID is empty")
+	}
+
+	p := path.Join("segments", url.PathEscape(id))
+
+	return c.makeRequest(http.MethodDelete, p, nil, nil, nil)
+}
+
+// AddSegmentTargets adds users or channels with given IDs to the segment,
+// channels are referenced by CID
+func (c *Client) AddSegmentTargets(id string, targetIDs []string) error {
+	return c.segmentTargets(id, "addtargets", targetIDs)
+}
+
+// RemoveSegmentTargets removes users or channels with given IDs from the segment
+func (c *Client) RemoveSegmentTargets(id string, targetIDs []string) error {
+	return c.segmentTargets(id, "deletetargets", targetIDs)
+}
+
+func (c *Client) segmentTargets(id, action string, targetIDs []string) error {
+	switch {
+	case id == "":
+		return errors.New("segment ID is empty")
+	case len(targetIDs) == 0:
+		return errors.New("target IDs are empty")
+	}
+
+	p := path.Join("segments", url.PathEscape(id), action)
+
+	req := segmentTargetsRequest{TargetIDs: targetIDs}
+
+	return c.makeRequest(http.MethodPost, p, nil, req, nil)
+}
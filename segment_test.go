@@ -0,0 +1,37 @@
+package stream_chat // nolint: golint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Segments(t *testing.T) {
+	c := initClient(t)
+
+	segment, err := c.CreateSegment(&Segment{
+		Name:   randomString(10),
+		Filter: map[string]interface{}{"role": "user"},
+	})
+	mustNoError(t, err, "create segment")
+	assert.Equal(t, SegmentTypeUser, segment.Type)
+	defer func() {
+		mustNoError(t, c.DeleteSegment(segment.ID), "delete segment")
+	}()
+
+	got, err := c.GetSegment(segment.ID)
+	mustNoError(t, err, "get segment")
+	assert.Equal(t, segment.Name, got.Name)
+
+	user := randomUser()
+	mustNoError(t, c.AddSegmentTargets(segment.ID, []string{user.ID}), "add targets")
+	mustNoError(t, c.RemoveSegmentTargets(segment.ID, []string{user.ID}), "remove targets")
+
+	resp, err := c.QuerySegments(QuerySegmentsRequest{
+		Filter: map[string]interface{}{"id": segment.ID},
+	})
+	mustNoError(t, err, "query segments")
+	assert.Len(t, resp.Segments, 1)
+
+	mustError(t, c.AddSegmentTargets(segment.ID, nil), "empty targets")
+}
@@ -4,6 +4,8 @@
 package stream_chat // nolint: golint
 
 import (
+	"context"
+	"io"
 	"time"
 )
 
@@ -18,6 +20,26 @@ type StreamClient interface {
 	// app.go
 	GetAppConfig() (*AppConfig, error)
 	UpdateAppSettings(settings *AppSettings) error
+	CheckSQS(req *CheckSQSRequest) (*CheckResponse, error)
+	CheckSNS(req *CheckSNSRequest) (*CheckResponse, error)
+	GetRateLimits(options GetRateLimitsOptions) (*GetRateLimitsResponse, error)
+
+	// blocklist.go
+	CreateBlocklist(name string, words []string) error
+	DeleteBlocklist(name string) error
+	GetBlocklist(name string) (*Blocklist, error)
+	ListBlocklists() ([]*Blocklist, error)
+	UpdateBlocklist(name string, words []string) error
+
+	// campaign.go
+	CreateCampaign(campaign *Campaign) (*Campaign, error)
+	GetCampaign(id string) (*Campaign, error)
+	GetCampaignStats(id string) (*CampaignStats, error)
+	StartCampaign(id string) (*Campaign, error)
+	StartCampaignWithOptions(id string, options StartCampaignOptions) (*Campaign, error)
+	StopCampaign(id string) (*Campaign, error)
+	TestCampaign(id string, userIDs []string) (*Campaign, error)
+	QueryCampaigns(req QueryCampaignsRequest) (*QueryCampaignsResponse, error)
 
 	// device.go
 	AddDevice(device *Device) error
@@ -26,6 +48,11 @@ type StreamClient interface {
 
 	// channel.go
 	CreateChannel(chanType string, chanID string, userID string, data map[string]interface{}) (*Channel, error)
+	CreateChannelWithOptions(chanType string, chanID string, userID string, options CreateChannelOptions) (*Channel, error)
+	CreateDistinctChannel(chanType string, members []string, createdBy string, data map[string]interface{}) (*Channel, error)
+	ChannelByCID(cid string) (*Channel, error)
+	DeleteChannels(cids []string, hardDelete bool) (taskID string, err error)
+	RestoreChannels(cids []string) error
 
 	// channel_type.go
 	CreateChannelType(chType *ChannelType) (*ChannelType, error)
@@ -33,41 +60,160 @@ type StreamClient interface {
 	GetChannelType(chanType string) (ct *ChannelType, err error)
 	ListChannelTypes() (map[string]*ChannelType, error)
 	UpdateChannelType(name string, options map[string]interface{}) error
+	UpdateChannelTypeModeration(name string, moderation ChannelTypeModeration) error
 
 	// client.go
 	CreateToken(userID string, expire time.Time) ([]byte, error)
+	SetLogger(logger Logger, level LogLevel)
+	Use(middleware ...RequestMiddleware)
 	VerifyWebhook(body []byte, signature []byte) (valid bool)
+	WithTimeout(timeout time.Duration) StreamClient
+
+	// command.go
+	CreateCommand(cmd *Command) (*Command, error)
+	DeleteCommand(name string) error
+	GetCommand(name string) (*Command, error)
+	ListCommands() ([]*Command, error)
+	UpdateCommand(name string, options map[string]interface{}) (*Command, error)
+
+	// event.go
+	SendUserEvent(userID string, event *Event) error
+
+	// export.go
+	ExportChannels(channels []*ExportableChannel, options *ExportChannelOptions) (taskID string, err error)
+	GetExportChannelsStatus(taskID string) (*ExportChannelsStatus, error)
+
+	// import.go
+	CreateImportURL(filename string) (*ImportURL, error)
+	CreateImport(filePath string, mode ImportMode) (*ImportTask, error)
+	GetImport(id string) (*ImportTask, error)
+	ListImports(limit, offset int) ([]*ImportTask, error)
+
+	// iterator.go
+	IterateUsers(q *QueryOption, sort ...*SortOption) *UserIterator
+	IterateChannels(q *QueryOption, sort ...*SortOption) *ChannelIterator
+	IterateSearch(request SearchRequest) *SearchIterator
 
 	// message.go
 	DeleteMessage(msgID string) error
+	HardDeleteMessage(msgID string) error
+	DeleteMessages(msgIDs []string, hard bool) error
 	GetMessage(msgID string) (*Message, error)
 	MarkAllRead(userID string) error
 	UpdateMessage(msg *Message, msgID string) (*Message, error)
 	FlagMessage(msgID string) error
 	UnflagMessage(msgID string) error
+	FlagMessageByUser(msgID string, userID string) error
+	PinMessage(msgID string, userID string, expiration *time.Time) (*Message, error)
+	UnpinMessage(msgID string, userID string) (*Message, error)
+	TranslateMessage(msgID string, language string) (*Message, error)
+	UnflagMessageByUser(msgID string, userID string) error
+	QueryMessageHistory(req QueryMessageHistoryRequest) (*QueryMessageHistoryResponse, error)
+
+	// moderation.go
+	QueryReviewQueue(req QueryReviewQueueRequest) (*QueryReviewQueueResponse, error)
+	SubmitModerationAction(req *SubmitModerationActionRequest) (*ReviewQueueItem, error)
+	UpsertModerationConfig(config *ModerationConfig) (*ModerationConfig, error)
+	GetModerationConfig(key string) (*ModerationConfig, error)
+	DeleteModerationConfig(key string) error
+	QueryModerationConfigs(req QueryModerationConfigsRequest) (*QueryModerationConfigsResponse, error)
+	FlagMessageWithOptions(msgID string, options FlagOptions) error
+	FlagUserWithOptions(targetID string, options FlagOptions) error
+	QueryFlagReports(req QueryFlagReportsRequest) ([]*FlagReport, error)
+	ReviewFlagReport(reportID string, userID string, result string, details map[string]interface{}) (*FlagReport, error)
+
+	// permission.go
+	CreateRole(name string) (*Role, error)
+	DeleteRole(name string) error
+	ListRoles() ([]*Role, error)
+	GetPermission(id string) (*Permission, error)
+	CreatePermission(perm *Permission) error
+	UpdatePermission(id string, perm *Permission) error
+	DeletePermission(id string) error
+	ListPermissions() ([]*Permission, error)
+
+	// poll.go
+	CreatePoll(userID string, poll *Poll) (*Poll, error)
+	GetPoll(pollID string, userID string) (*Poll, error)
+	UpdatePoll(userID string, poll *Poll) (*Poll, error)
+	ClosePoll(pollID string, userID string) (*Poll, error)
+	DeletePoll(pollID string, userID string) error
+	CastVote(messageID string, pollID string, userID string, vote PollVoteRequest) (*PollVote, error)
+	RemoveVote(messageID string, pollID string, voteID string, userID string) error
+	QueryPolls(userID string, req QueryPollsRequest) (*QueryPollsResponse, error)
+
+	// push.go
+	UpsertPushProvider(provider *PushProvider) (*PushProvider, error)
+	ListPushProviders() ([]*PushProvider, error)
+	DeletePushProvider(providerType string, name string) error
+	UpsertPushPreferences(preferences []*PushPreferenceInput) (*UpsertPushPreferencesResponse, error)
 
 	// query.go
 	QueryUsers(q *QueryOption, sort ...*SortOption) ([]*User, error)
 	QueryChannels(q *QueryOption, sort ...*SortOption) ([]*Channel, error)
+	QueryInvites(userID string, status InviteStatus, sort ...*SortOption) ([]*Channel, error)
 	Search(request SearchRequest) ([]*Message, error)
+	QueryMessageFlags(q *QueryOption) ([]*MessageFlag, error)
+	QueryBannedUsers(q *QueryOption, sort ...*SortOption) ([]*Ban, error)
+
+	// reminder.go
+	CreateReminder(messageID string, userID string, remindAt *time.Time) (*Reminder, error)
+	UpdateReminder(messageID string, userID string, remindAt *time.Time) (*Reminder, error)
+	DeleteReminder(messageID string, userID string) error
+	QueryReminders(req QueryRemindersRequest) (*QueryRemindersResponse, error)
+
+	// segment.go
+	CreateSegment(segment *Segment) (*Segment, error)
+	GetSegment(id string) (*Segment, error)
+	QuerySegments(req QuerySegmentsRequest) (*QuerySegmentsResponse, error)
+	DeleteSegment(id string) error
+	AddSegmentTargets(id string, targetIDs []string) error
+	RemoveSegmentTargets(id string, targetIDs []string) error
+
+	// task.go
+	GetTask(taskID string) (*Task, error)
+	WaitForTask(taskID string, timeout time.Duration) (*Task, error)
+
+	// thread.go
+	QueryThreads(userID string, req QueryThreadsRequest) (*QueryThreadsResponse, error)
+	GetThread(messageID string, options ThreadOptions) (*Thread, error)
 
 	// user.go
 	BanUser(targetID string, userID string, options map[string]interface{}) error
+	BanUserWithOptions(targetID string, userID string, options BanOptions) error
 	DeactivateUser(targetID string, options map[string]interface{}) error
 	ReactivateUser(targetID string, options map[string]interface{}) error
+	DeactivateUsers(userIDs []string, options DeactivateUserOptions) (taskID string, err error)
+	ReactivateUsers(userIDs []string, options ReactivateUserOptions) (taskID string, err error)
 	DeleteUser(targetID string, options map[string][]string) error
+	DeleteUserWithOptions(targetID string, options DeleteSingleUserOptions) error
+	DeleteUsers(userIDs []string, options DeleteUserOptions) (taskID string, err error)
+	RestoreUsers(userIDs []string) error
+	BlockUser(targetID string, userID string) error
+	UnblockUser(targetID string, userID string) error
+	GetBlockedUsers(userID string) ([]*UserBlock, error)
 	ExportUser(targetID string, options map[string][]string) (user *User, err error)
+	ExportUserData(targetID string, options map[string][]string) (*UserExport, error)
+	ExportUsers(userIDs []string) (taskID string, err error)
 	FlagUser(targetID string, options map[string]interface{}) error
 	MuteUser(targetID string, userID string) error
 	MuteUsers(targetIDs []string, userID string) error
+	MuteUserWithExpiration(targetID string, userID string, expiration time.Duration) (*Mute, error)
+	MuteUsersWithExpiration(targetIDs []string, userID string, expiration time.Duration) ([]*Mute, error)
 	UnBanUser(targetID string, options map[string]string) error
 	UnFlagUser(targetID string, options map[string]interface{}) error
 	UnmuteUser(targetID string, userID string) error
 	UnmuteUsers(targetIDs []string, userID string) error
+	CreateGuestUser(user *User) (guest *User, token string, err error)
 	UpdateUser(user *User) (*User, error)
 	UpdateUsers(users ...*User) (map[string]*User, error)
+	UpsertUser(user *User) (*User, error)
+	UpsertUsers(users ...*User) (map[string]*User, error)
 	PartialUpdateUser(update PartialUserUpdate) (*User, error)
 	PartialUpdateUsers(updates []PartialUserUpdate) (map[string]*User, error)
+
+	// websocket.go
+	ConnectWebSocket(userID string) (*WebSocket, error)
 }
 
 // StreamChannel is a channel of communication
@@ -76,37 +222,85 @@ type StreamChannel interface {
 	AddMembers(userIDs []string, message *Message) error
 	AddModerators(userIDs ...string) error
 	AddModeratorsWithMessage(userIDs []string, msg *Message) error
+	AssignRoles(assignments []*RoleAssignment, msg *Message) error
 	BanUser(targetID string, userID string, options map[string]interface{}) error
+	BanUserWithOptions(targetID string, userID string, options BanOptions) error
 	Delete() error
+	HardDelete() error
+	Freeze(message *Message) error
+	Unfreeze(message *Message) error
 	DemoteModerators(userIDs ...string) error
 	DemoteModeratorsWithMessage(userIDs []string, msg *Message) error
 	MarkRead(userID string, options map[string]interface{}) error
+	MarkReadWithOptions(userID string, options MarkReadOptions) error
+	MarkUnread(userID string, messageID string) error
 	RemoveMembers(userIDs []string, message *Message) error
 	Truncate() error
+	TruncateWithOptions(options TruncateOptions) error
 	UnBanUser(targetID string, options map[string]string) error
 	Update(options map[string]interface{}, message *Message) error
 	Query(data map[string]interface{}) error
+	QueryWithOptions(req *ChannelQueryRequest) error
+	Refresh() error
+	RefreshContext(ctx context.Context) error
+	Snapshot() *Channel
+	ReadState(userID string) *ChannelRead
+	QueryWatchers(limit int, offset int) ([]*User, error)
+	StopWatching(userID string, connectionID string) error
 	Show(userID string) error
 	Hide(userID string) error
 	HideWithHistoryClear(userID string) error
+	PartialUpdateMember(userID string, set map[string]interface{}, unset []string) (*ChannelMember, error)
+	Pin(userID string) (*ChannelMember, error)
+	Unpin(userID string) (*ChannelMember, error)
+	Archive(userID string) (*ChannelMember, error)
+	Unarchive(userID string) (*ChannelMember, error)
 	InviteMembers(userIDs ...string) error
 	InviteMembersWithMessage(userIDs []string, msg *Message) error
 	SendFile(request SendFileRequest) (url string, err error)
+	SendLargeFile(request SendFileRequest, retries int) (url string, err error)
 	SendImage(request SendFileRequest) (url string, err error)
 	DeleteFile(location string) error
 	DeleteImage(location string) error
 	AcceptInvite(userID string, message *Message) error
 	RejectInvite(userID string, message *Message) error
+	Mute(userID string, expiration *time.Duration) (*ChannelMute, error)
+	SetAutoTranslation(enabled bool, language string) error
+	Unmute(userID string) error
 	// event.go
 	SendEvent(event *Event, userID string) error
+	SendTypingStart(userID string, parentID string) error
+	SendTypingStop(userID string, parentID string) error
+
+	// export.go
+	Export(w io.Writer, options ChannelExportOptions) error
+
+	// extra_data.go
+	DecodeExtraData(dst interface{}) error
+
+	// iterator.go
+	IterateMembers(q *QueryOption, sort ...*SortOption) *MemberIterator
 
 	// message.go
-	SendMessage(message *Message, userID string) (*Message, error)
+	SendMessage(message *Message, userID string, options ...SendMessageOption) (*Message, error)
+	SendMessageWithResponse(message *Message, userID string, options ...SendMessageOption) (*MessageResponse, error)
+	SendSystemMessage(text string, userID string, options ...SendMessageOption) (*Message, error)
+	GetMessages(msgIDs []string) ([]*Message, error)
 	GetReplies(parentID string, options map[string][]string) (replies []*Message, err error)
+	GetRepliesWithOptions(parentID string, options RepliesOptions) (*RepliesResponse, error)
+	IterateReplies(parentID string, options RepliesOptions) *MessageIterator
+	IterateMessages(options MessagePaginationParams) *MessageIterator
 	SendAction(msgID string, formData map[string]string) (*Message, error)
+	SendActionAsUser(msgID string, userID string, formData map[string]string) (*Message, error)
+	SendGiphyAction(msgID string, userID string, action GiphyAction) (*Message, error)
+	GetPinnedMessages(options PinnedMessagesOptions) ([]*Message, error)
+
+	// query.go
+	QueryMembers(q *QueryOption, sort ...*SortOption) ([]*ChannelMember, error)
 
 	// reaction.go
 	DeleteReaction(messageID string, reactionType string, userID string) (*Message, error)
 	GetReactions(messageID string, options map[string][]string) ([]*Reaction, error)
-	SendReaction(reaction *Reaction, messageID string, userID string) (*Message, error)
+	GetReactionsWithOptions(messageID string, options ReactionsOptions) (*ReactionsResponse, error)
+	SendReaction(reaction *Reaction, messageID string, userID string, options ...SendReactionOption) (*Message, error)
 }
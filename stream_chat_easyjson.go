@@ -19,7 +19,226 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo(in *jlexer.Lexer, out *usersResponse) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2(in *jlexer.Lexer, out *wsUserDetails) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2(out *jwriter.Writer, in wsUserDetails) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v wsUserDetails) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v wsUserDetails) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *wsUserDetails) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *wsUserDetails) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV21(in *jlexer.Lexer, out *wsHealthCheck) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = EventType(in.String())
+		case "client_id":
+			out.ClientID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV21(out *jwriter.Writer, in wsHealthCheck) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"client_id\":"
+		out.RawString(prefix)
+		out.String(string(in.ClientID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v wsHealthCheck) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV21(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v wsHealthCheck) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV21(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *wsHealthCheck) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV21(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *wsHealthCheck) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV21(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV22(in *jlexer.Lexer, out *wsConnectRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = string(in.String())
+		case "user_details":
+			(out.UserDetails).UnmarshalEasyJSON(in)
+		case "server_determines_connection_id":
+			out.ServerDeterminesConnectionID = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV22(out *jwriter.Writer, in wsConnectRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.UserID))
+	}
+	{
+		const prefix string = ",\"user_details\":"
+		out.RawString(prefix)
+		(in.UserDetails).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"server_determines_connection_id\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ServerDeterminesConnectionID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v wsConnectRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV22(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v wsConnectRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV22(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *wsConnectRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV22(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *wsConnectRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV22(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV23(in *jlexer.Lexer, out *usersResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -76,7 +295,7 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo(out *jwriter.Writer, in usersResponse) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV23(out *jwriter.Writer, in usersResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -111,27 +330,27 @@ func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo(out *jwriter.Writer, i
 // MarshalJSON supports json.Marshaler interface
 func (v usersResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV23(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v usersResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo(w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV23(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *usersResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV23(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *usersResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo(l, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV23(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo1(in *jlexer.Lexer, out *usersRequest) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV24(in *jlexer.Lexer, out *usersRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -180,7 +399,7 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo1(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo1(out *jwriter.Writer, in usersRequest) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV24(out *jwriter.Writer, in usersRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -211,27 +430,27 @@ func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo1(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v usersRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo1(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV24(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v usersRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo1(w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV24(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *usersRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo1(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV24(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *usersRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo1(l, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV24(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo2(in *jlexer.Lexer, out *userRequest) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV25(in *jlexer.Lexer, out *userRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -262,10 +481,75 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo2(in *jlexer.Lexer, out
 			out.Image = string(in.String())
 		case "role":
 			out.Role = string(in.String())
+		case "language":
+			out.Language = string(in.String())
+		case "teams":
+			if in.IsNull() {
+				in.Skip()
+				out.Teams = nil
+			} else {
+				in.Delim('[')
+				if out.Teams == nil {
+					if !in.IsDelim(']') {
+						out.Teams = make([]string, 0, 4)
+					} else {
+						out.Teams = []string{}
+					}
+				} else {
+					out.Teams = (out.Teams)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v5 string
+					v5 = string(in.String())
+					out.Teams = append(out.Teams, v5)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		case "online":
 			out.Online = bool(in.Bool())
 		case "invisible":
 			out.Invisible = bool(in.Bool())
+		case "banned":
+			out.Banned = bool(in.Bool())
+		case "ban_expires":
+			if in.IsNull() {
+				in.Skip()
+				out.BanExpires = nil
+			} else {
+				if out.BanExpires == nil {
+					out.BanExpires = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.BanExpires).UnmarshalJSON(data))
+				}
+			}
+		case "shadow_banned":
+			out.ShadowBanned = bool(in.Bool())
+		case "deactivated_at":
+			if in.IsNull() {
+				in.Skip()
+				out.DeactivatedAt = nil
+			} else {
+				if out.DeactivatedAt == nil {
+					out.DeactivatedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.DeactivatedAt).UnmarshalJSON(data))
+				}
+			}
+		case "deleted_at":
+			if in.IsNull() {
+				in.Skip()
+				out.DeletedAt = nil
+			} else {
+				if out.DeletedAt == nil {
+					out.DeletedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.DeletedAt).UnmarshalJSON(data))
+				}
+			}
 		case "mutes":
 			if in.IsNull() {
 				in.Skip()
@@ -282,17 +566,48 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo2(in *jlexer.Lexer, out
 					out.Mutes = (out.Mutes)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v5 *Mute
+					var v6 *Mute
+					if in.IsNull() {
+						in.Skip()
+						v6 = nil
+					} else {
+						if v6 == nil {
+							v6 = new(Mute)
+						}
+						(*v6).UnmarshalEasyJSON(in)
+					}
+					out.Mutes = append(out.Mutes, v6)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "channel_mutes":
+			if in.IsNull() {
+				in.Skip()
+				out.ChannelMutes = nil
+			} else {
+				in.Delim('[')
+				if out.ChannelMutes == nil {
+					if !in.IsDelim(']') {
+						out.ChannelMutes = make([]*ChannelMute, 0, 8)
+					} else {
+						out.ChannelMutes = []*ChannelMute{}
+					}
+				} else {
+					out.ChannelMutes = (out.ChannelMutes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 *ChannelMute
 					if in.IsNull() {
 						in.Skip()
-						v5 = nil
+						v7 = nil
 					} else {
-						if v5 == nil {
-							v5 = new(Mute)
+						if v7 == nil {
+							v7 = new(ChannelMute)
 						}
-						(*v5).UnmarshalEasyJSON(in)
+						(*v7).UnmarshalEasyJSON(in)
 					}
-					out.Mutes = append(out.Mutes, v5)
+					out.ChannelMutes = append(out.ChannelMutes, v7)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -310,7 +625,7 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo2(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo2(out *jwriter.Writer, in userRequest) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV25(out *jwriter.Writer, in userRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -339,6 +654,25 @@ func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo2(out *jwriter.Writer,
 		out.RawString(prefix)
 		out.String(string(in.Role))
 	}
+	if in.Language != "" {
+		const prefix string = ",\"language\":"
+		out.RawString(prefix)
+		out.String(string(in.Language))
+	}
+	if len(in.Teams) != 0 {
+		const prefix string = ",\"teams\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v8, v9 := range in.Teams {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v9))
+			}
+			out.RawByte(']')
+		}
+	}
 	if in.Online {
 		const prefix string = ",\"online\":"
 		out.RawString(prefix)
@@ -349,29 +683,72 @@ func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo2(out *jwriter.Writer,
 		out.RawString(prefix)
 		out.Bool(bool(in.Invisible))
 	}
+	if in.Banned {
+		const prefix string = ",\"banned\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Banned))
+	}
+	if in.BanExpires != nil {
+		const prefix string = ",\"ban_expires\":"
+		out.RawString(prefix)
+		out.Raw((*in.BanExpires).MarshalJSON())
+	}
+	if in.ShadowBanned {
+		const prefix string = ",\"shadow_banned\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ShadowBanned))
+	}
+	if in.DeactivatedAt != nil {
+		const prefix string = ",\"deactivated_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.DeactivatedAt).MarshalJSON())
+	}
+	if in.DeletedAt != nil {
+		const prefix string = ",\"deleted_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.DeletedAt).MarshalJSON())
+	}
 	if len(in.Mutes) != 0 {
 		const prefix string = ",\"mutes\":"
 		out.RawString(prefix)
 		{
 			out.RawByte('[')
-			for v6, v7 := range in.Mutes {
-				if v6 > 0 {
+			for v10, v11 := range in.Mutes {
+				if v10 > 0 {
 					out.RawByte(',')
 				}
-				if v7 == nil {
+				if v11 == nil {
 					out.RawString("null")
 				} else {
-					(*v7).MarshalEasyJSON(out)
+					(*v11).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
 		}
 	}
-	for k, v := range in.ExtraData {
-		switch k {
-		case "id", "name", "image", "role", "online", "invisible", "mutes":
-			continue // don't allow field overwrites
-		}
+	if len(in.ChannelMutes) != 0 {
+		const prefix string = ",\"channel_mutes\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v12, v13 := range in.ChannelMutes {
+				if v12 > 0 {
+					out.RawByte(',')
+				}
+				if v13 == nil {
+					out.RawString("null")
+				} else {
+					(*v13).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	for k, v := range in.ExtraData {
+		switch k {
+		case "id", "name", "image", "role", "language", "teams", "online", "invisible", "banned", "ban_expires", "shadow_banned", "deactivated_at", "deleted_at", "mutes", "channel_mutes":
+			continue // don't allow field overwrites
+		}
 		out.RawByte(',')
 		out.String(string(k))
 		out.RawByte(':')
@@ -389,27 +766,27 @@ func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo2(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v userRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo2(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV25(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v userRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo2(w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV25(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *userRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo2(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV25(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *userRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo2(l, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV25(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo3(in *jlexer.Lexer, out *sendFileResponse) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV26(in *jlexer.Lexer, out *uploadReader) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -428,8 +805,6 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo3(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "file":
-			out.File = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -440,42 +815,37 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo3(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo3(out *jwriter.Writer, in sendFileResponse) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV26(out *jwriter.Writer, in uploadReader) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	{
-		const prefix string = ",\"file\":"
-		out.RawString(prefix[1:])
-		out.String(string(in.File))
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v sendFileResponse) MarshalJSON() ([]byte, error) {
+func (v uploadReader) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo3(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV26(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v sendFileResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo3(w, v)
+func (v uploadReader) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV26(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *sendFileResponse) UnmarshalJSON(data []byte) error {
+func (v *uploadReader) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo3(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV26(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *sendFileResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo3(l, v)
+func (v *uploadReader) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV26(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo4(in *jlexer.Lexer, out *sendActionRequest) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV27(in *jlexer.Lexer, out *truncateRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -494,28 +864,34 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo4(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "message_id":
-			out.MessageID = string(in.String())
-		case "form_data":
+		case "hard_delete":
+			out.HardDelete = bool(in.Bool())
+		case "skip_push":
+			out.SkipPush = bool(in.Bool())
+		case "truncated_at":
 			if in.IsNull() {
 				in.Skip()
+				out.TruncatedAt = nil
 			} else {
-				in.Delim('{')
-				if !in.IsDelim('}') {
-					out.FormData = make(map[string]string)
-				} else {
-					out.FormData = nil
+				if out.TruncatedAt == nil {
+					out.TruncatedAt = new(time.Time)
 				}
-				for !in.IsDelim('}') {
-					key := string(in.String())
-					in.WantColon()
-					var v8 string
-					v8 = string(in.String())
-					(out.FormData)[key] = v8
-					in.WantComma()
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.TruncatedAt).UnmarshalJSON(data))
 				}
-				in.Delim('}')
 			}
+		case "message":
+			if in.IsNull() {
+				in.Skip()
+				out.Message = nil
+			} else {
+				if out.Message == nil {
+					out.Message = new(messageRequestMessage)
+				}
+				(*out.Message).UnmarshalEasyJSON(in)
+			}
+		case "user_id":
+			out.UserID = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -526,63 +902,83 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo4(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo4(out *jwriter.Writer, in sendActionRequest) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV27(out *jwriter.Writer, in truncateRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	{
-		const prefix string = ",\"message_id\":"
+	if in.HardDelete {
+		const prefix string = ",\"hard_delete\":"
+		first = false
 		out.RawString(prefix[1:])
-		out.String(string(in.MessageID))
+		out.Bool(bool(in.HardDelete))
 	}
-	{
-		const prefix string = ",\"form_data\":"
-		out.RawString(prefix)
-		if in.FormData == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
-			out.RawString(`null`)
+	if in.SkipPush {
+		const prefix string = ",\"skip_push\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
 		} else {
-			out.RawByte('{')
-			v9First := true
-			for v9Name, v9Value := range in.FormData {
-				if v9First {
-					v9First = false
-				} else {
-					out.RawByte(',')
-				}
-				out.String(string(v9Name))
-				out.RawByte(':')
-				out.String(string(v9Value))
-			}
-			out.RawByte('}')
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.SkipPush))
+	}
+	if in.TruncatedAt != nil {
+		const prefix string = ",\"truncated_at\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.TruncatedAt).MarshalJSON())
+	}
+	if in.Message != nil {
+		const prefix string = ",\"message\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.Message).MarshalEasyJSON(out)
+	}
+	if in.UserID != "" {
+		const prefix string = ",\"user_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
 		}
+		out.String(string(in.UserID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v sendActionRequest) MarshalJSON() ([]byte, error) {
+func (v truncateRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo4(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV27(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v sendActionRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo4(w, v)
+func (v truncateRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV27(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *sendActionRequest) UnmarshalJSON(data []byte) error {
+func (v *truncateRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo4(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV27(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *sendActionRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo4(l, v)
+func (v *truncateRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV27(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo5(in *jlexer.Lexer, out *searchResponse) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV28(in *jlexer.Lexer, out *translateMessageRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -601,29 +997,8 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo5(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "results":
-			if in.IsNull() {
-				in.Skip()
-				out.Results = nil
-			} else {
-				in.Delim('[')
-				if out.Results == nil {
-					if !in.IsDelim(']') {
-						out.Results = make([]searchMessageResponse, 0, 8)
-					} else {
-						out.Results = []searchMessageResponse{}
-					}
-				} else {
-					out.Results = (out.Results)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v10 searchMessageResponse
-					(v10).UnmarshalEasyJSON(in)
-					out.Results = append(out.Results, v10)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "language":
+			out.Language = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -634,53 +1009,42 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo5(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo5(out *jwriter.Writer, in searchResponse) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV28(out *jwriter.Writer, in translateMessageRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"results\":"
+		const prefix string = ",\"language\":"
 		out.RawString(prefix[1:])
-		if in.Results == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v11, v12 := range in.Results {
-				if v11 > 0 {
-					out.RawByte(',')
-				}
-				(v12).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Language))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v searchResponse) MarshalJSON() ([]byte, error) {
+func (v translateMessageRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo5(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV28(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v searchResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo5(w, v)
+func (v translateMessageRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV28(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *searchResponse) UnmarshalJSON(data []byte) error {
+func (v *translateMessageRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo5(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV28(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *searchResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo5(l, v)
+func (v *translateMessageRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV28(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo6(in *jlexer.Lexer, out *searchMessageResponse) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV29(in *jlexer.Lexer, out *threadResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -699,15 +1063,15 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo6(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "message":
+		case "thread":
 			if in.IsNull() {
 				in.Skip()
-				out.Message = nil
+				out.Thread = nil
 			} else {
-				if out.Message == nil {
-					out.Message = new(Message)
+				if out.Thread == nil {
+					out.Thread = new(Thread)
 				}
-				(*out.Message).UnmarshalEasyJSON(in)
+				(*out.Thread).UnmarshalEasyJSON(in)
 			}
 		default:
 			in.SkipRecursive()
@@ -719,46 +1083,46 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo6(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo6(out *jwriter.Writer, in searchMessageResponse) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV29(out *jwriter.Writer, in threadResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"message\":"
+		const prefix string = ",\"thread\":"
 		out.RawString(prefix[1:])
-		if in.Message == nil {
+		if in.Thread == nil {
 			out.RawString("null")
 		} else {
-			(*in.Message).MarshalEasyJSON(out)
+			(*in.Thread).MarshalEasyJSON(out)
 		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v searchMessageResponse) MarshalJSON() ([]byte, error) {
+func (v threadResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo6(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV29(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v searchMessageResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo6(w, v)
+func (v threadResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV29(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *searchMessageResponse) UnmarshalJSON(data []byte) error {
+func (v *threadResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo6(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV29(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *searchMessageResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo6(l, v)
+func (v *threadResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV29(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo7(in *jlexer.Lexer, out *repliesResponse) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV210(in *jlexer.Lexer, out *testCampaignRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -777,33 +1141,25 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo7(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "messages":
+		case "user_ids":
 			if in.IsNull() {
 				in.Skip()
-				out.Messages = nil
+				out.UserIDs = nil
 			} else {
 				in.Delim('[')
-				if out.Messages == nil {
+				if out.UserIDs == nil {
 					if !in.IsDelim(']') {
-						out.Messages = make([]*Message, 0, 8)
+						out.UserIDs = make([]string, 0, 4)
 					} else {
-						out.Messages = []*Message{}
+						out.UserIDs = []string{}
 					}
 				} else {
-					out.Messages = (out.Messages)[:0]
+					out.UserIDs = (out.UserIDs)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v13 *Message
-					if in.IsNull() {
-						in.Skip()
-						v13 = nil
-					} else {
-						if v13 == nil {
-							v13 = new(Message)
-						}
-						(*v13).UnmarshalEasyJSON(in)
-					}
-					out.Messages = append(out.Messages, v13)
+					var v14 string
+					v14 = string(in.String())
+					out.UserIDs = append(out.UserIDs, v14)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -818,26 +1174,22 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo7(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo7(out *jwriter.Writer, in repliesResponse) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV210(out *jwriter.Writer, in testCampaignRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"messages\":"
+		const prefix string = ",\"user_ids\":"
 		out.RawString(prefix[1:])
-		if in.Messages == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.UserIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v14, v15 := range in.Messages {
-				if v14 > 0 {
+			for v15, v16 := range in.UserIDs {
+				if v15 > 0 {
 					out.RawByte(',')
 				}
-				if v15 == nil {
-					out.RawString("null")
-				} else {
-					(*v15).MarshalEasyJSON(out)
-				}
+				out.String(string(v16))
 			}
 			out.RawByte(']')
 		}
@@ -846,29 +1198,29 @@ func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo7(out *jwriter.Writer,
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v repliesResponse) MarshalJSON() ([]byte, error) {
+func (v testCampaignRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo7(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV210(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v repliesResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo7(w, v)
+func (v testCampaignRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV210(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *repliesResponse) UnmarshalJSON(data []byte) error {
+func (v *testCampaignRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo7(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV210(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *repliesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo7(l, v)
+func (v *testCampaignRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV210(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo8(in *jlexer.Lexer, out *reactionsResponse) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV211(in *jlexer.Lexer, out *taskResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -887,37 +1239,8 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo8(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "reactions":
-			if in.IsNull() {
-				in.Skip()
-				out.Reactions = nil
-			} else {
-				in.Delim('[')
-				if out.Reactions == nil {
-					if !in.IsDelim(']') {
-						out.Reactions = make([]*Reaction, 0, 8)
-					} else {
-						out.Reactions = []*Reaction{}
-					}
-				} else {
-					out.Reactions = (out.Reactions)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v16 *Reaction
-					if in.IsNull() {
-						in.Skip()
-						v16 = nil
-					} else {
-						if v16 == nil {
-							v16 = new(Reaction)
-						}
-						(*v16).UnmarshalEasyJSON(in)
-					}
-					out.Reactions = append(out.Reactions, v16)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "task_id":
+			out.TaskID = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -928,57 +1251,42 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo8(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo8(out *jwriter.Writer, in reactionsResponse) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV211(out *jwriter.Writer, in taskResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"reactions\":"
+		const prefix string = ",\"task_id\":"
 		out.RawString(prefix[1:])
-		if in.Reactions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v17, v18 := range in.Reactions {
-				if v17 > 0 {
-					out.RawByte(',')
-				}
-				if v18 == nil {
-					out.RawString("null")
-				} else {
-					(*v18).MarshalEasyJSON(out)
-				}
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.TaskID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v reactionsResponse) MarshalJSON() ([]byte, error) {
+func (v taskResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo8(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV211(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v reactionsResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo8(w, v)
+func (v taskResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV211(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *reactionsResponse) UnmarshalJSON(data []byte) error {
+func (v *taskResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo8(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV211(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *reactionsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo8(l, v)
+func (v *taskResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV211(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo9(in *jlexer.Lexer, out *reactionResponse) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV212(in *jlexer.Lexer, out *submitModerationActionResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -997,25 +1305,15 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo9(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "message":
+		case "item":
 			if in.IsNull() {
 				in.Skip()
-				out.Message = nil
-			} else {
-				if out.Message == nil {
-					out.Message = new(Message)
-				}
-				(*out.Message).UnmarshalEasyJSON(in)
-			}
-		case "reaction":
-			if in.IsNull() {
-				in.Skip()
-				out.Reaction = nil
+				out.Item = nil
 			} else {
-				if out.Reaction == nil {
-					out.Reaction = new(Reaction)
+				if out.Item == nil {
+					out.Item = new(ReviewQueueItem)
 				}
-				(*out.Reaction).UnmarshalEasyJSON(in)
+				(*out.Item).UnmarshalEasyJSON(in)
 			}
 		default:
 			in.SkipRecursive()
@@ -1027,55 +1325,46 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo9(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo9(out *jwriter.Writer, in reactionResponse) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV212(out *jwriter.Writer, in submitModerationActionResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"message\":"
+		const prefix string = ",\"item\":"
 		out.RawString(prefix[1:])
-		if in.Message == nil {
+		if in.Item == nil {
 			out.RawString("null")
 		} else {
-			(*in.Message).MarshalEasyJSON(out)
-		}
-	}
-	{
-		const prefix string = ",\"reaction\":"
-		out.RawString(prefix)
-		if in.Reaction == nil {
-			out.RawString("null")
-		} else {
-			(*in.Reaction).MarshalEasyJSON(out)
+			(*in.Item).MarshalEasyJSON(out)
 		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v reactionResponse) MarshalJSON() ([]byte, error) {
+func (v submitModerationActionResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo9(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV212(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v reactionResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo9(w, v)
+func (v submitModerationActionResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV212(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *reactionResponse) UnmarshalJSON(data []byte) error {
+func (v *submitModerationActionResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo9(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV212(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *reactionResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo9(l, v)
+func (v *submitModerationActionResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV212(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo10(in *jlexer.Lexer, out *reactionRequest) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV213(in *jlexer.Lexer, out *stopWatchingRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1094,16 +1383,8 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo10(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "reaction":
-			if in.IsNull() {
-				in.Skip()
-				out.Reaction = nil
-			} else {
-				if out.Reaction == nil {
-					out.Reaction = new(Reaction)
-				}
-				(*out.Reaction).UnmarshalEasyJSON(in)
-			}
+		case "user_id":
+			out.UserID = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1114,46 +1395,101 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo10(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo10(out *jwriter.Writer, in reactionRequest) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV213(out *jwriter.Writer, in stopWatchingRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"reaction\":"
+		const prefix string = ",\"user_id\":"
 		out.RawString(prefix[1:])
-		if in.Reaction == nil {
-			out.RawString("null")
-		} else {
-			(*in.Reaction).MarshalEasyJSON(out)
+		out.String(string(in.UserID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v stopWatchingRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV213(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v stopWatchingRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV213(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *stopWatchingRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV213(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *stopWatchingRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV213(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV214(in *jlexer.Lexer, out *stdLogger) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		default:
+			in.SkipRecursive()
 		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV214(out *jwriter.Writer, in stdLogger) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v reactionRequest) MarshalJSON() ([]byte, error) {
+func (v stdLogger) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo10(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV214(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v reactionRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo10(w, v)
+func (v stdLogger) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV214(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *reactionRequest) UnmarshalJSON(data []byte) error {
+func (v *stdLogger) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo10(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV214(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *reactionRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo10(l, v)
+func (v *stdLogger) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV214(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo11(in *jlexer.Lexer, out *queryUsersResponse) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV215(in *jlexer.Lexer, out *sendFileResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1172,37 +1508,100 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo11(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "users":
+		case "file":
+			out.File = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV215(out *jwriter.Writer, in sendFileResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"file\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.File))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v sendFileResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV215(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v sendFileResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV215(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *sendFileResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV215(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *sendFileResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV215(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV216(in *jlexer.Lexer, out *sendActionRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "message_id":
+			out.MessageID = string(in.String())
+		case "form_data":
 			if in.IsNull() {
 				in.Skip()
-				out.Users = nil
 			} else {
-				in.Delim('[')
-				if out.Users == nil {
-					if !in.IsDelim(']') {
-						out.Users = make([]*User, 0, 8)
-					} else {
-						out.Users = []*User{}
-					}
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.FormData = make(map[string]string)
 				} else {
-					out.Users = (out.Users)[:0]
+					out.FormData = nil
 				}
-				for !in.IsDelim(']') {
-					var v19 *User
-					if in.IsNull() {
-						in.Skip()
-						v19 = nil
-					} else {
-						if v19 == nil {
-							v19 = new(User)
-						}
-						(*v19).UnmarshalEasyJSON(in)
-					}
-					out.Users = append(out.Users, v19)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v17 string
+					v17 = string(in.String())
+					(out.FormData)[key] = v17
 					in.WantComma()
 				}
-				in.Delim(']')
+				in.Delim('}')
 			}
+		case "user_id":
+			out.UserID = string(in.String())
+		case "id":
+			out.ID = string(in.String())
+		case "type":
+			out.Type = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1213,57 +1612,78 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo11(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo11(out *jwriter.Writer, in queryUsersResponse) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV216(out *jwriter.Writer, in sendActionRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"users\":"
+		const prefix string = ",\"message_id\":"
 		out.RawString(prefix[1:])
-		if in.Users == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
+		out.String(string(in.MessageID))
+	}
+	{
+		const prefix string = ",\"form_data\":"
+		out.RawString(prefix)
+		if in.FormData == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
 		} else {
-			out.RawByte('[')
-			for v20, v21 := range in.Users {
-				if v20 > 0 {
-					out.RawByte(',')
-				}
-				if v21 == nil {
-					out.RawString("null")
+			out.RawByte('{')
+			v18First := true
+			for v18Name, v18Value := range in.FormData {
+				if v18First {
+					v18First = false
 				} else {
-					(*v21).MarshalEasyJSON(out)
+					out.RawByte(',')
 				}
+				out.String(string(v18Name))
+				out.RawByte(':')
+				out.String(string(v18Value))
 			}
-			out.RawByte(']')
+			out.RawByte('}')
 		}
 	}
+	if in.UserID != "" {
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.String(string(in.UserID))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v queryUsersResponse) MarshalJSON() ([]byte, error) {
+func (v sendActionRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo11(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV216(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v queryUsersResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo11(w, v)
+func (v sendActionRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV216(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *queryUsersResponse) UnmarshalJSON(data []byte) error {
+func (v *sendActionRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo11(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV216(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *queryUsersResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo11(l, v)
+func (v *sendActionRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV216(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo12(in *jlexer.Lexer, out *queryUsersRequest) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV217(in *jlexer.Lexer, out *segmentTargetsRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1282,43 +1702,25 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo12(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "filter_conditions":
+		case "target_ids":
 			if in.IsNull() {
 				in.Skip()
-				out.FilterConditions = nil
-			} else {
-				if out.FilterConditions == nil {
-					out.FilterConditions = new(QueryOption)
-				}
-				(*out.FilterConditions).UnmarshalEasyJSON(in)
-			}
-		case "sort":
-			if in.IsNull() {
-				in.Skip()
-				out.Sort = nil
+				out.TargetIDs = nil
 			} else {
 				in.Delim('[')
-				if out.Sort == nil {
+				if out.TargetIDs == nil {
 					if !in.IsDelim(']') {
-						out.Sort = make([]*SortOption, 0, 8)
+						out.TargetIDs = make([]string, 0, 4)
 					} else {
-						out.Sort = []*SortOption{}
+						out.TargetIDs = []string{}
 					}
 				} else {
-					out.Sort = (out.Sort)[:0]
+					out.TargetIDs = (out.TargetIDs)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v22 *SortOption
-					if in.IsNull() {
-						in.Skip()
-						v22 = nil
-					} else {
-						if v22 == nil {
-							v22 = new(SortOption)
-						}
-						(*v22).UnmarshalEasyJSON(in)
-					}
-					out.Sort = append(out.Sort, v22)
+					var v19 string
+					v19 = string(in.String())
+					out.TargetIDs = append(out.TargetIDs, v19)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1333,35 +1735,22 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo12(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo12(out *jwriter.Writer, in queryUsersRequest) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV217(out *jwriter.Writer, in segmentTargetsRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if in.FilterConditions != nil {
-		const prefix string = ",\"filter_conditions\":"
-		first = false
+	{
+		const prefix string = ",\"target_ids\":"
 		out.RawString(prefix[1:])
-		(*in.FilterConditions).MarshalEasyJSON(out)
-	}
-	if len(in.Sort) != 0 {
-		const prefix string = ",\"sort\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
+		if in.TargetIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
 		} else {
-			out.RawString(prefix)
-		}
-		{
 			out.RawByte('[')
-			for v23, v24 := range in.Sort {
-				if v23 > 0 {
+			for v20, v21 := range in.TargetIDs {
+				if v20 > 0 {
 					out.RawByte(',')
 				}
-				if v24 == nil {
-					out.RawString("null")
-				} else {
-					(*v24).MarshalEasyJSON(out)
-				}
+				out.String(string(v21))
 			}
 			out.RawByte(']')
 		}
@@ -1370,29 +1759,29 @@ func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo12(out *jwriter.Writer,
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v queryUsersRequest) MarshalJSON() ([]byte, error) {
+func (v segmentTargetsRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo12(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV217(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v queryUsersRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo12(w, v)
+func (v segmentTargetsRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV217(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *queryUsersRequest) UnmarshalJSON(data []byte) error {
+func (v *segmentTargetsRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo12(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV217(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *queryUsersRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo12(l, v)
+func (v *segmentTargetsRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV217(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo13(in *jlexer.Lexer, out *queryResponse) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV218(in *jlexer.Lexer, out *segmentResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1411,108 +1800,117 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo13(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "channel":
+		case "segment":
 			if in.IsNull() {
 				in.Skip()
-				out.Channel = nil
+				out.Segment = nil
 			} else {
-				if out.Channel == nil {
-					out.Channel = new(Channel)
+				if out.Segment == nil {
+					out.Segment = new(Segment)
 				}
-				(*out.Channel).UnmarshalEasyJSON(in)
+				(*out.Segment).UnmarshalEasyJSON(in)
 			}
-		case "messages":
-			if in.IsNull() {
-				in.Skip()
-				out.Messages = nil
-			} else {
-				in.Delim('[')
-				if out.Messages == nil {
-					if !in.IsDelim(']') {
-						out.Messages = make([]*Message, 0, 8)
-					} else {
-						out.Messages = []*Message{}
-					}
-				} else {
-					out.Messages = (out.Messages)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v25 *Message
-					if in.IsNull() {
-						in.Skip()
-						v25 = nil
-					} else {
-						if v25 == nil {
-							v25 = new(Message)
-						}
-						(*v25).UnmarshalEasyJSON(in)
-					}
-					out.Messages = append(out.Messages, v25)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "members":
-			if in.IsNull() {
-				in.Skip()
-				out.Members = nil
-			} else {
-				in.Delim('[')
-				if out.Members == nil {
-					if !in.IsDelim(']') {
-						out.Members = make([]*ChannelMember, 0, 8)
-					} else {
-						out.Members = []*ChannelMember{}
-					}
-				} else {
-					out.Members = (out.Members)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v26 *ChannelMember
-					if in.IsNull() {
-						in.Skip()
-						v26 = nil
-					} else {
-						if v26 == nil {
-							v26 = new(ChannelMember)
-						}
-						(*v26).UnmarshalEasyJSON(in)
-					}
-					out.Members = append(out.Members, v26)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "read":
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV218(out *jwriter.Writer, in segmentResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"segment\":"
+		out.RawString(prefix[1:])
+		if in.Segment == nil {
+			out.RawString("null")
+		} else {
+			(*in.Segment).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v segmentResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV218(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v segmentResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV218(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *segmentResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV218(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *segmentResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV218(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV219(in *jlexer.Lexer, out *segmentRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "type":
+			out.Type = SegmentType(in.String())
+		case "filter":
 			if in.IsNull() {
 				in.Skip()
-				out.Read = nil
 			} else {
-				in.Delim('[')
-				if out.Read == nil {
-					if !in.IsDelim(']') {
-						out.Read = make([]*ChannelRead, 0, 8)
-					} else {
-						out.Read = []*ChannelRead{}
-					}
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Filter = make(map[string]interface{})
 				} else {
-					out.Read = (out.Read)[:0]
+					out.Filter = nil
 				}
-				for !in.IsDelim(']') {
-					var v27 *ChannelRead
-					if in.IsNull() {
-						in.Skip()
-						v27 = nil
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v22 interface{}
+					if m, ok := v22.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v22.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
 					} else {
-						if v27 == nil {
-							v27 = new(ChannelRead)
-						}
-						(*v27).UnmarshalEasyJSON(in)
+						v22 = in.Interface()
 					}
-					out.Read = append(out.Read, v27)
+					(out.Filter)[key] = v22
 					in.WantComma()
 				}
-				in.Delim(']')
+				in.Delim('}')
 			}
 		default:
 			in.SkipRecursive()
@@ -1524,112 +1922,88 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo13(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo13(out *jwriter.Writer, in queryResponse) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV219(out *jwriter.Writer, in segmentRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if in.Channel != nil {
-		const prefix string = ",\"channel\":"
+	if in.ID != "" {
+		const prefix string = ",\"id\":"
 		first = false
 		out.RawString(prefix[1:])
-		(*in.Channel).MarshalEasyJSON(out)
+		out.String(string(in.ID))
 	}
-	if len(in.Messages) != 0 {
-		const prefix string = ",\"messages\":"
+	{
+		const prefix string = ",\"name\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		{
-			out.RawByte('[')
-			for v28, v29 := range in.Messages {
-				if v28 > 0 {
-					out.RawByte(',')
-				}
-				if v29 == nil {
-					out.RawString("null")
-				} else {
-					(*v29).MarshalEasyJSON(out)
-				}
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Name))
 	}
-	if len(in.Members) != 0 {
-		const prefix string = ",\"members\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		{
-			out.RawByte('[')
-			for v30, v31 := range in.Members {
-				if v30 > 0 {
-					out.RawByte(',')
-				}
-				if v31 == nil {
-					out.RawString("null")
-				} else {
-					(*v31).MarshalEasyJSON(out)
-				}
-			}
-			out.RawByte(']')
-		}
+	if in.Description != "" {
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
 	}
-	if len(in.Read) != 0 {
-		const prefix string = ",\"read\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	if len(in.Filter) != 0 {
+		const prefix string = ",\"filter\":"
+		out.RawString(prefix)
 		{
-			out.RawByte('[')
-			for v32, v33 := range in.Read {
-				if v32 > 0 {
+			out.RawByte('{')
+			v23First := true
+			for v23Name, v23Value := range in.Filter {
+				if v23First {
+					v23First = false
+				} else {
 					out.RawByte(',')
 				}
-				if v33 == nil {
-					out.RawString("null")
+				out.String(string(v23Name))
+				out.RawByte(':')
+				if m, ok := v23Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v23Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
 				} else {
-					(*v33).MarshalEasyJSON(out)
+					out.Raw(json.Marshal(v23Value))
 				}
 			}
-			out.RawByte(']')
+			out.RawByte('}')
 		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v queryResponse) MarshalJSON() ([]byte, error) {
+func (v segmentRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo13(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV219(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v queryResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo13(w, v)
+func (v segmentRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV219(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *queryResponse) UnmarshalJSON(data []byte) error {
+func (v *segmentRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo13(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV219(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *queryResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo13(l, v)
+func (v *segmentRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV219(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo14(in *jlexer.Lexer, out *queryChannelResponseData) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV220(in *jlexer.Lexer, out *searchResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1648,188 +2022,55 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo14(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "channel":
-			if in.IsNull() {
-				in.Skip()
-				out.Channel = nil
-			} else {
-				if out.Channel == nil {
-					out.Channel = new(Channel)
-				}
-				(*out.Channel).UnmarshalEasyJSON(in)
-			}
-		case "messages":
+		case "results":
 			if in.IsNull() {
 				in.Skip()
-				out.Messages = nil
+				out.Results = nil
 			} else {
 				in.Delim('[')
-				if out.Messages == nil {
+				if out.Results == nil {
 					if !in.IsDelim(']') {
-						out.Messages = make([]*Message, 0, 8)
+						out.Results = make([]searchMessageResponse, 0, 8)
 					} else {
-						out.Messages = []*Message{}
+						out.Results = []searchMessageResponse{}
 					}
 				} else {
-					out.Messages = (out.Messages)[:0]
+					out.Results = (out.Results)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v34 *Message
-					if in.IsNull() {
-						in.Skip()
-						v34 = nil
-					} else {
-						if v34 == nil {
-							v34 = new(Message)
-						}
-						(*v34).UnmarshalEasyJSON(in)
-					}
-					out.Messages = append(out.Messages, v34)
+					var v24 searchMessageResponse
+					(v24).UnmarshalEasyJSON(in)
+					out.Results = append(out.Results, v24)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "read":
-			if in.IsNull() {
-				in.Skip()
-				out.Read = nil
-			} else {
-				in.Delim('[')
-				if out.Read == nil {
-					if !in.IsDelim(']') {
-						out.Read = make([]*ChannelRead, 0, 8)
-					} else {
-						out.Read = []*ChannelRead{}
-					}
-				} else {
-					out.Read = (out.Read)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v35 *ChannelRead
-					if in.IsNull() {
-						in.Skip()
-						v35 = nil
-					} else {
-						if v35 == nil {
-							v35 = new(ChannelRead)
-						}
-						(*v35).UnmarshalEasyJSON(in)
-					}
-					out.Read = append(out.Read, v35)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "members":
-			if in.IsNull() {
-				in.Skip()
-				out.Members = nil
-			} else {
-				in.Delim('[')
-				if out.Members == nil {
-					if !in.IsDelim(']') {
-						out.Members = make([]*ChannelMember, 0, 8)
-					} else {
-						out.Members = []*ChannelMember{}
-					}
-				} else {
-					out.Members = (out.Members)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v36 *ChannelMember
-					if in.IsNull() {
-						in.Skip()
-						v36 = nil
-					} else {
-						if v36 == nil {
-							v36 = new(ChannelMember)
-						}
-						(*v36).UnmarshalEasyJSON(in)
-					}
-					out.Members = append(out.Members, v36)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo14(out *jwriter.Writer, in queryChannelResponseData) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"channel\":"
-		out.RawString(prefix[1:])
-		if in.Channel == nil {
-			out.RawString("null")
-		} else {
-			(*in.Channel).MarshalEasyJSON(out)
-		}
-	}
-	{
-		const prefix string = ",\"messages\":"
-		out.RawString(prefix)
-		if in.Messages == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v37, v38 := range in.Messages {
-				if v37 > 0 {
-					out.RawByte(',')
-				}
-				if v38 == nil {
-					out.RawString("null")
-				} else {
-					(*v38).MarshalEasyJSON(out)
-				}
-			}
-			out.RawByte(']')
-		}
-	}
-	{
-		const prefix string = ",\"read\":"
-		out.RawString(prefix)
-		if in.Read == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v39, v40 := range in.Read {
-				if v39 > 0 {
-					out.RawByte(',')
-				}
-				if v40 == nil {
-					out.RawString("null")
-				} else {
-					(*v40).MarshalEasyJSON(out)
-				}
-			}
-			out.RawByte(']')
-		}
-	}
-	{
-		const prefix string = ",\"members\":"
-		out.RawString(prefix)
-		if in.Members == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v41, v42 := range in.Members {
-				if v41 > 0 {
-					out.RawByte(',')
-				}
-				if v42 == nil {
-					out.RawString("null")
-				} else {
-					(*v42).MarshalEasyJSON(out)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV220(out *jwriter.Writer, in searchResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"results\":"
+		out.RawString(prefix[1:])
+		if in.Results == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v25, v26 := range in.Results {
+				if v25 > 0 {
+					out.RawByte(',')
 				}
+				(v26).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -1838,29 +2079,29 @@ func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo14(out *jwriter.Writer,
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v queryChannelResponseData) MarshalJSON() ([]byte, error) {
+func (v searchResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo14(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV220(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v queryChannelResponseData) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo14(w, v)
+func (v searchResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV220(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *queryChannelResponseData) UnmarshalJSON(data []byte) error {
+func (v *searchResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo14(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV220(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *queryChannelResponseData) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo14(l, v)
+func (v *searchResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV220(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo15(in *jlexer.Lexer, out *queryChannelResponse) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV221(in *jlexer.Lexer, out *searchMessageResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1879,28 +2120,15 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo15(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "channels":
+		case "message":
 			if in.IsNull() {
 				in.Skip()
-				out.Channels = nil
+				out.Message = nil
 			} else {
-				in.Delim('[')
-				if out.Channels == nil {
-					if !in.IsDelim(']') {
-						out.Channels = make([]queryChannelResponseData, 0, 0)
-					} else {
-						out.Channels = []queryChannelResponseData{}
-					}
-				} else {
-					out.Channels = (out.Channels)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v43 queryChannelResponseData
-					(v43).UnmarshalEasyJSON(in)
-					out.Channels = append(out.Channels, v43)
-					in.WantComma()
+				if out.Message == nil {
+					out.Message = new(Message)
 				}
-				in.Delim(']')
+				(*out.Message).UnmarshalEasyJSON(in)
 			}
 		default:
 			in.SkipRecursive()
@@ -1912,53 +2140,46 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo15(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo15(out *jwriter.Writer, in queryChannelResponse) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV221(out *jwriter.Writer, in searchMessageResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"channels\":"
+		const prefix string = ",\"message\":"
 		out.RawString(prefix[1:])
-		if in.Channels == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Message == nil {
 			out.RawString("null")
 		} else {
-			out.RawByte('[')
-			for v44, v45 := range in.Channels {
-				if v44 > 0 {
-					out.RawByte(',')
-				}
-				(v45).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
+			(*in.Message).MarshalEasyJSON(out)
 		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v queryChannelResponse) MarshalJSON() ([]byte, error) {
+func (v searchMessageResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo15(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV221(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v queryChannelResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo15(w, v)
+func (v searchMessageResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV221(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *queryChannelResponse) UnmarshalJSON(data []byte) error {
+func (v *searchMessageResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo15(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV221(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *queryChannelResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo15(l, v)
+func (v *searchMessageResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV221(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo16(in *jlexer.Lexer, out *queryChannelRequest) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV222(in *jlexer.Lexer, out *rolesResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1977,49 +2198,33 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo16(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "watch":
-			out.Watch = bool(in.Bool())
-		case "state":
-			out.State = bool(in.Bool())
-		case "presence":
-			out.Presence = bool(in.Bool())
-		case "filter_conditions":
-			if in.IsNull() {
-				in.Skip()
-				out.FilterConditions = nil
-			} else {
-				if out.FilterConditions == nil {
-					out.FilterConditions = new(QueryOption)
-				}
-				(*out.FilterConditions).UnmarshalEasyJSON(in)
-			}
-		case "sort":
+		case "roles":
 			if in.IsNull() {
 				in.Skip()
-				out.Sort = nil
+				out.Roles = nil
 			} else {
 				in.Delim('[')
-				if out.Sort == nil {
+				if out.Roles == nil {
 					if !in.IsDelim(']') {
-						out.Sort = make([]*SortOption, 0, 8)
+						out.Roles = make([]*Role, 0, 8)
 					} else {
-						out.Sort = []*SortOption{}
+						out.Roles = []*Role{}
 					}
 				} else {
-					out.Sort = (out.Sort)[:0]
+					out.Roles = (out.Roles)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v46 *SortOption
+					var v27 *Role
 					if in.IsNull() {
 						in.Skip()
-						v46 = nil
+						v27 = nil
 					} else {
-						if v46 == nil {
-							v46 = new(SortOption)
+						if v27 == nil {
+							v27 = new(Role)
 						}
-						(*v46).UnmarshalEasyJSON(in)
+						(*v27).UnmarshalEasyJSON(in)
 					}
-					out.Sort = append(out.Sort, v46)
+					out.Roles = append(out.Roles, v27)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -2034,43 +2239,25 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo16(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo16(out *jwriter.Writer, in queryChannelRequest) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV222(out *jwriter.Writer, in rolesResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"watch\":"
+		const prefix string = ",\"roles\":"
 		out.RawString(prefix[1:])
-		out.Bool(bool(in.Watch))
-	}
-	{
-		const prefix string = ",\"state\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.State))
-	}
-	{
-		const prefix string = ",\"presence\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Presence))
-	}
-	if in.FilterConditions != nil {
-		const prefix string = ",\"filter_conditions\":"
-		out.RawString(prefix)
-		(*in.FilterConditions).MarshalEasyJSON(out)
-	}
-	if len(in.Sort) != 0 {
-		const prefix string = ",\"sort\":"
-		out.RawString(prefix)
-		{
+		if in.Roles == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
 			out.RawByte('[')
-			for v47, v48 := range in.Sort {
-				if v47 > 0 {
+			for v28, v29 := range in.Roles {
+				if v28 > 0 {
 					out.RawByte(',')
 				}
-				if v48 == nil {
+				if v29 == nil {
 					out.RawString("null")
 				} else {
-					(*v48).MarshalEasyJSON(out)
+					(*v29).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -2080,29 +2267,29 @@ func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo16(out *jwriter.Writer,
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v queryChannelRequest) MarshalJSON() ([]byte, error) {
+func (v rolesResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo16(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV222(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v queryChannelRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo16(w, v)
+func (v rolesResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV222(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *queryChannelRequest) UnmarshalJSON(data []byte) error {
+func (v *rolesResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo16(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV222(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *queryChannelRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo16(l, v)
+func (v *rolesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV222(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo17(in *jlexer.Lexer, out *partialUserUpdateReq) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV223(in *jlexer.Lexer, out *roleResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2121,28 +2308,15 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo17(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "users":
+		case "role":
 			if in.IsNull() {
 				in.Skip()
-				out.Users = nil
+				out.Role = nil
 			} else {
-				in.Delim('[')
-				if out.Users == nil {
-					if !in.IsDelim(']') {
-						out.Users = make([]PartialUserUpdate, 0, 1)
-					} else {
-						out.Users = []PartialUserUpdate{}
-					}
-				} else {
-					out.Users = (out.Users)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v49 PartialUserUpdate
-					(v49).UnmarshalEasyJSON(in)
-					out.Users = append(out.Users, v49)
-					in.WantComma()
+				if out.Role == nil {
+					out.Role = new(Role)
 				}
-				in.Delim(']')
+				(*out.Role).UnmarshalEasyJSON(in)
 			}
 		default:
 			in.SkipRecursive()
@@ -2154,53 +2328,46 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo17(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo17(out *jwriter.Writer, in partialUserUpdateReq) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV223(out *jwriter.Writer, in roleResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"users\":"
+		const prefix string = ",\"role\":"
 		out.RawString(prefix[1:])
-		if in.Users == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Role == nil {
 			out.RawString("null")
 		} else {
-			out.RawByte('[')
-			for v50, v51 := range in.Users {
-				if v50 > 0 {
-					out.RawByte(',')
-				}
-				(v51).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
+			(*in.Role).MarshalEasyJSON(out)
 		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v partialUserUpdateReq) MarshalJSON() ([]byte, error) {
+func (v roleResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo17(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV223(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v partialUserUpdateReq) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo17(w, v)
+func (v roleResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV223(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *partialUserUpdateReq) UnmarshalJSON(data []byte) error {
+func (v *roleResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo17(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV223(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *partialUserUpdateReq) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo17(l, v)
+func (v *roleResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV223(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo18(in *jlexer.Lexer, out *multipartForm) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV224(in *jlexer.Lexer, out *reviewFlagReportRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2209,7 +2376,6 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo18(in *jlexer.Lexer, ou
 		in.Skip()
 		return
 	}
-	out.Writer = new(multipart.Writer)
 	in.Delim('{')
 	for !in.IsDelim('}') {
 		key := in.UnsafeString()
@@ -2220,47 +2386,112 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo18(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo18(out *jwriter.Writer, in multipartForm) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v multipartForm) MarshalJSON() ([]byte, error) {
+		case "user_id":
+			out.UserID = string(in.String())
+		case "review_result":
+			out.ReviewResult = string(in.String())
+		case "review_details":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.ReviewDetails = make(map[string]interface{})
+				} else {
+					out.ReviewDetails = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v30 interface{}
+					if m, ok := v30.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v30.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v30 = in.Interface()
+					}
+					(out.ReviewDetails)[key] = v30
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV224(out *jwriter.Writer, in reviewFlagReportRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.UserID))
+	}
+	{
+		const prefix string = ",\"review_result\":"
+		out.RawString(prefix)
+		out.String(string(in.ReviewResult))
+	}
+	if len(in.ReviewDetails) != 0 {
+		const prefix string = ",\"review_details\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v31First := true
+			for v31Name, v31Value := range in.ReviewDetails {
+				if v31First {
+					v31First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v31Name))
+				out.RawByte(':')
+				if m, ok := v31Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v31Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v31Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v reviewFlagReportRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo18(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV224(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v multipartForm) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo18(w, v)
+func (v reviewFlagReportRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV224(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *multipartForm) UnmarshalJSON(data []byte) error {
+func (v *reviewFlagReportRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo18(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV224(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *multipartForm) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo18(l, v)
+func (v *reviewFlagReportRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV224(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo19(in *jlexer.Lexer, out *messageResponse) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV225(in *jlexer.Lexer, out *retryAttemptKey) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2279,16 +2510,6 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo19(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "message":
-			if in.IsNull() {
-				in.Skip()
-				out.Message = nil
-			} else {
-				if out.Message == nil {
-					out.Message = new(Message)
-				}
-				(*out.Message).UnmarshalEasyJSON(in)
-			}
 		default:
 			in.SkipRecursive()
 		}
@@ -2299,46 +2520,37 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo19(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo19(out *jwriter.Writer, in messageResponse) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV225(out *jwriter.Writer, in retryAttemptKey) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	{
-		const prefix string = ",\"message\":"
-		out.RawString(prefix[1:])
-		if in.Message == nil {
-			out.RawString("null")
-		} else {
-			(*in.Message).MarshalEasyJSON(out)
-		}
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v messageResponse) MarshalJSON() ([]byte, error) {
+func (v retryAttemptKey) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo19(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV225(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v messageResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo19(w, v)
+func (v retryAttemptKey) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV225(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *messageResponse) UnmarshalJSON(data []byte) error {
+func (v *retryAttemptKey) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo19(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV225(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *messageResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo19(l, v)
+func (v *retryAttemptKey) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV225(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo20(in *jlexer.Lexer, out *messageRequestUser) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV226(in *jlexer.Lexer, out *restoreUsersRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2357,8 +2569,29 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo20(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = string(in.String())
+		case "user_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.UserIDs = nil
+			} else {
+				in.Delim('[')
+				if out.UserIDs == nil {
+					if !in.IsDelim(']') {
+						out.UserIDs = make([]string, 0, 4)
+					} else {
+						out.UserIDs = []string{}
+					}
+				} else {
+					out.UserIDs = (out.UserIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v32 string
+					v32 = string(in.String())
+					out.UserIDs = append(out.UserIDs, v32)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -2369,42 +2602,53 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo20(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo20(out *jwriter.Writer, in messageRequestUser) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV226(out *jwriter.Writer, in restoreUsersRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"user_ids\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.ID))
+		if in.UserIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v33, v34 := range in.UserIDs {
+				if v33 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v34))
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v messageRequestUser) MarshalJSON() ([]byte, error) {
+func (v restoreUsersRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo20(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV226(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v messageRequestUser) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo20(w, v)
+func (v restoreUsersRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV226(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *messageRequestUser) UnmarshalJSON(data []byte) error {
+func (v *restoreUsersRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo20(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV226(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *messageRequestUser) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo20(l, v)
+func (v *restoreUsersRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV226(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo21(in *jlexer.Lexer, out *messageRequestMessage) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV227(in *jlexer.Lexer, out *restoreChannelsRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2413,9 +2657,6 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo21(in *jlexer.Lexer, ou
 		in.Skip()
 		return
 	}
-	for key := range out.ExtraData {
-		delete(out.ExtraData, key)
-	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
 		key := in.UnsafeString()
@@ -2426,73 +2667,31 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo21(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "text":
-			out.Text = string(in.String())
-		case "attachments":
-			if in.IsNull() {
-				in.Skip()
-				out.Attachments = nil
-			} else {
-				in.Delim('[')
-				if out.Attachments == nil {
-					if !in.IsDelim(']') {
-						out.Attachments = make([]*Attachment, 0, 8)
-					} else {
-						out.Attachments = []*Attachment{}
-					}
-				} else {
-					out.Attachments = (out.Attachments)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v52 *Attachment
-					if in.IsNull() {
-						in.Skip()
-						v52 = nil
-					} else {
-						if v52 == nil {
-							v52 = new(Attachment)
-						}
-						(*v52).UnmarshalEasyJSON(in)
-					}
-					out.Attachments = append(out.Attachments, v52)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "user":
-			(out.User).UnmarshalEasyJSON(in)
-		case "mentioned_users":
+		case "cids":
 			if in.IsNull() {
 				in.Skip()
-				out.MentionedUsers = nil
+				out.CIDs = nil
 			} else {
 				in.Delim('[')
-				if out.MentionedUsers == nil {
+				if out.CIDs == nil {
 					if !in.IsDelim(']') {
-						out.MentionedUsers = make([]string, 0, 4)
+						out.CIDs = make([]string, 0, 4)
 					} else {
-						out.MentionedUsers = []string{}
+						out.CIDs = []string{}
 					}
 				} else {
-					out.MentionedUsers = (out.MentionedUsers)[:0]
+					out.CIDs = (out.CIDs)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v53 string
-					v53 = string(in.String())
-					out.MentionedUsers = append(out.MentionedUsers, v53)
+					var v35 string
+					v35 = string(in.String())
+					out.CIDs = append(out.CIDs, v35)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "parent_id":
-			out.ParentID = string(in.String())
-		case "show_in_channel":
-			out.ShowInChannel = bool(in.Bool())
 		default:
-			if out.ExtraData == nil {
-				out.ExtraData = make(map[string]interface{})
-			}
-			out.ExtraData[key] = in.Interface()
+			in.SkipRecursive()
 		}
 		in.WantComma()
 	}
@@ -2501,109 +2700,53 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo21(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo21(out *jwriter.Writer, in messageRequestMessage) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV227(out *jwriter.Writer, in restoreChannelsRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"text\":"
+		const prefix string = ",\"cids\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Text))
-	}
-	{
-		const prefix string = ",\"attachments\":"
-		out.RawString(prefix)
-		if in.Attachments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v54, v55 := range in.Attachments {
-				if v54 > 0 {
-					out.RawByte(',')
-				}
-				if v55 == nil {
-					out.RawString("null")
-				} else {
-					(*v55).MarshalEasyJSON(out)
-				}
-			}
-			out.RawByte(']')
-		}
-	}
-	{
-		const prefix string = ",\"user\":"
-		out.RawString(prefix)
-		(in.User).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"mentioned_users\":"
-		out.RawString(prefix)
-		if in.MentionedUsers == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.CIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v56, v57 := range in.MentionedUsers {
-				if v56 > 0 {
+			for v36, v37 := range in.CIDs {
+				if v36 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v57))
+				out.String(string(v37))
 			}
 			out.RawByte(']')
 		}
 	}
-	{
-		const prefix string = ",\"parent_id\":"
-		out.RawString(prefix)
-		out.String(string(in.ParentID))
-	}
-	{
-		const prefix string = ",\"show_in_channel\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.ShowInChannel))
-	}
-	for k, v := range in.ExtraData {
-		switch k {
-		case "text", "attachments", "user", "mentioned_users", "parent_id", "show_in_channel":
-			continue // don't allow field overwrites
-		}
-		out.RawByte(',')
-		out.String(string(k))
-		out.RawByte(':')
-		if m, ok := v.(easyjson.Marshaler); ok {
-			m.MarshalEasyJSON(out)
-		} else if m, ok := v.(json.Marshaler); ok {
-			out.Raw(m.MarshalJSON())
-		} else {
-			out.Raw(json.Marshal(v))
-		}
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v messageRequestMessage) MarshalJSON() ([]byte, error) {
+func (v restoreChannelsRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo21(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV227(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v messageRequestMessage) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo21(w, v)
+func (v restoreChannelsRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV227(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *messageRequestMessage) UnmarshalJSON(data []byte) error {
+func (v *restoreChannelsRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo21(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV227(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *messageRequestMessage) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo21(l, v)
+func (v *restoreChannelsRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV227(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo22(in *jlexer.Lexer, out *messageRequest) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV228(in *jlexer.Lexer, out *reminderResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2622,8 +2765,16 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo22(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "message":
-			(out.Message).UnmarshalEasyJSON(in)
+		case "reminder":
+			if in.IsNull() {
+				in.Skip()
+				out.Reminder = nil
+			} else {
+				if out.Reminder == nil {
+					out.Reminder = new(Reminder)
+				}
+				(*out.Reminder).UnmarshalEasyJSON(in)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -2634,42 +2785,46 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo22(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo22(out *jwriter.Writer, in messageRequest) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV228(out *jwriter.Writer, in reminderResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"message\":"
+		const prefix string = ",\"reminder\":"
 		out.RawString(prefix[1:])
-		(in.Message).MarshalEasyJSON(out)
+		if in.Reminder == nil {
+			out.RawString("null")
+		} else {
+			(*in.Reminder).MarshalEasyJSON(out)
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v messageRequest) MarshalJSON() ([]byte, error) {
+func (v reminderResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo22(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV228(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v messageRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo22(w, v)
+func (v reminderResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV228(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *messageRequest) UnmarshalJSON(data []byte) error {
+func (v *reminderResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo22(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV228(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *messageRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo22(l, v)
+func (v *reminderResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV228(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo23(in *jlexer.Lexer, out *eventRequest) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV229(in *jlexer.Lexer, out *reminderRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2688,15 +2843,19 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo23(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "event":
+		case "user_id":
+			out.UserID = string(in.String())
+		case "remind_at":
 			if in.IsNull() {
 				in.Skip()
-				out.Event = nil
+				out.RemindAt = nil
 			} else {
-				if out.Event == nil {
-					out.Event = new(Event)
+				if out.RemindAt == nil {
+					out.RemindAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.RemindAt).UnmarshalJSON(data))
 				}
-				(*out.Event).UnmarshalEasyJSON(in)
 			}
 		default:
 			in.SkipRecursive()
@@ -2708,46 +2867,51 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo23(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo23(out *jwriter.Writer, in eventRequest) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV229(out *jwriter.Writer, in reminderRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"event\":"
+		const prefix string = ",\"user_id\":"
 		out.RawString(prefix[1:])
-		if in.Event == nil {
+		out.String(string(in.UserID))
+	}
+	{
+		const prefix string = ",\"remind_at\":"
+		out.RawString(prefix)
+		if in.RemindAt == nil {
 			out.RawString("null")
 		} else {
-			(*in.Event).MarshalEasyJSON(out)
+			out.Raw((*in.RemindAt).MarshalJSON())
 		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v eventRequest) MarshalJSON() ([]byte, error) {
+func (v reminderRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo23(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV229(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v eventRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo23(w, v)
+func (v reminderRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV229(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *eventRequest) UnmarshalJSON(data []byte) error {
+func (v *reminderRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo23(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV229(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *eventRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo23(l, v)
+func (v *reminderRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV229(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo24(in *jlexer.Lexer, out *devicesResponse) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV230(in *jlexer.Lexer, out *reactivateUsersRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2766,37 +2930,33 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo24(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "devices":
+		case "user_ids":
 			if in.IsNull() {
 				in.Skip()
-				out.Devices = nil
+				out.UserIDs = nil
 			} else {
 				in.Delim('[')
-				if out.Devices == nil {
+				if out.UserIDs == nil {
 					if !in.IsDelim(']') {
-						out.Devices = make([]*Device, 0, 8)
+						out.UserIDs = make([]string, 0, 4)
 					} else {
-						out.Devices = []*Device{}
+						out.UserIDs = []string{}
 					}
 				} else {
-					out.Devices = (out.Devices)[:0]
+					out.UserIDs = (out.UserIDs)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v58 *Device
-					if in.IsNull() {
-						in.Skip()
-						v58 = nil
-					} else {
-						if v58 == nil {
-							v58 = new(Device)
-						}
-						(*v58).UnmarshalEasyJSON(in)
-					}
-					out.Devices = append(out.Devices, v58)
+					var v38 string
+					v38 = string(in.String())
+					out.UserIDs = append(out.UserIDs, v38)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
+		case "restore_messages":
+			out.RestoreMessages = bool(in.Bool())
+		case "created_by_id":
+			out.CreatedByID = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -2807,57 +2967,63 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo24(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo24(out *jwriter.Writer, in devicesResponse) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV230(out *jwriter.Writer, in reactivateUsersRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"devices\":"
+		const prefix string = ",\"user_ids\":"
 		out.RawString(prefix[1:])
-		if in.Devices == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.UserIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v59, v60 := range in.Devices {
-				if v59 > 0 {
+			for v39, v40 := range in.UserIDs {
+				if v39 > 0 {
 					out.RawByte(',')
 				}
-				if v60 == nil {
-					out.RawString("null")
-				} else {
-					(*v60).MarshalEasyJSON(out)
-				}
+				out.String(string(v40))
 			}
 			out.RawByte(']')
 		}
 	}
+	if in.RestoreMessages {
+		const prefix string = ",\"restore_messages\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.RestoreMessages))
+	}
+	if in.CreatedByID != "" {
+		const prefix string = ",\"created_by_id\":"
+		out.RawString(prefix)
+		out.String(string(in.CreatedByID))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v devicesResponse) MarshalJSON() ([]byte, error) {
+func (v reactivateUsersRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo24(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV230(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v devicesResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo24(w, v)
+func (v reactivateUsersRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV230(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *devicesResponse) UnmarshalJSON(data []byte) error {
+func (v *reactivateUsersRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo24(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV230(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *devicesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo24(l, v)
+func (v *reactivateUsersRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV230(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo25(in *jlexer.Lexer, out *channelTypeResponse) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV231(in *jlexer.Lexer, out *reactionsResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2876,33 +3042,36 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo25(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "channel_types":
+		case "reactions":
 			if in.IsNull() {
 				in.Skip()
+				out.Reactions = nil
 			} else {
-				in.Delim('{')
-				if !in.IsDelim('}') {
-					out.ChannelTypes = make(map[string]*ChannelType)
+				in.Delim('[')
+				if out.Reactions == nil {
+					if !in.IsDelim(']') {
+						out.Reactions = make([]*Reaction, 0, 8)
+					} else {
+						out.Reactions = []*Reaction{}
+					}
 				} else {
-					out.ChannelTypes = nil
+					out.Reactions = (out.Reactions)[:0]
 				}
-				for !in.IsDelim('}') {
-					key := string(in.String())
-					in.WantColon()
-					var v61 *ChannelType
+				for !in.IsDelim(']') {
+					var v41 *Reaction
 					if in.IsNull() {
 						in.Skip()
-						v61 = nil
+						v41 = nil
 					} else {
-						if v61 == nil {
-							v61 = new(ChannelType)
+						if v41 == nil {
+							v41 = new(Reaction)
 						}
-						(*v61).UnmarshalEasyJSON(in)
+						(*v41).UnmarshalEasyJSON(in)
 					}
-					(out.ChannelTypes)[key] = v61
+					out.Reactions = append(out.Reactions, v41)
 					in.WantComma()
 				}
-				in.Delim('}')
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -2914,62 +3083,57 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo25(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo25(out *jwriter.Writer, in channelTypeResponse) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV231(out *jwriter.Writer, in reactionsResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"channel_types\":"
+		const prefix string = ",\"reactions\":"
 		out.RawString(prefix[1:])
-		if in.ChannelTypes == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
-			out.RawString(`null`)
+		if in.Reactions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
 		} else {
-			out.RawByte('{')
-			v62First := true
-			for v62Name, v62Value := range in.ChannelTypes {
-				if v62First {
-					v62First = false
-				} else {
+			out.RawByte('[')
+			for v42, v43 := range in.Reactions {
+				if v42 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v62Name))
-				out.RawByte(':')
-				if v62Value == nil {
+				if v43 == nil {
 					out.RawString("null")
 				} else {
-					(*v62Value).MarshalEasyJSON(out)
+					(*v43).MarshalEasyJSON(out)
 				}
 			}
-			out.RawByte('}')
+			out.RawByte(']')
 		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v channelTypeResponse) MarshalJSON() ([]byte, error) {
+func (v reactionsResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo25(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV231(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v channelTypeResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo25(w, v)
+func (v reactionsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV231(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *channelTypeResponse) UnmarshalJSON(data []byte) error {
+func (v *reactionsResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo25(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV231(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *channelTypeResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo25(l, v)
+func (v *reactionsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV231(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo26(in *jlexer.Lexer, out *channelTypeRequest) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV232(in *jlexer.Lexer, out *reactionResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2978,7 +3142,6 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo26(in *jlexer.Lexer, ou
 		in.Skip()
 		return
 	}
-	out.ChannelType = new(ChannelType)
 	in.Delim('{')
 	for !in.IsDelim('}') {
 		key := in.UnsafeString()
@@ -2989,84 +3152,26 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo26(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "commands":
+		case "message":
 			if in.IsNull() {
 				in.Skip()
-				out.Commands = nil
+				out.Message = nil
 			} else {
-				in.Delim('[')
-				if out.Commands == nil {
-					if !in.IsDelim(']') {
-						out.Commands = make([]string, 0, 4)
-					} else {
-						out.Commands = []string{}
-					}
-				} else {
-					out.Commands = (out.Commands)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v63 string
-					v63 = string(in.String())
-					out.Commands = append(out.Commands, v63)
-					in.WantComma()
+				if out.Message == nil {
+					out.Message = new(Message)
 				}
-				in.Delim(']')
+				(*out.Message).UnmarshalEasyJSON(in)
 			}
-		case "permissions":
+		case "reaction":
 			if in.IsNull() {
 				in.Skip()
-				out.Permissions = nil
+				out.Reaction = nil
 			} else {
-				in.Delim('[')
-				if out.Permissions == nil {
-					if !in.IsDelim(']') {
-						out.Permissions = make([]*Permission, 0, 8)
-					} else {
-						out.Permissions = []*Permission{}
-					}
-				} else {
-					out.Permissions = (out.Permissions)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v64 *Permission
-					if in.IsNull() {
-						in.Skip()
-						v64 = nil
-					} else {
-						if v64 == nil {
-							v64 = new(Permission)
-						}
-						(*v64).UnmarshalEasyJSON(in)
-					}
-					out.Permissions = append(out.Permissions, v64)
-					in.WantComma()
+				if out.Reaction == nil {
+					out.Reaction = new(Reaction)
 				}
-				in.Delim(']')
+				(*out.Reaction).UnmarshalEasyJSON(in)
 			}
-		case "name":
-			out.Name = string(in.String())
-		case "typing_events":
-			out.TypingEvents = bool(in.Bool())
-		case "read_events":
-			out.ReadEvents = bool(in.Bool())
-		case "connect_events":
-			out.ConnectEvents = bool(in.Bool())
-		case "search":
-			out.Search = bool(in.Bool())
-		case "reactions":
-			out.Reactions = bool(in.Bool())
-		case "replies":
-			out.Replies = bool(in.Bool())
-		case "mutes":
-			out.Mutes = bool(in.Bool())
-		case "message_retention":
-			out.MessageRetention = string(in.String())
-		case "max_message_length":
-			out.MaxMessageLength = int(in.Int())
-		case "automod":
-			out.Automod = modType(in.String())
-		case "automod_behavior":
-			out.ModBehavior = modBehaviour(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -3077,133 +3182,55 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo26(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo26(out *jwriter.Writer, in channelTypeRequest) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV232(out *jwriter.Writer, in reactionResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"commands\":"
+		const prefix string = ",\"message\":"
 		out.RawString(prefix[1:])
-		if in.Commands == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Message == nil {
 			out.RawString("null")
 		} else {
-			out.RawByte('[')
-			for v65, v66 := range in.Commands {
-				if v65 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v66))
-			}
-			out.RawByte(']')
+			(*in.Message).MarshalEasyJSON(out)
 		}
 	}
 	{
-		const prefix string = ",\"permissions\":"
+		const prefix string = ",\"reaction\":"
 		out.RawString(prefix)
-		if in.Permissions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Reaction == nil {
 			out.RawString("null")
 		} else {
-			out.RawByte('[')
-			for v67, v68 := range in.Permissions {
-				if v67 > 0 {
-					out.RawByte(',')
-				}
-				if v68 == nil {
-					out.RawString("null")
-				} else {
-					(*v68).MarshalEasyJSON(out)
-				}
-			}
-			out.RawByte(']')
+			(*in.Reaction).MarshalEasyJSON(out)
 		}
 	}
-	{
-		const prefix string = ",\"name\":"
-		out.RawString(prefix)
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"typing_events\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.TypingEvents))
-	}
-	{
-		const prefix string = ",\"read_events\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.ReadEvents))
-	}
-	{
-		const prefix string = ",\"connect_events\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.ConnectEvents))
-	}
-	{
-		const prefix string = ",\"search\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Search))
-	}
-	{
-		const prefix string = ",\"reactions\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Reactions))
-	}
-	{
-		const prefix string = ",\"replies\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Replies))
-	}
-	{
-		const prefix string = ",\"mutes\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Mutes))
-	}
-	{
-		const prefix string = ",\"message_retention\":"
-		out.RawString(prefix)
-		out.String(string(in.MessageRetention))
-	}
-	{
-		const prefix string = ",\"max_message_length\":"
-		out.RawString(prefix)
-		out.Int(int(in.MaxMessageLength))
-	}
-	{
-		const prefix string = ",\"automod\":"
-		out.RawString(prefix)
-		out.String(string(in.Automod))
-	}
-	{
-		const prefix string = ",\"automod_behavior\":"
-		out.RawString(prefix)
-		out.String(string(in.ModBehavior))
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v channelTypeRequest) MarshalJSON() ([]byte, error) {
+func (v reactionResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo26(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV232(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v channelTypeRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo26(w, v)
+func (v reactionResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV232(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *channelTypeRequest) UnmarshalJSON(data []byte) error {
+func (v *reactionResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo26(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV232(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *channelTypeRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo26(l, v)
+func (v *reactionResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV232(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo27(in *jlexer.Lexer, out *appResponse) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV233(in *jlexer.Lexer, out *reactionRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3222,16 +3249,20 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo27(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "app":
+		case "reaction":
 			if in.IsNull() {
 				in.Skip()
-				out.App = nil
+				out.Reaction = nil
 			} else {
-				if out.App == nil {
-					out.App = new(AppConfig)
+				if out.Reaction == nil {
+					out.Reaction = new(Reaction)
 				}
-				(*out.App).UnmarshalEasyJSON(in)
+				(*out.Reaction).UnmarshalEasyJSON(in)
 			}
+		case "enforce_unique":
+			out.EnforceUnique = bool(in.Bool())
+		case "skip_push":
+			out.SkipPush = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -3242,46 +3273,56 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo27(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo27(out *jwriter.Writer, in appResponse) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV233(out *jwriter.Writer, in reactionRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"app\":"
+		const prefix string = ",\"reaction\":"
 		out.RawString(prefix[1:])
-		if in.App == nil {
+		if in.Reaction == nil {
 			out.RawString("null")
 		} else {
-			(*in.App).MarshalEasyJSON(out)
+			(*in.Reaction).MarshalEasyJSON(out)
 		}
 	}
+	if in.EnforceUnique {
+		const prefix string = ",\"enforce_unique\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.EnforceUnique))
+	}
+	if in.SkipPush {
+		const prefix string = ",\"skip_push\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.SkipPush))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v appResponse) MarshalJSON() ([]byte, error) {
+func (v reactionRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo27(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV233(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v appResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo27(w, v)
+func (v reactionRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV233(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *appResponse) UnmarshalJSON(data []byte) error {
+func (v *reactionRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo27(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV233(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *appResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo27(l, v)
+func (v *reactionRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV233(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo28(in *jlexer.Lexer, out *User) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV234(in *jlexer.Lexer, out *queryUsersResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3290,9 +3331,6 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo28(in *jlexer.Lexer, ou
 		in.Skip()
 		return
 	}
-	for key := range out.ExtraData {
-		delete(out.ExtraData, key)
-	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
 		key := in.UnsafeString()
@@ -3303,90 +3341,39 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo28(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = string(in.String())
-		case "name":
-			out.Name = string(in.String())
-		case "image":
-			out.Image = string(in.String())
-		case "role":
-			out.Role = string(in.String())
-		case "online":
-			out.Online = bool(in.Bool())
-		case "invisible":
-			out.Invisible = bool(in.Bool())
-		case "created_at":
-			if in.IsNull() {
-				in.Skip()
-				out.CreatedAt = nil
-			} else {
-				if out.CreatedAt == nil {
-					out.CreatedAt = new(time.Time)
-				}
-				if data := in.Raw(); in.Ok() {
-					in.AddError((*out.CreatedAt).UnmarshalJSON(data))
-				}
-			}
-		case "updated_at":
-			if in.IsNull() {
-				in.Skip()
-				out.UpdatedAt = nil
-			} else {
-				if out.UpdatedAt == nil {
-					out.UpdatedAt = new(time.Time)
-				}
-				if data := in.Raw(); in.Ok() {
-					in.AddError((*out.UpdatedAt).UnmarshalJSON(data))
-				}
-			}
-		case "last_active":
-			if in.IsNull() {
-				in.Skip()
-				out.LastActive = nil
-			} else {
-				if out.LastActive == nil {
-					out.LastActive = new(time.Time)
-				}
-				if data := in.Raw(); in.Ok() {
-					in.AddError((*out.LastActive).UnmarshalJSON(data))
-				}
-			}
-		case "mutes":
+		case "users":
 			if in.IsNull() {
 				in.Skip()
-				out.Mutes = nil
+				out.Users = nil
 			} else {
 				in.Delim('[')
-				if out.Mutes == nil {
+				if out.Users == nil {
 					if !in.IsDelim(']') {
-						out.Mutes = make([]*Mute, 0, 8)
+						out.Users = make([]*User, 0, 8)
 					} else {
-						out.Mutes = []*Mute{}
+						out.Users = []*User{}
 					}
 				} else {
-					out.Mutes = (out.Mutes)[:0]
+					out.Users = (out.Users)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v69 *Mute
+					var v44 *User
 					if in.IsNull() {
 						in.Skip()
-						v69 = nil
+						v44 = nil
 					} else {
-						if v69 == nil {
-							v69 = new(Mute)
+						if v44 == nil {
+							v44 = new(User)
 						}
-						(*v69).UnmarshalEasyJSON(in)
+						(*v44).UnmarshalEasyJSON(in)
 					}
-					out.Mutes = append(out.Mutes, v69)
+					out.Users = append(out.Users, v44)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
 		default:
-			if out.ExtraData == nil {
-				out.ExtraData = make(map[string]interface{})
-			}
-			out.ExtraData[key] = in.Interface()
+			in.SkipRecursive()
 		}
 		in.WantComma()
 	}
@@ -3395,116 +3382,57 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo28(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo28(out *jwriter.Writer, in User) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV234(out *jwriter.Writer, in queryUsersResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"users\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.ID))
-	}
-	if in.Name != "" {
-		const prefix string = ",\"name\":"
-		out.RawString(prefix)
-		out.String(string(in.Name))
-	}
-	if in.Image != "" {
-		const prefix string = ",\"image\":"
-		out.RawString(prefix)
-		out.String(string(in.Image))
-	}
-	if in.Role != "" {
-		const prefix string = ",\"role\":"
-		out.RawString(prefix)
-		out.String(string(in.Role))
-	}
-	if in.Online {
-		const prefix string = ",\"online\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Online))
-	}
-	if in.Invisible {
-		const prefix string = ",\"invisible\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Invisible))
-	}
-	if in.CreatedAt != nil {
-		const prefix string = ",\"created_at\":"
-		out.RawString(prefix)
-		out.Raw((*in.CreatedAt).MarshalJSON())
-	}
-	if in.UpdatedAt != nil {
-		const prefix string = ",\"updated_at\":"
-		out.RawString(prefix)
-		out.Raw((*in.UpdatedAt).MarshalJSON())
-	}
-	if in.LastActive != nil {
-		const prefix string = ",\"last_active\":"
-		out.RawString(prefix)
-		out.Raw((*in.LastActive).MarshalJSON())
-	}
-	if len(in.Mutes) != 0 {
-		const prefix string = ",\"mutes\":"
-		out.RawString(prefix)
-		{
+		if in.Users == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
 			out.RawByte('[')
-			for v70, v71 := range in.Mutes {
-				if v70 > 0 {
+			for v45, v46 := range in.Users {
+				if v45 > 0 {
 					out.RawByte(',')
 				}
-				if v71 == nil {
+				if v46 == nil {
 					out.RawString("null")
 				} else {
-					(*v71).MarshalEasyJSON(out)
+					(*v46).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
 		}
 	}
-	for k, v := range in.ExtraData {
-		switch k {
-		case "id", "name", "image", "role", "online", "invisible", "created_at", "updated_at", "last_active", "mutes":
-			continue // don't allow field overwrites
-		}
-		out.RawByte(',')
-		out.String(string(k))
-		out.RawByte(':')
-		if m, ok := v.(easyjson.Marshaler); ok {
-			m.MarshalEasyJSON(out)
-		} else if m, ok := v.(json.Marshaler); ok {
-			out.Raw(m.MarshalJSON())
-		} else {
-			out.Raw(json.Marshal(v))
-		}
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v User) MarshalJSON() ([]byte, error) {
+func (v queryUsersResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo28(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV234(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v User) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo28(w, v)
+func (v queryUsersResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV234(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *User) UnmarshalJSON(data []byte) error {
+func (v *queryUsersResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo28(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV234(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *User) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo28(l, v)
+func (v *queryUsersResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV234(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo29(in *jlexer.Lexer, out *SortOption) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV235(in *jlexer.Lexer, out *queryUsersRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3523,10 +3451,49 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo29(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "field":
-			out.Field = string(in.String())
-		case "direction":
-			out.Direction = int(in.Int())
+		case "filter_conditions":
+			if in.IsNull() {
+				in.Skip()
+				out.FilterConditions = nil
+			} else {
+				if out.FilterConditions == nil {
+					out.FilterConditions = new(QueryOption)
+				}
+				(*out.FilterConditions).UnmarshalEasyJSON(in)
+			}
+		case "sort":
+			if in.IsNull() {
+				in.Skip()
+				out.Sort = nil
+			} else {
+				in.Delim('[')
+				if out.Sort == nil {
+					if !in.IsDelim(']') {
+						out.Sort = make([]*SortOption, 0, 8)
+					} else {
+						out.Sort = []*SortOption{}
+					}
+				} else {
+					out.Sort = (out.Sort)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v47 *SortOption
+					if in.IsNull() {
+						in.Skip()
+						v47 = nil
+					} else {
+						if v47 == nil {
+							v47 = new(SortOption)
+						}
+						(*v47).UnmarshalEasyJSON(in)
+					}
+					out.Sort = append(out.Sort, v47)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "presence":
+			out.Presence = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -3537,47 +3504,76 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo29(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo29(out *jwriter.Writer, in SortOption) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV235(out *jwriter.Writer, in queryUsersRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	{
-		const prefix string = ",\"field\":"
+	if in.FilterConditions != nil {
+		const prefix string = ",\"filter_conditions\":"
+		first = false
 		out.RawString(prefix[1:])
-		out.String(string(in.Field))
+		(*in.FilterConditions).MarshalEasyJSON(out)
 	}
-	{
-		const prefix string = ",\"direction\":"
-		out.RawString(prefix)
-		out.Int(int(in.Direction))
+	if len(in.Sort) != 0 {
+		const prefix string = ",\"sort\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v48, v49 := range in.Sort {
+				if v48 > 0 {
+					out.RawByte(',')
+				}
+				if v49 == nil {
+					out.RawString("null")
+				} else {
+					(*v49).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Presence {
+		const prefix string = ",\"presence\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.Presence))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SortOption) MarshalJSON() ([]byte, error) {
+func (v queryUsersRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo29(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV235(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SortOption) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo29(w, v)
+func (v queryUsersRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV235(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SortOption) UnmarshalJSON(data []byte) error {
+func (v *queryUsersRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo29(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV235(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SortOption) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo29(l, v)
+func (v *queryUsersRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV235(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo30(in *jlexer.Lexer, out *SendFileRequest) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV236(in *jlexer.Lexer, out *queryThreadsRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3596,135 +3592,77 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo30(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "FileName":
-			out.FileName = string(in.String())
-		case "User":
+		case "user_id":
+			out.UserID = string(in.String())
+		case "filter":
 			if in.IsNull() {
 				in.Skip()
-				out.User = nil
 			} else {
-				if out.User == nil {
-					out.User = new(User)
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Filter = make(map[string]interface{})
+				} else {
+					out.Filter = nil
 				}
-				(*out.User).UnmarshalEasyJSON(in)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v50 interface{}
+					if m, ok := v50.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v50.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v50 = in.Interface()
+					}
+					(out.Filter)[key] = v50
+					in.WantComma()
+				}
+				in.Delim('}')
 			}
-		case "ContentType":
-			out.ContentType = string(in.String())
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo30(out *jwriter.Writer, in SendFileRequest) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"FileName\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.FileName))
-	}
-	{
-		const prefix string = ",\"User\":"
-		out.RawString(prefix)
-		if in.User == nil {
-			out.RawString("null")
-		} else {
-			(*in.User).MarshalEasyJSON(out)
-		}
-	}
-	{
-		const prefix string = ",\"ContentType\":"
-		out.RawString(prefix)
-		out.String(string(in.ContentType))
-	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v SendFileRequest) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo30(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SendFileRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo30(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *SendFileRequest) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo30(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SendFileRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo30(l, v)
-}
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo31(in *jlexer.Lexer, out *SearchRequest) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeString()
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "query":
-			out.Query = string(in.String())
-		case "filter_conditions":
+		case "sort":
 			if in.IsNull() {
 				in.Skip()
+				out.Sort = nil
 			} else {
-				in.Delim('{')
-				if !in.IsDelim('}') {
-					out.Filters = make(map[string]interface{})
+				in.Delim('[')
+				if out.Sort == nil {
+					if !in.IsDelim(']') {
+						out.Sort = make([]*SortOption, 0, 8)
+					} else {
+						out.Sort = []*SortOption{}
+					}
 				} else {
-					out.Filters = nil
+					out.Sort = (out.Sort)[:0]
 				}
-				for !in.IsDelim('}') {
-					key := string(in.String())
-					in.WantColon()
-					var v72 interface{}
-					if m, ok := v72.(easyjson.Unmarshaler); ok {
-						m.UnmarshalEasyJSON(in)
-					} else if m, ok := v72.(json.Unmarshaler); ok {
-						_ = m.UnmarshalJSON(in.Raw())
+				for !in.IsDelim(']') {
+					var v51 *SortOption
+					if in.IsNull() {
+						in.Skip()
+						v51 = nil
 					} else {
-						v72 = in.Interface()
+						if v51 == nil {
+							v51 = new(SortOption)
+						}
+						(*v51).UnmarshalEasyJSON(in)
 					}
-					(out.Filters)[key] = v72
+					out.Sort = append(out.Sort, v51)
 					in.WantComma()
 				}
-				in.Delim('}')
+				in.Delim(']')
 			}
 		case "limit":
 			out.Limit = int(in.Int())
-		case "offset":
-			out.Offset = int(in.Int())
+		case "next":
+			out.Next = string(in.String())
+		case "prev":
+			out.Prev = string(in.String())
+		case "reply_limit":
+			out.ReplyLimit = int(in.Int())
+		case "participant_limit":
+			out.ParticipantLimit = int(in.Int())
+		case "member_limit":
+			out.MemberLimit = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -3735,181 +3673,115 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo31(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo31(out *jwriter.Writer, in SearchRequest) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV236(out *jwriter.Writer, in queryThreadsRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"query\":"
+		const prefix string = ",\"user_id\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Query))
+		out.String(string(in.UserID))
 	}
-	{
-		const prefix string = ",\"filter_conditions\":"
+	if len(in.Filter) != 0 {
+		const prefix string = ",\"filter\":"
 		out.RawString(prefix)
-		if in.Filters == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
-			out.RawString(`null`)
-		} else {
+		{
 			out.RawByte('{')
-			v73First := true
-			for v73Name, v73Value := range in.Filters {
-				if v73First {
-					v73First = false
+			v52First := true
+			for v52Name, v52Value := range in.Filter {
+				if v52First {
+					v52First = false
 				} else {
 					out.RawByte(',')
 				}
-				out.String(string(v73Name))
+				out.String(string(v52Name))
 				out.RawByte(':')
-				if m, ok := v73Value.(easyjson.Marshaler); ok {
+				if m, ok := v52Value.(easyjson.Marshaler); ok {
 					m.MarshalEasyJSON(out)
-				} else if m, ok := v73Value.(json.Marshaler); ok {
+				} else if m, ok := v52Value.(json.Marshaler); ok {
 					out.Raw(m.MarshalJSON())
 				} else {
-					out.Raw(json.Marshal(v73Value))
+					out.Raw(json.Marshal(v52Value))
 				}
 			}
 			out.RawByte('}')
 		}
 	}
+	if len(in.Sort) != 0 {
+		const prefix string = ",\"sort\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v53, v54 := range in.Sort {
+				if v53 > 0 {
+					out.RawByte(',')
+				}
+				if v54 == nil {
+					out.RawString("null")
+				} else {
+					(*v54).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
 	if in.Limit != 0 {
 		const prefix string = ",\"limit\":"
 		out.RawString(prefix)
 		out.Int(int(in.Limit))
 	}
-	if in.Offset != 0 {
-		const prefix string = ",\"offset\":"
+	if in.Next != "" {
+		const prefix string = ",\"next\":"
 		out.RawString(prefix)
-		out.Int(int(in.Offset))
-	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v SearchRequest) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo31(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SearchRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo31(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *SearchRequest) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo31(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SearchRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo31(l, v)
-}
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo32(in *jlexer.Lexer, out *Reaction) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	for key := range out.ExtraData {
-		delete(out.ExtraData, key)
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeString()
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "message_id":
-			out.MessageID = string(in.String())
-		case "user_id":
-			out.UserID = string(in.String())
-		case "type":
-			out.Type = string(in.String())
-		default:
-			if out.ExtraData == nil {
-				out.ExtraData = make(map[string]interface{})
-			}
-			out.ExtraData[key] = in.Interface()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
+		out.String(string(in.Next))
 	}
-}
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo32(out *jwriter.Writer, in Reaction) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"message_id\":"
-		out.RawString(prefix[1:])
-		out.String(string(in.MessageID))
+	if in.Prev != "" {
+		const prefix string = ",\"prev\":"
+		out.RawString(prefix)
+		out.String(string(in.Prev))
 	}
-	{
-		const prefix string = ",\"user_id\":"
+	if in.ReplyLimit != 0 {
+		const prefix string = ",\"reply_limit\":"
 		out.RawString(prefix)
-		out.String(string(in.UserID))
+		out.Int(int(in.ReplyLimit))
 	}
-	{
-		const prefix string = ",\"type\":"
+	if in.ParticipantLimit != 0 {
+		const prefix string = ",\"participant_limit\":"
 		out.RawString(prefix)
-		out.String(string(in.Type))
+		out.Int(int(in.ParticipantLimit))
 	}
-	for k, v := range in.ExtraData {
-		switch k {
-		case "message_id", "user_id", "type":
-			continue // don't allow field overwrites
-		}
-		out.RawByte(',')
-		out.String(string(k))
-		out.RawByte(':')
-		if m, ok := v.(easyjson.Marshaler); ok {
-			m.MarshalEasyJSON(out)
-		} else if m, ok := v.(json.Marshaler); ok {
-			out.Raw(m.MarshalJSON())
-		} else {
-			out.Raw(json.Marshal(v))
-		}
+	if in.MemberLimit != 0 {
+		const prefix string = ",\"member_limit\":"
+		out.RawString(prefix)
+		out.Int(int(in.MemberLimit))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Reaction) MarshalJSON() ([]byte, error) {
+func (v queryThreadsRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo32(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV236(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Reaction) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo32(w, v)
+func (v queryThreadsRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV236(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Reaction) UnmarshalJSON(data []byte) error {
+func (v *queryThreadsRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo32(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV236(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Reaction) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo32(l, v)
+func (v *queryThreadsRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV236(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo33(in *jlexer.Lexer, out *QueryOption) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV237(in *jlexer.Lexer, out *queryResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3918,9 +3790,6 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo33(in *jlexer.Lexer, ou
 		in.Skip()
 		return
 	}
-	for key := range out.Filter {
-		delete(out.Filter, key)
-	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
 		key := in.UnsafeString()
@@ -3931,90 +3800,291 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo33(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "limit":
-			out.Limit = int(in.Int())
-		case "offset":
-			out.Offset = int(in.Int())
-		default:
-			if out.Filter == nil {
-				out.Filter = make(map[string]interface{})
+		case "channel":
+			if in.IsNull() {
+				in.Skip()
+				out.Channel = nil
+			} else {
+				if out.Channel == nil {
+					out.Channel = new(Channel)
+				}
+				(*out.Channel).UnmarshalEasyJSON(in)
 			}
-			out.Filter[key] = in.Interface()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
+		case "messages":
+			if in.IsNull() {
+				in.Skip()
+				out.Messages = nil
+			} else {
+				in.Delim('[')
+				if out.Messages == nil {
+					if !in.IsDelim(']') {
+						out.Messages = make([]*Message, 0, 8)
+					} else {
+						out.Messages = []*Message{}
+					}
+				} else {
+					out.Messages = (out.Messages)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v55 *Message
+					if in.IsNull() {
+						in.Skip()
+						v55 = nil
+					} else {
+						if v55 == nil {
+							v55 = new(Message)
+						}
+						(*v55).UnmarshalEasyJSON(in)
+					}
+					out.Messages = append(out.Messages, v55)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "members":
+			if in.IsNull() {
+				in.Skip()
+				out.Members = nil
+			} else {
+				in.Delim('[')
+				if out.Members == nil {
+					if !in.IsDelim(']') {
+						out.Members = make([]*ChannelMember, 0, 8)
+					} else {
+						out.Members = []*ChannelMember{}
+					}
+				} else {
+					out.Members = (out.Members)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v56 *ChannelMember
+					if in.IsNull() {
+						in.Skip()
+						v56 = nil
+					} else {
+						if v56 == nil {
+							v56 = new(ChannelMember)
+						}
+						(*v56).UnmarshalEasyJSON(in)
+					}
+					out.Members = append(out.Members, v56)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "read":
+			if in.IsNull() {
+				in.Skip()
+				out.Read = nil
+			} else {
+				in.Delim('[')
+				if out.Read == nil {
+					if !in.IsDelim(']') {
+						out.Read = make([]*ChannelRead, 0, 8)
+					} else {
+						out.Read = []*ChannelRead{}
+					}
+				} else {
+					out.Read = (out.Read)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v57 *ChannelRead
+					if in.IsNull() {
+						in.Skip()
+						v57 = nil
+					} else {
+						if v57 == nil {
+							v57 = new(ChannelRead)
+						}
+						(*v57).UnmarshalEasyJSON(in)
+					}
+					out.Read = append(out.Read, v57)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "watcher_count":
+			out.WatcherCount = int(in.Int())
+		case "watchers":
+			if in.IsNull() {
+				in.Skip()
+				out.Watchers = nil
+			} else {
+				in.Delim('[')
+				if out.Watchers == nil {
+					if !in.IsDelim(']') {
+						out.Watchers = make([]*User, 0, 8)
+					} else {
+						out.Watchers = []*User{}
+					}
+				} else {
+					out.Watchers = (out.Watchers)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v58 *User
+					if in.IsNull() {
+						in.Skip()
+						v58 = nil
+					} else {
+						if v58 == nil {
+							v58 = new(User)
+						}
+						(*v58).UnmarshalEasyJSON(in)
+					}
+					out.Watchers = append(out.Watchers, v58)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo33(out *jwriter.Writer, in QueryOption) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV237(out *jwriter.Writer, in queryResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if in.Limit != 0 {
-		const prefix string = ",\"limit\":"
+	if in.Channel != nil {
+		const prefix string = ",\"channel\":"
 		first = false
 		out.RawString(prefix[1:])
-		out.Int(int(in.Limit))
+		(*in.Channel).MarshalEasyJSON(out)
 	}
-	if in.Offset != 0 {
-		const prefix string = ",\"offset\":"
+	if len(in.Messages) != 0 {
+		const prefix string = ",\"messages\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int(int(in.Offset))
+		{
+			out.RawByte('[')
+			for v59, v60 := range in.Messages {
+				if v59 > 0 {
+					out.RawByte(',')
+				}
+				if v60 == nil {
+					out.RawString("null")
+				} else {
+					(*v60).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
 	}
-	for k, v := range in.Filter {
-		switch k {
-		case "limit", "offset":
-			continue // don't allow field overwrites
+	if len(in.Members) != 0 {
+		const prefix string = ",\"members\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v61, v62 := range in.Members {
+				if v61 > 0 {
+					out.RawByte(',')
+				}
+				if v62 == nil {
+					out.RawString("null")
+				} else {
+					(*v62).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
 		}
+	}
+	if len(in.Read) != 0 {
+		const prefix string = ",\"read\":"
 		if first {
 			first = false
+			out.RawString(prefix[1:])
 		} else {
-			out.RawByte(',')
+			out.RawString(prefix)
 		}
-		out.String(string(k))
-		out.RawByte(':')
-		if m, ok := v.(easyjson.Marshaler); ok {
-			m.MarshalEasyJSON(out)
-		} else if m, ok := v.(json.Marshaler); ok {
-			out.Raw(m.MarshalJSON())
+		{
+			out.RawByte('[')
+			for v63, v64 := range in.Read {
+				if v63 > 0 {
+					out.RawByte(',')
+				}
+				if v64 == nil {
+					out.RawString("null")
+				} else {
+					(*v64).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.WatcherCount != 0 {
+		const prefix string = ",\"watcher_count\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
 		} else {
-			out.Raw(json.Marshal(v))
+			out.RawString(prefix)
+		}
+		out.Int(int(in.WatcherCount))
+	}
+	if len(in.Watchers) != 0 {
+		const prefix string = ",\"watchers\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v65, v66 := range in.Watchers {
+				if v65 > 0 {
+					out.RawByte(',')
+				}
+				if v66 == nil {
+					out.RawString("null")
+				} else {
+					(*v66).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
 		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v QueryOption) MarshalJSON() ([]byte, error) {
+func (v queryResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo33(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV237(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v QueryOption) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo33(w, v)
+func (v queryResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV237(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *QueryOption) UnmarshalJSON(data []byte) error {
+func (v *queryResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo33(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV237(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *QueryOption) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo33(l, v)
+func (v *queryResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV237(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo34(in *jlexer.Lexer, out *PushNotificationFields) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV238(in *jlexer.Lexer, out *queryMessageFlagsResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4033,10 +4103,37 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo34(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "apn":
-			(out.APNConfig).UnmarshalEasyJSON(in)
-		case "firebase":
-			(out.FirebaseConfig).UnmarshalEasyJSON(in)
+		case "flags":
+			if in.IsNull() {
+				in.Skip()
+				out.Flags = nil
+			} else {
+				in.Delim('[')
+				if out.Flags == nil {
+					if !in.IsDelim(']') {
+						out.Flags = make([]*MessageFlag, 0, 8)
+					} else {
+						out.Flags = []*MessageFlag{}
+					}
+				} else {
+					out.Flags = (out.Flags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v67 *MessageFlag
+					if in.IsNull() {
+						in.Skip()
+						v67 = nil
+					} else {
+						if v67 == nil {
+							v67 = new(MessageFlag)
+						}
+						(*v67).UnmarshalEasyJSON(in)
+					}
+					out.Flags = append(out.Flags, v67)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -4047,47 +4144,57 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo34(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo34(out *jwriter.Writer, in PushNotificationFields) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV238(out *jwriter.Writer, in queryMessageFlagsResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"apn\":"
+		const prefix string = ",\"flags\":"
 		out.RawString(prefix[1:])
-		(in.APNConfig).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"firebase\":"
-		out.RawString(prefix)
-		(in.FirebaseConfig).MarshalEasyJSON(out)
+		if in.Flags == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v68, v69 := range in.Flags {
+				if v68 > 0 {
+					out.RawByte(',')
+				}
+				if v69 == nil {
+					out.RawString("null")
+				} else {
+					(*v69).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PushNotificationFields) MarshalJSON() ([]byte, error) {
+func (v queryMessageFlagsResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo34(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV238(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PushNotificationFields) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo34(w, v)
+func (v queryMessageFlagsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV238(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PushNotificationFields) UnmarshalJSON(data []byte) error {
+func (v *queryMessageFlagsResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo34(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV238(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PushNotificationFields) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo34(l, v)
+func (v *queryMessageFlagsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV238(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo35(in *jlexer.Lexer, out *Policy) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV239(in *jlexer.Lexer, out *queryMessageFlagsRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4106,68 +4213,36 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo35(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "name":
-			out.Name = string(in.String())
-		case "resources":
+		case "filter_conditions":
 			if in.IsNull() {
 				in.Skip()
-				out.Resources = nil
 			} else {
-				in.Delim('[')
-				if out.Resources == nil {
-					if !in.IsDelim(']') {
-						out.Resources = make([]string, 0, 4)
-					} else {
-						out.Resources = []string{}
-					}
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.FilterConditions = make(map[string]interface{})
 				} else {
-					out.Resources = (out.Resources)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v74 string
-					v74 = string(in.String())
-					out.Resources = append(out.Resources, v74)
-					in.WantComma()
+					out.FilterConditions = nil
 				}
-				in.Delim(']')
-			}
-		case "roles":
-			if in.IsNull() {
-				in.Skip()
-				out.Roles = nil
-			} else {
-				in.Delim('[')
-				if out.Roles == nil {
-					if !in.IsDelim(']') {
-						out.Roles = make([]string, 0, 4)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v70 interface{}
+					if m, ok := v70.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v70.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
 					} else {
-						out.Roles = []string{}
+						v70 = in.Interface()
 					}
-				} else {
-					out.Roles = (out.Roles)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v75 string
-					v75 = string(in.String())
-					out.Roles = append(out.Roles, v75)
+					(out.FilterConditions)[key] = v70
 					in.WantComma()
 				}
-				in.Delim(']')
-			}
-		case "action":
-			out.Action = int(in.Int())
-		case "owner":
-			out.Owner = bool(in.Bool())
-		case "priority":
-			out.Priority = int(in.Int())
-		case "created_at":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.CreatedAt).UnmarshalJSON(data))
-			}
-		case "updated_at":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+				in.Delim('}')
 			}
+		case "limit":
+			out.Limit = int(in.Int())
+		case "offset":
+			out.Offset = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -4178,99 +4253,83 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo35(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo35(out *jwriter.Writer, in Policy) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV239(out *jwriter.Writer, in queryMessageFlagsRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	{
-		const prefix string = ",\"name\":"
+	if len(in.FilterConditions) != 0 {
+		const prefix string = ",\"filter_conditions\":"
+		first = false
 		out.RawString(prefix[1:])
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"resources\":"
-		out.RawString(prefix)
-		if in.Resources == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v76, v77 := range in.Resources {
-				if v76 > 0 {
+		{
+			out.RawByte('{')
+			v71First := true
+			for v71Name, v71Value := range in.FilterConditions {
+				if v71First {
+					v71First = false
+				} else {
 					out.RawByte(',')
 				}
-				out.String(string(v77))
+				out.String(string(v71Name))
+				out.RawByte(':')
+				if m, ok := v71Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v71Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v71Value))
+				}
 			}
-			out.RawByte(']')
+			out.RawByte('}')
 		}
 	}
-	{
-		const prefix string = ",\"roles\":"
-		out.RawString(prefix)
-		if in.Roles == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
+	if in.Limit != 0 {
+		const prefix string = ",\"limit\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
 		} else {
-			out.RawByte('[')
-			for v78, v79 := range in.Roles {
-				if v78 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v79))
-			}
-			out.RawByte(']')
+			out.RawString(prefix)
 		}
+		out.Int(int(in.Limit))
 	}
-	{
-		const prefix string = ",\"action\":"
-		out.RawString(prefix)
-		out.Int(int(in.Action))
-	}
-	{
-		const prefix string = ",\"owner\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Owner))
-	}
-	{
-		const prefix string = ",\"priority\":"
-		out.RawString(prefix)
-		out.Int(int(in.Priority))
-	}
-	{
-		const prefix string = ",\"created_at\":"
-		out.RawString(prefix)
-		out.Raw((in.CreatedAt).MarshalJSON())
-	}
-	{
-		const prefix string = ",\"updated_at\":"
-		out.RawString(prefix)
-		out.Raw((in.UpdatedAt).MarshalJSON())
+	if in.Offset != 0 {
+		const prefix string = ",\"offset\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.Offset))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Policy) MarshalJSON() ([]byte, error) {
+func (v queryMessageFlagsRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo35(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV239(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Policy) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo35(w, v)
+func (v queryMessageFlagsRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV239(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Policy) UnmarshalJSON(data []byte) error {
+func (v *queryMessageFlagsRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo35(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV239(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Policy) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo35(l, v)
+func (v *queryMessageFlagsRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV239(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo36(in *jlexer.Lexer, out *Permission) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV240(in *jlexer.Lexer, out *queryMembersResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4289,60 +4348,37 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo36(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "name":
-			out.Name = string(in.String())
-		case "action":
-			out.Action = string(in.String())
-		case "resources":
+		case "members":
 			if in.IsNull() {
 				in.Skip()
-				out.Resources = nil
+				out.Members = nil
 			} else {
 				in.Delim('[')
-				if out.Resources == nil {
+				if out.Members == nil {
 					if !in.IsDelim(']') {
-						out.Resources = make([]string, 0, 4)
+						out.Members = make([]*ChannelMember, 0, 8)
 					} else {
-						out.Resources = []string{}
+						out.Members = []*ChannelMember{}
 					}
 				} else {
-					out.Resources = (out.Resources)[:0]
+					out.Members = (out.Members)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v80 string
-					v80 = string(in.String())
-					out.Resources = append(out.Resources, v80)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "roles":
-			if in.IsNull() {
-				in.Skip()
-				out.Roles = nil
-			} else {
-				in.Delim('[')
-				if out.Roles == nil {
-					if !in.IsDelim(']') {
-						out.Roles = make([]string, 0, 4)
+					var v72 *ChannelMember
+					if in.IsNull() {
+						in.Skip()
+						v72 = nil
 					} else {
-						out.Roles = []string{}
+						if v72 == nil {
+							v72 = new(ChannelMember)
+						}
+						(*v72).UnmarshalEasyJSON(in)
 					}
-				} else {
-					out.Roles = (out.Roles)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v81 string
-					v81 = string(in.String())
-					out.Roles = append(out.Roles, v81)
+					out.Members = append(out.Members, v72)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "owner":
-			out.Owner = bool(in.Bool())
-		case "priority":
-			out.Priority = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -4353,89 +4389,57 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo36(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo36(out *jwriter.Writer, in Permission) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV240(out *jwriter.Writer, in queryMembersResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"members\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"action\":"
-		out.RawString(prefix)
-		out.String(string(in.Action))
-	}
-	{
-		const prefix string = ",\"resources\":"
-		out.RawString(prefix)
-		if in.Resources == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Members == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v82, v83 := range in.Resources {
-				if v82 > 0 {
+			for v73, v74 := range in.Members {
+				if v73 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v83))
-			}
-			out.RawByte(']')
-		}
-	}
-	{
-		const prefix string = ",\"roles\":"
-		out.RawString(prefix)
-		if in.Roles == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v84, v85 := range in.Roles {
-				if v84 > 0 {
-					out.RawByte(',')
+				if v74 == nil {
+					out.RawString("null")
+				} else {
+					(*v74).MarshalEasyJSON(out)
 				}
-				out.String(string(v85))
 			}
 			out.RawByte(']')
 		}
 	}
-	{
-		const prefix string = ",\"owner\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Owner))
-	}
-	{
-		const prefix string = ",\"priority\":"
-		out.RawString(prefix)
-		out.Int(int(in.Priority))
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Permission) MarshalJSON() ([]byte, error) {
+func (v queryMembersResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo36(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV240(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Permission) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo36(w, v)
+func (v queryMembersResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV240(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Permission) UnmarshalJSON(data []byte) error {
+func (v *queryMembersResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo36(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV240(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Permission) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo36(l, v)
+func (v *queryMembersResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV240(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo37(in *jlexer.Lexer, out *PartialUserUpdate) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV241(in *jlexer.Lexer, out *queryMembersRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4454,57 +4458,71 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo37(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
+		case "type":
+			out.Type = string(in.String())
 		case "id":
 			out.ID = string(in.String())
-		case "set":
+		case "filter_conditions":
 			if in.IsNull() {
 				in.Skip()
 			} else {
 				in.Delim('{')
 				if !in.IsDelim('}') {
-					out.Set = make(map[string]interface{})
+					out.FilterConditions = make(map[string]interface{})
 				} else {
-					out.Set = nil
+					out.FilterConditions = nil
 				}
 				for !in.IsDelim('}') {
 					key := string(in.String())
 					in.WantColon()
-					var v86 interface{}
-					if m, ok := v86.(easyjson.Unmarshaler); ok {
+					var v75 interface{}
+					if m, ok := v75.(easyjson.Unmarshaler); ok {
 						m.UnmarshalEasyJSON(in)
-					} else if m, ok := v86.(json.Unmarshaler); ok {
+					} else if m, ok := v75.(json.Unmarshaler); ok {
 						_ = m.UnmarshalJSON(in.Raw())
 					} else {
-						v86 = in.Interface()
+						v75 = in.Interface()
 					}
-					(out.Set)[key] = v86
+					(out.FilterConditions)[key] = v75
 					in.WantComma()
 				}
 				in.Delim('}')
 			}
-		case "unset":
+		case "sort":
 			if in.IsNull() {
 				in.Skip()
-				out.Unset = nil
+				out.Sort = nil
 			} else {
 				in.Delim('[')
-				if out.Unset == nil {
+				if out.Sort == nil {
 					if !in.IsDelim(']') {
-						out.Unset = make([]string, 0, 4)
+						out.Sort = make([]*SortOption, 0, 8)
 					} else {
-						out.Unset = []string{}
+						out.Sort = []*SortOption{}
 					}
 				} else {
-					out.Unset = (out.Unset)[:0]
+					out.Sort = (out.Sort)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v87 string
-					v87 = string(in.String())
-					out.Unset = append(out.Unset, v87)
+					var v76 *SortOption
+					if in.IsNull() {
+						in.Skip()
+						v76 = nil
+					} else {
+						if v76 == nil {
+							v76 = new(SortOption)
+						}
+						(*v76).UnmarshalEasyJSON(in)
+					}
+					out.Sort = append(out.Sort, v76)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
+		case "limit":
+			out.Limit = int(in.Int())
+		case "offset":
+			out.Offset = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -4515,81 +4533,102 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo37(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo37(out *jwriter.Writer, in PartialUserUpdate) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV241(out *jwriter.Writer, in queryMembersRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"type\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.ID))
+		out.String(string(in.Type))
 	}
-	if len(in.Set) != 0 {
-		const prefix string = ",\"set\":"
+	{
+		const prefix string = ",\"id\":"
 		out.RawString(prefix)
-		{
-			out.RawByte('{')
-			v88First := true
-			for v88Name, v88Value := range in.Set {
-				if v88First {
-					v88First = false
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"filter_conditions\":"
+		out.RawString(prefix)
+		if in.FilterConditions == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v77First := true
+			for v77Name, v77Value := range in.FilterConditions {
+				if v77First {
+					v77First = false
 				} else {
 					out.RawByte(',')
 				}
-				out.String(string(v88Name))
+				out.String(string(v77Name))
 				out.RawByte(':')
-				if m, ok := v88Value.(easyjson.Marshaler); ok {
+				if m, ok := v77Value.(easyjson.Marshaler); ok {
 					m.MarshalEasyJSON(out)
-				} else if m, ok := v88Value.(json.Marshaler); ok {
+				} else if m, ok := v77Value.(json.Marshaler); ok {
 					out.Raw(m.MarshalJSON())
 				} else {
-					out.Raw(json.Marshal(v88Value))
+					out.Raw(json.Marshal(v77Value))
 				}
 			}
 			out.RawByte('}')
 		}
 	}
-	if len(in.Unset) != 0 {
-		const prefix string = ",\"unset\":"
+	if len(in.Sort) != 0 {
+		const prefix string = ",\"sort\":"
 		out.RawString(prefix)
 		{
 			out.RawByte('[')
-			for v89, v90 := range in.Unset {
-				if v89 > 0 {
+			for v78, v79 := range in.Sort {
+				if v78 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v90))
+				if v79 == nil {
+					out.RawString("null")
+				} else {
+					(*v79).MarshalEasyJSON(out)
+				}
 			}
 			out.RawByte(']')
 		}
 	}
+	if in.Limit != 0 {
+		const prefix string = ",\"limit\":"
+		out.RawString(prefix)
+		out.Int(int(in.Limit))
+	}
+	if in.Offset != 0 {
+		const prefix string = ",\"offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.Offset))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PartialUserUpdate) MarshalJSON() ([]byte, error) {
+func (v queryMembersRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo37(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV241(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PartialUserUpdate) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo37(w, v)
+func (v queryMembersRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV241(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PartialUserUpdate) UnmarshalJSON(data []byte) error {
+func (v *queryMembersRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo37(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV241(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PartialUserUpdate) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo37(l, v)
+func (v *queryMembersRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV241(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo38(in *jlexer.Lexer, out *Mute) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV242(in *jlexer.Lexer, out *queryChannelResponseData) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4608,17 +4647,108 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo38(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "user":
-			(out.User).UnmarshalEasyJSON(in)
-		case "target":
-			(out.Target).UnmarshalEasyJSON(in)
-		case "created_at":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+		case "channel":
+			if in.IsNull() {
+				in.Skip()
+				out.Channel = nil
+			} else {
+				if out.Channel == nil {
+					out.Channel = new(Channel)
+				}
+				(*out.Channel).UnmarshalEasyJSON(in)
 			}
-		case "updated_at":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+		case "messages":
+			if in.IsNull() {
+				in.Skip()
+				out.Messages = nil
+			} else {
+				in.Delim('[')
+				if out.Messages == nil {
+					if !in.IsDelim(']') {
+						out.Messages = make([]*Message, 0, 8)
+					} else {
+						out.Messages = []*Message{}
+					}
+				} else {
+					out.Messages = (out.Messages)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v80 *Message
+					if in.IsNull() {
+						in.Skip()
+						v80 = nil
+					} else {
+						if v80 == nil {
+							v80 = new(Message)
+						}
+						(*v80).UnmarshalEasyJSON(in)
+					}
+					out.Messages = append(out.Messages, v80)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "read":
+			if in.IsNull() {
+				in.Skip()
+				out.Read = nil
+			} else {
+				in.Delim('[')
+				if out.Read == nil {
+					if !in.IsDelim(']') {
+						out.Read = make([]*ChannelRead, 0, 8)
+					} else {
+						out.Read = []*ChannelRead{}
+					}
+				} else {
+					out.Read = (out.Read)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v81 *ChannelRead
+					if in.IsNull() {
+						in.Skip()
+						v81 = nil
+					} else {
+						if v81 == nil {
+							v81 = new(ChannelRead)
+						}
+						(*v81).UnmarshalEasyJSON(in)
+					}
+					out.Read = append(out.Read, v81)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "members":
+			if in.IsNull() {
+				in.Skip()
+				out.Members = nil
+			} else {
+				in.Delim('[')
+				if out.Members == nil {
+					if !in.IsDelim(']') {
+						out.Members = make([]*ChannelMember, 0, 8)
+					} else {
+						out.Members = []*ChannelMember{}
+					}
+				} else {
+					out.Members = (out.Members)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v82 *ChannelMember
+					if in.IsNull() {
+						in.Skip()
+						v82 = nil
+					} else {
+						if v82 == nil {
+							v82 = new(ChannelMember)
+						}
+						(*v82).UnmarshalEasyJSON(in)
+					}
+					out.Members = append(out.Members, v82)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -4630,57 +4760,106 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo38(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo38(out *jwriter.Writer, in Mute) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV242(out *jwriter.Writer, in queryChannelResponseData) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"user\":"
+		const prefix string = ",\"channel\":"
 		out.RawString(prefix[1:])
-		(in.User).MarshalEasyJSON(out)
+		if in.Channel == nil {
+			out.RawString("null")
+		} else {
+			(*in.Channel).MarshalEasyJSON(out)
+		}
 	}
 	{
-		const prefix string = ",\"target\":"
+		const prefix string = ",\"messages\":"
 		out.RawString(prefix)
-		(in.Target).MarshalEasyJSON(out)
+		if in.Messages == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v83, v84 := range in.Messages {
+				if v83 > 0 {
+					out.RawByte(',')
+				}
+				if v84 == nil {
+					out.RawString("null")
+				} else {
+					(*v84).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"created_at\":"
+		const prefix string = ",\"read\":"
 		out.RawString(prefix)
-		out.Raw((in.CreatedAt).MarshalJSON())
+		if in.Read == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v85, v86 := range in.Read {
+				if v85 > 0 {
+					out.RawByte(',')
+				}
+				if v86 == nil {
+					out.RawString("null")
+				} else {
+					(*v86).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"updated_at\":"
+		const prefix string = ",\"members\":"
 		out.RawString(prefix)
-		out.Raw((in.UpdatedAt).MarshalJSON())
+		if in.Members == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v87, v88 := range in.Members {
+				if v87 > 0 {
+					out.RawByte(',')
+				}
+				if v88 == nil {
+					out.RawString("null")
+				} else {
+					(*v88).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Mute) MarshalJSON() ([]byte, error) {
+func (v queryChannelResponseData) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo38(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV242(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Mute) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo38(w, v)
+func (v queryChannelResponseData) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV242(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Mute) UnmarshalJSON(data []byte) error {
+func (v *queryChannelResponseData) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo38(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV242(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Mute) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo38(l, v)
+func (v *queryChannelResponseData) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV242(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo39(in *jlexer.Lexer, out *Message) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV243(in *jlexer.Lexer, out *queryChannelResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4699,224 +4878,22729 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo39(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = string(in.String())
-		case "text":
-			out.Text = string(in.String())
-		case "html":
-			out.HTML = string(in.String())
-		case "type":
-			out.Type = MessageType(in.String())
-		case "user":
-			if in.IsNull() {
-				in.Skip()
-				out.User = nil
-			} else {
-				if out.User == nil {
-					out.User = new(User)
-				}
-				(*out.User).UnmarshalEasyJSON(in)
-			}
-		case "attachments":
+		case "channels":
 			if in.IsNull() {
 				in.Skip()
-				out.Attachments = nil
+				out.Channels = nil
 			} else {
 				in.Delim('[')
-				if out.Attachments == nil {
+				if out.Channels == nil {
 					if !in.IsDelim(']') {
-						out.Attachments = make([]*Attachment, 0, 8)
+						out.Channels = make([]queryChannelResponseData, 0, 0)
 					} else {
-						out.Attachments = []*Attachment{}
+						out.Channels = []queryChannelResponseData{}
 					}
 				} else {
-					out.Attachments = (out.Attachments)[:0]
+					out.Channels = (out.Channels)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v91 *Attachment
-					if in.IsNull() {
-						in.Skip()
-						v91 = nil
-					} else {
-						if v91 == nil {
-							v91 = new(Attachment)
-						}
-						(*v91).UnmarshalEasyJSON(in)
-					}
-					out.Attachments = append(out.Attachments, v91)
+					var v89 queryChannelResponseData
+					(v89).UnmarshalEasyJSON(in)
+					out.Channels = append(out.Channels, v89)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "latest_reactions":
-			if in.IsNull() {
-				in.Skip()
-				out.LatestReactions = nil
-			} else {
-				in.Delim('[')
-				if out.LatestReactions == nil {
-					if !in.IsDelim(']') {
-						out.LatestReactions = make([]*Reaction, 0, 8)
-					} else {
-						out.LatestReactions = []*Reaction{}
-					}
-				} else {
-					out.LatestReactions = (out.LatestReactions)[:0]
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV243(out *jwriter.Writer, in queryChannelResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"channels\":"
+		out.RawString(prefix[1:])
+		if in.Channels == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v90, v91 := range in.Channels {
+				if v90 > 0 {
+					out.RawByte(',')
 				}
-				for !in.IsDelim(']') {
-					var v92 *Reaction
-					if in.IsNull() {
-						in.Skip()
-						v92 = nil
-					} else {
-						if v92 == nil {
-							v92 = new(Reaction)
-						}
-						(*v92).UnmarshalEasyJSON(in)
-					}
-					out.LatestReactions = append(out.LatestReactions, v92)
-					in.WantComma()
+				(v91).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v queryChannelResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV243(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v queryChannelResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV243(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *queryChannelResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV243(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *queryChannelResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV243(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV244(in *jlexer.Lexer, out *queryChannelRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "watch":
+			out.Watch = bool(in.Bool())
+		case "state":
+			out.State = bool(in.Bool())
+		case "presence":
+			out.Presence = bool(in.Bool())
+		case "user_id":
+			out.UserID = string(in.String())
+		case "filter_conditions":
+			if in.IsNull() {
+				in.Skip()
+				out.FilterConditions = nil
+			} else {
+				if out.FilterConditions == nil {
+					out.FilterConditions = new(QueryOption)
 				}
-				in.Delim(']')
+				(*out.FilterConditions).UnmarshalEasyJSON(in)
 			}
-		case "own_reactions":
+		case "sort":
 			if in.IsNull() {
 				in.Skip()
-				out.OwnReactions = nil
+				out.Sort = nil
 			} else {
 				in.Delim('[')
-				if out.OwnReactions == nil {
+				if out.Sort == nil {
 					if !in.IsDelim(']') {
-						out.OwnReactions = make([]*Reaction, 0, 8)
+						out.Sort = make([]*SortOption, 0, 8)
 					} else {
-						out.OwnReactions = []*Reaction{}
+						out.Sort = []*SortOption{}
 					}
 				} else {
-					out.OwnReactions = (out.OwnReactions)[:0]
+					out.Sort = (out.Sort)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v93 *Reaction
+					var v92 *SortOption
 					if in.IsNull() {
 						in.Skip()
-						v93 = nil
+						v92 = nil
 					} else {
-						if v93 == nil {
-							v93 = new(Reaction)
+						if v92 == nil {
+							v92 = new(SortOption)
 						}
-						(*v93).UnmarshalEasyJSON(in)
+						(*v92).UnmarshalEasyJSON(in)
 					}
-					out.OwnReactions = append(out.OwnReactions, v93)
+					out.Sort = append(out.Sort, v92)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "reaction_counts":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				in.Delim('{')
-				if !in.IsDelim('}') {
-					out.ReactionCounts = make(map[string]int)
-				} else {
-					out.ReactionCounts = nil
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV244(out *jwriter.Writer, in queryChannelRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"watch\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Watch))
+	}
+	{
+		const prefix string = ",\"state\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.State))
+	}
+	{
+		const prefix string = ",\"presence\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Presence))
+	}
+	if in.UserID != "" {
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.String(string(in.UserID))
+	}
+	if in.FilterConditions != nil {
+		const prefix string = ",\"filter_conditions\":"
+		out.RawString(prefix)
+		(*in.FilterConditions).MarshalEasyJSON(out)
+	}
+	if len(in.Sort) != 0 {
+		const prefix string = ",\"sort\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v93, v94 := range in.Sort {
+				if v93 > 0 {
+					out.RawByte(',')
 				}
-				for !in.IsDelim('}') {
-					key := string(in.String())
-					in.WantColon()
-					var v94 int
-					v94 = int(in.Int())
-					(out.ReactionCounts)[key] = v94
-					in.WantComma()
+				if v94 == nil {
+					out.RawString("null")
+				} else {
+					(*v94).MarshalEasyJSON(out)
 				}
-				in.Delim('}')
 			}
-		case "parent_id":
-			out.ParentID = string(in.String())
-		case "show_in_channel":
-			out.ShowInChannel = bool(in.Bool())
-		case "reply_count":
-			out.ReplyCount = int(in.Int())
-		case "mentioned_users":
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v queryChannelRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV244(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v queryChannelRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV244(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *queryChannelRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV244(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *queryChannelRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV244(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV245(in *jlexer.Lexer, out *queryBannedUsersResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "bans":
 			if in.IsNull() {
 				in.Skip()
-				out.MentionedUsers = nil
+				out.Bans = nil
 			} else {
 				in.Delim('[')
-				if out.MentionedUsers == nil {
+				if out.Bans == nil {
 					if !in.IsDelim(']') {
-						out.MentionedUsers = make([]*User, 0, 8)
+						out.Bans = make([]*Ban, 0, 8)
 					} else {
-						out.MentionedUsers = []*User{}
+						out.Bans = []*Ban{}
 					}
 				} else {
-					out.MentionedUsers = (out.MentionedUsers)[:0]
+					out.Bans = (out.Bans)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v95 *User
+					var v95 *Ban
 					if in.IsNull() {
 						in.Skip()
 						v95 = nil
 					} else {
 						if v95 == nil {
-							v95 = new(User)
+							v95 = new(Ban)
 						}
 						(*v95).UnmarshalEasyJSON(in)
 					}
-					out.MentionedUsers = append(out.MentionedUsers, v95)
+					out.Bans = append(out.Bans, v95)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "created_at":
-			if in.IsNull() {
-				in.Skip()
-				out.CreatedAt = nil
-			} else {
-				if out.CreatedAt == nil {
-					out.CreatedAt = new(time.Time)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV245(out *jwriter.Writer, in queryBannedUsersResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"bans\":"
+		out.RawString(prefix[1:])
+		if in.Bans == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v96, v97 := range in.Bans {
+				if v96 > 0 {
+					out.RawByte(',')
 				}
-				if data := in.Raw(); in.Ok() {
-					in.AddError((*out.CreatedAt).UnmarshalJSON(data))
+				if v97 == nil {
+					out.RawString("null")
+				} else {
+					(*v97).MarshalEasyJSON(out)
 				}
 			}
-		case "updated_at":
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v queryBannedUsersResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV245(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v queryBannedUsersResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV245(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *queryBannedUsersResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV245(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *queryBannedUsersResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV245(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV246(in *jlexer.Lexer, out *queryBannedUsersRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "filter_conditions":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.FilterConditions = make(map[string]interface{})
+				} else {
+					out.FilterConditions = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v98 interface{}
+					if m, ok := v98.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v98.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v98 = in.Interface()
+					}
+					(out.FilterConditions)[key] = v98
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "sort":
+			if in.IsNull() {
+				in.Skip()
+				out.Sort = nil
+			} else {
+				in.Delim('[')
+				if out.Sort == nil {
+					if !in.IsDelim(']') {
+						out.Sort = make([]*SortOption, 0, 8)
+					} else {
+						out.Sort = []*SortOption{}
+					}
+				} else {
+					out.Sort = (out.Sort)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v99 *SortOption
+					if in.IsNull() {
+						in.Skip()
+						v99 = nil
+					} else {
+						if v99 == nil {
+							v99 = new(SortOption)
+						}
+						(*v99).UnmarshalEasyJSON(in)
+					}
+					out.Sort = append(out.Sort, v99)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "limit":
+			out.Limit = int(in.Int())
+		case "offset":
+			out.Offset = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV246(out *jwriter.Writer, in queryBannedUsersRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"filter_conditions\":"
+		out.RawString(prefix[1:])
+		if in.FilterConditions == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v100First := true
+			for v100Name, v100Value := range in.FilterConditions {
+				if v100First {
+					v100First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v100Name))
+				out.RawByte(':')
+				if m, ok := v100Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v100Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v100Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	if len(in.Sort) != 0 {
+		const prefix string = ",\"sort\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v101, v102 := range in.Sort {
+				if v101 > 0 {
+					out.RawByte(',')
+				}
+				if v102 == nil {
+					out.RawString("null")
+				} else {
+					(*v102).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Limit != 0 {
+		const prefix string = ",\"limit\":"
+		out.RawString(prefix)
+		out.Int(int(in.Limit))
+	}
+	if in.Offset != 0 {
+		const prefix string = ",\"offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.Offset))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v queryBannedUsersRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV246(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v queryBannedUsersRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV246(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *queryBannedUsersRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV246(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *queryBannedUsersRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV246(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV247(in *jlexer.Lexer, out *pushProvidersResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "push_providers":
+			if in.IsNull() {
+				in.Skip()
+				out.PushProviders = nil
+			} else {
+				in.Delim('[')
+				if out.PushProviders == nil {
+					if !in.IsDelim(']') {
+						out.PushProviders = make([]*PushProvider, 0, 8)
+					} else {
+						out.PushProviders = []*PushProvider{}
+					}
+				} else {
+					out.PushProviders = (out.PushProviders)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v103 *PushProvider
+					if in.IsNull() {
+						in.Skip()
+						v103 = nil
+					} else {
+						if v103 == nil {
+							v103 = new(PushProvider)
+						}
+						(*v103).UnmarshalEasyJSON(in)
+					}
+					out.PushProviders = append(out.PushProviders, v103)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV247(out *jwriter.Writer, in pushProvidersResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"push_providers\":"
+		out.RawString(prefix[1:])
+		if in.PushProviders == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v104, v105 := range in.PushProviders {
+				if v104 > 0 {
+					out.RawByte(',')
+				}
+				if v105 == nil {
+					out.RawString("null")
+				} else {
+					(*v105).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pushProvidersResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV247(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pushProvidersResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV247(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pushProvidersResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV247(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pushProvidersResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV247(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV248(in *jlexer.Lexer, out *pushProviderResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "push_provider":
+			if in.IsNull() {
+				in.Skip()
+				out.PushProvider = nil
+			} else {
+				if out.PushProvider == nil {
+					out.PushProvider = new(PushProvider)
+				}
+				(*out.PushProvider).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV248(out *jwriter.Writer, in pushProviderResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"push_provider\":"
+		out.RawString(prefix[1:])
+		if in.PushProvider == nil {
+			out.RawString("null")
+		} else {
+			(*in.PushProvider).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pushProviderResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV248(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pushProviderResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV248(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pushProviderResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV248(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pushProviderResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV248(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV249(in *jlexer.Lexer, out *pushProviderRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "push_provider":
+			if in.IsNull() {
+				in.Skip()
+				out.PushProvider = nil
+			} else {
+				if out.PushProvider == nil {
+					out.PushProvider = new(PushProvider)
+				}
+				(*out.PushProvider).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV249(out *jwriter.Writer, in pushProviderRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"push_provider\":"
+		out.RawString(prefix[1:])
+		if in.PushProvider == nil {
+			out.RawString("null")
+		} else {
+			(*in.PushProvider).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pushProviderRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV249(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pushProviderRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV249(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pushProviderRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV249(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pushProviderRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV249(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV250(in *jlexer.Lexer, out *pushPreferencesRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "preferences":
+			if in.IsNull() {
+				in.Skip()
+				out.Preferences = nil
+			} else {
+				in.Delim('[')
+				if out.Preferences == nil {
+					if !in.IsDelim(']') {
+						out.Preferences = make([]*PushPreferenceInput, 0, 8)
+					} else {
+						out.Preferences = []*PushPreferenceInput{}
+					}
+				} else {
+					out.Preferences = (out.Preferences)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v106 *PushPreferenceInput
+					if in.IsNull() {
+						in.Skip()
+						v106 = nil
+					} else {
+						if v106 == nil {
+							v106 = new(PushPreferenceInput)
+						}
+						(*v106).UnmarshalEasyJSON(in)
+					}
+					out.Preferences = append(out.Preferences, v106)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV250(out *jwriter.Writer, in pushPreferencesRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"preferences\":"
+		out.RawString(prefix[1:])
+		if in.Preferences == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v107, v108 := range in.Preferences {
+				if v107 > 0 {
+					out.RawByte(',')
+				}
+				if v108 == nil {
+					out.RawString("null")
+				} else {
+					(*v108).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pushPreferencesRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV250(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pushPreferencesRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV250(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pushPreferencesRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV250(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pushPreferencesRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV250(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV251(in *jlexer.Lexer, out *pollVoteResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "vote":
+			if in.IsNull() {
+				in.Skip()
+				out.Vote = nil
+			} else {
+				if out.Vote == nil {
+					out.Vote = new(PollVote)
+				}
+				(*out.Vote).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV251(out *jwriter.Writer, in pollVoteResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"vote\":"
+		out.RawString(prefix[1:])
+		if in.Vote == nil {
+			out.RawString("null")
+		} else {
+			(*in.Vote).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pollVoteResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV251(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pollVoteResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV251(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pollVoteResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV251(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pollVoteResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV251(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV252(in *jlexer.Lexer, out *pollResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "poll":
+			if in.IsNull() {
+				in.Skip()
+				out.Poll = nil
+			} else {
+				if out.Poll == nil {
+					out.Poll = new(Poll)
+				}
+				(*out.Poll).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV252(out *jwriter.Writer, in pollResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"poll\":"
+		out.RawString(prefix[1:])
+		if in.Poll == nil {
+			out.RawString("null")
+		} else {
+			(*in.Poll).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pollResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV252(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pollResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV252(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pollResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV252(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pollResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV252(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV253(in *jlexer.Lexer, out *pollRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	for key := range out.ExtraData {
+		delete(out.ExtraData, key)
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "voting_visibility":
+			out.VotingVisibility = PollVotingVisibility(in.String())
+		case "enforce_unique_vote":
+			out.EnforceUniqueVote = bool(in.Bool())
+		case "max_votes_allowed":
+			out.MaxVotesAllowed = int(in.Int())
+		case "allow_user_suggested_options":
+			out.AllowUserSuggestedOptions = bool(in.Bool())
+		case "allow_answers":
+			out.AllowAnswers = bool(in.Bool())
+		case "is_closed":
+			out.IsClosed = bool(in.Bool())
+		case "options":
+			if in.IsNull() {
+				in.Skip()
+				out.Options = nil
+			} else {
+				in.Delim('[')
+				if out.Options == nil {
+					if !in.IsDelim(']') {
+						out.Options = make([]*PollOption, 0, 8)
+					} else {
+						out.Options = []*PollOption{}
+					}
+				} else {
+					out.Options = (out.Options)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v109 *PollOption
+					if in.IsNull() {
+						in.Skip()
+						v109 = nil
+					} else {
+						if v109 == nil {
+							v109 = new(PollOption)
+						}
+						(*v109).UnmarshalEasyJSON(in)
+					}
+					out.Options = append(out.Options, v109)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "user_id":
+			out.UserID = string(in.String())
+		default:
+			if out.ExtraData == nil {
+				out.ExtraData = make(map[string]interface{})
+			}
+			out.ExtraData[key] = in.Interface()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV253(out *jwriter.Writer, in pollRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.ID != "" {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Name))
+	}
+	if in.Description != "" {
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	if in.VotingVisibility != "" {
+		const prefix string = ",\"voting_visibility\":"
+		out.RawString(prefix)
+		out.String(string(in.VotingVisibility))
+	}
+	{
+		const prefix string = ",\"enforce_unique_vote\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.EnforceUniqueVote))
+	}
+	if in.MaxVotesAllowed != 0 {
+		const prefix string = ",\"max_votes_allowed\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxVotesAllowed))
+	}
+	{
+		const prefix string = ",\"allow_user_suggested_options\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.AllowUserSuggestedOptions))
+	}
+	{
+		const prefix string = ",\"allow_answers\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.AllowAnswers))
+	}
+	{
+		const prefix string = ",\"is_closed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsClosed))
+	}
+	if len(in.Options) != 0 {
+		const prefix string = ",\"options\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v110, v111 := range in.Options {
+				if v110 > 0 {
+					out.RawByte(',')
+				}
+				if v111 == nil {
+					out.RawString("null")
+				} else {
+					(*v111).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.String(string(in.UserID))
+	}
+	for k, v := range in.ExtraData {
+		switch k {
+		case "id", "name", "description", "voting_visibility", "enforce_unique_vote", "max_votes_allowed", "allow_user_suggested_options", "allow_answers", "is_closed", "options", "user_id":
+			continue // don't allow field overwrites
+		}
+		out.RawByte(',')
+		out.String(string(k))
+		out.RawByte(':')
+		if m, ok := v.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := v.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(v))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pollRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV253(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pollRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV253(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pollRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV253(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pollRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV253(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV254(in *jlexer.Lexer, out *pollPartialUpdateRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "set":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Set = make(map[string]interface{})
+				} else {
+					out.Set = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v112 interface{}
+					if m, ok := v112.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v112.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v112 = in.Interface()
+					}
+					(out.Set)[key] = v112
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "unset":
+			if in.IsNull() {
+				in.Skip()
+				out.Unset = nil
+			} else {
+				in.Delim('[')
+				if out.Unset == nil {
+					if !in.IsDelim(']') {
+						out.Unset = make([]string, 0, 4)
+					} else {
+						out.Unset = []string{}
+					}
+				} else {
+					out.Unset = (out.Unset)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v113 string
+					v113 = string(in.String())
+					out.Unset = append(out.Unset, v113)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "user_id":
+			out.UserID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV254(out *jwriter.Writer, in pollPartialUpdateRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if len(in.Set) != 0 {
+		const prefix string = ",\"set\":"
+		first = false
+		out.RawString(prefix[1:])
+		{
+			out.RawByte('{')
+			v114First := true
+			for v114Name, v114Value := range in.Set {
+				if v114First {
+					v114First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v114Name))
+				out.RawByte(':')
+				if m, ok := v114Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v114Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v114Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	if len(in.Unset) != 0 {
+		const prefix string = ",\"unset\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v115, v116 := range in.Unset {
+				if v115 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v116))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.UserID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pollPartialUpdateRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV254(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pollPartialUpdateRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV254(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pollPartialUpdateRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV254(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pollPartialUpdateRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV254(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV255(in *jlexer.Lexer, out *pinnedMessagesResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "messages":
+			if in.IsNull() {
+				in.Skip()
+				out.Messages = nil
+			} else {
+				in.Delim('[')
+				if out.Messages == nil {
+					if !in.IsDelim(']') {
+						out.Messages = make([]*Message, 0, 8)
+					} else {
+						out.Messages = []*Message{}
+					}
+				} else {
+					out.Messages = (out.Messages)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v117 *Message
+					if in.IsNull() {
+						in.Skip()
+						v117 = nil
+					} else {
+						if v117 == nil {
+							v117 = new(Message)
+						}
+						(*v117).UnmarshalEasyJSON(in)
+					}
+					out.Messages = append(out.Messages, v117)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV255(out *jwriter.Writer, in pinnedMessagesResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"messages\":"
+		out.RawString(prefix[1:])
+		if in.Messages == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v118, v119 := range in.Messages {
+				if v118 > 0 {
+					out.RawByte(',')
+				}
+				if v119 == nil {
+					out.RawString("null")
+				} else {
+					(*v119).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pinnedMessagesResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV255(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pinnedMessagesResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV255(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pinnedMessagesResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV255(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pinnedMessagesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV255(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV256(in *jlexer.Lexer, out *pinMessageRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "set":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Set = make(map[string]interface{})
+				} else {
+					out.Set = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v120 interface{}
+					if m, ok := v120.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v120.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v120 = in.Interface()
+					}
+					(out.Set)[key] = v120
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "user_id":
+			out.UserID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV256(out *jwriter.Writer, in pinMessageRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"set\":"
+		out.RawString(prefix[1:])
+		if in.Set == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v121First := true
+			for v121Name, v121Value := range in.Set {
+				if v121First {
+					v121First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v121Name))
+				out.RawByte(':')
+				if m, ok := v121Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v121Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v121Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.String(string(in.UserID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pinMessageRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV256(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pinMessageRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV256(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pinMessageRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV256(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pinMessageRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV256(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV257(in *jlexer.Lexer, out *permissionsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "permissions":
+			if in.IsNull() {
+				in.Skip()
+				out.Permissions = nil
+			} else {
+				in.Delim('[')
+				if out.Permissions == nil {
+					if !in.IsDelim(']') {
+						out.Permissions = make([]*Permission, 0, 8)
+					} else {
+						out.Permissions = []*Permission{}
+					}
+				} else {
+					out.Permissions = (out.Permissions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v122 *Permission
+					if in.IsNull() {
+						in.Skip()
+						v122 = nil
+					} else {
+						if v122 == nil {
+							v122 = new(Permission)
+						}
+						(*v122).UnmarshalEasyJSON(in)
+					}
+					out.Permissions = append(out.Permissions, v122)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV257(out *jwriter.Writer, in permissionsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"permissions\":"
+		out.RawString(prefix[1:])
+		if in.Permissions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v123, v124 := range in.Permissions {
+				if v123 > 0 {
+					out.RawByte(',')
+				}
+				if v124 == nil {
+					out.RawString("null")
+				} else {
+					(*v124).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v permissionsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV257(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v permissionsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV257(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *permissionsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV257(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *permissionsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV257(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV258(in *jlexer.Lexer, out *permissionResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "permission":
+			if in.IsNull() {
+				in.Skip()
+				out.Permission = nil
+			} else {
+				if out.Permission == nil {
+					out.Permission = new(Permission)
+				}
+				(*out.Permission).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV258(out *jwriter.Writer, in permissionResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"permission\":"
+		out.RawString(prefix[1:])
+		if in.Permission == nil {
+			out.RawString("null")
+		} else {
+			(*in.Permission).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v permissionResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV258(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v permissionResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV258(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *permissionResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV258(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *permissionResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV258(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV259(in *jlexer.Lexer, out *partialUserUpdateReq) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "users":
+			if in.IsNull() {
+				in.Skip()
+				out.Users = nil
+			} else {
+				in.Delim('[')
+				if out.Users == nil {
+					if !in.IsDelim(']') {
+						out.Users = make([]PartialUserUpdate, 0, 1)
+					} else {
+						out.Users = []PartialUserUpdate{}
+					}
+				} else {
+					out.Users = (out.Users)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v125 PartialUserUpdate
+					(v125).UnmarshalEasyJSON(in)
+					out.Users = append(out.Users, v125)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV259(out *jwriter.Writer, in partialUserUpdateReq) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"users\":"
+		out.RawString(prefix[1:])
+		if in.Users == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v126, v127 := range in.Users {
+				if v126 > 0 {
+					out.RawByte(',')
+				}
+				(v127).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v partialUserUpdateReq) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV259(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v partialUserUpdateReq) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV259(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *partialUserUpdateReq) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV259(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *partialUserUpdateReq) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV259(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV260(in *jlexer.Lexer, out *offsetIterator) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV260(out *jwriter.Writer, in offsetIterator) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v offsetIterator) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV260(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v offsetIterator) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV260(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *offsetIterator) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV260(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *offsetIterator) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV260(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV261(in *jlexer.Lexer, out *muteResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "mute":
+			if in.IsNull() {
+				in.Skip()
+				out.Mute = nil
+			} else {
+				if out.Mute == nil {
+					out.Mute = new(Mute)
+				}
+				(*out.Mute).UnmarshalEasyJSON(in)
+			}
+		case "mutes":
+			if in.IsNull() {
+				in.Skip()
+				out.Mutes = nil
+			} else {
+				in.Delim('[')
+				if out.Mutes == nil {
+					if !in.IsDelim(']') {
+						out.Mutes = make([]*Mute, 0, 8)
+					} else {
+						out.Mutes = []*Mute{}
+					}
+				} else {
+					out.Mutes = (out.Mutes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v128 *Mute
+					if in.IsNull() {
+						in.Skip()
+						v128 = nil
+					} else {
+						if v128 == nil {
+							v128 = new(Mute)
+						}
+						(*v128).UnmarshalEasyJSON(in)
+					}
+					out.Mutes = append(out.Mutes, v128)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV261(out *jwriter.Writer, in muteResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"mute\":"
+		out.RawString(prefix[1:])
+		if in.Mute == nil {
+			out.RawString("null")
+		} else {
+			(*in.Mute).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"mutes\":"
+		out.RawString(prefix)
+		if in.Mutes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v129, v130 := range in.Mutes {
+				if v129 > 0 {
+					out.RawByte(',')
+				}
+				if v130 == nil {
+					out.RawString("null")
+				} else {
+					(*v130).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v muteResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV261(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v muteResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV261(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *muteResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV261(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *muteResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV261(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV262(in *jlexer.Lexer, out *multipartForm) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	out.Writer = new(multipart.Writer)
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV262(out *jwriter.Writer, in multipartForm) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v multipartForm) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV262(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v multipartForm) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV262(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *multipartForm) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV262(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *multipartForm) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV262(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV263(in *jlexer.Lexer, out *moderationConfigResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "config":
+			if in.IsNull() {
+				in.Skip()
+				out.Config = nil
+			} else {
+				if out.Config == nil {
+					out.Config = new(ModerationConfig)
+				}
+				(*out.Config).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV263(out *jwriter.Writer, in moderationConfigResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"config\":"
+		out.RawString(prefix[1:])
+		if in.Config == nil {
+			out.RawString("null")
+		} else {
+			(*in.Config).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v moderationConfigResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV263(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v moderationConfigResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV263(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *moderationConfigResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV263(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *moderationConfigResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV263(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV264(in *jlexer.Lexer, out *messagesResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "messages":
+			if in.IsNull() {
+				in.Skip()
+				out.Messages = nil
+			} else {
+				in.Delim('[')
+				if out.Messages == nil {
+					if !in.IsDelim(']') {
+						out.Messages = make([]*Message, 0, 8)
+					} else {
+						out.Messages = []*Message{}
+					}
+				} else {
+					out.Messages = (out.Messages)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v131 *Message
+					if in.IsNull() {
+						in.Skip()
+						v131 = nil
+					} else {
+						if v131 == nil {
+							v131 = new(Message)
+						}
+						(*v131).UnmarshalEasyJSON(in)
+					}
+					out.Messages = append(out.Messages, v131)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV264(out *jwriter.Writer, in messagesResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"messages\":"
+		out.RawString(prefix[1:])
+		if in.Messages == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v132, v133 := range in.Messages {
+				if v132 > 0 {
+					out.RawByte(',')
+				}
+				if v133 == nil {
+					out.RawString("null")
+				} else {
+					(*v133).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v messagesResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV264(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v messagesResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV264(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *messagesResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV264(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *messagesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV264(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV265(in *jlexer.Lexer, out *messageRequestUser) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV265(out *jwriter.Writer, in messageRequestUser) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v messageRequestUser) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV265(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v messageRequestUser) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV265(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *messageRequestUser) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV265(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *messageRequestUser) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV265(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV266(in *jlexer.Lexer, out *messageRequestMessage) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	for key := range out.ExtraData {
+		delete(out.ExtraData, key)
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "text":
+			out.Text = string(in.String())
+		case "type":
+			out.Type = MessageType(in.String())
+		case "attachments":
+			if in.IsNull() {
+				in.Skip()
+				out.Attachments = nil
+			} else {
+				in.Delim('[')
+				if out.Attachments == nil {
+					if !in.IsDelim(']') {
+						out.Attachments = make([]*Attachment, 0, 8)
+					} else {
+						out.Attachments = []*Attachment{}
+					}
+				} else {
+					out.Attachments = (out.Attachments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v134 *Attachment
+					if in.IsNull() {
+						in.Skip()
+						v134 = nil
+					} else {
+						if v134 == nil {
+							v134 = new(Attachment)
+						}
+						(*v134).UnmarshalEasyJSON(in)
+					}
+					out.Attachments = append(out.Attachments, v134)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "user":
+			(out.User).UnmarshalEasyJSON(in)
+		case "mentioned_users":
+			if in.IsNull() {
+				in.Skip()
+				out.MentionedUsers = nil
+			} else {
+				in.Delim('[')
+				if out.MentionedUsers == nil {
+					if !in.IsDelim(']') {
+						out.MentionedUsers = make([]string, 0, 4)
+					} else {
+						out.MentionedUsers = []string{}
+					}
+				} else {
+					out.MentionedUsers = (out.MentionedUsers)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v135 string
+					v135 = string(in.String())
+					out.MentionedUsers = append(out.MentionedUsers, v135)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "parent_id":
+			out.ParentID = string(in.String())
+		case "show_in_channel":
+			out.ShowInChannel = bool(in.Bool())
+		case "pinned":
+			out.Pinned = bool(in.Bool())
+		case "pin_expires":
+			if in.IsNull() {
+				in.Skip()
+				out.PinExpires = nil
+			} else {
+				if out.PinExpires == nil {
+					out.PinExpires = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.PinExpires).UnmarshalJSON(data))
+				}
+			}
+		case "quoted_message_id":
+			out.QuotedMessageID = string(in.String())
+		case "silent":
+			out.Silent = bool(in.Bool())
+		case "poll_id":
+			out.PollID = string(in.String())
+		default:
+			if out.ExtraData == nil {
+				out.ExtraData = make(map[string]interface{})
+			}
+			out.ExtraData[key] = in.Interface()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV266(out *jwriter.Writer, in messageRequestMessage) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.ID != "" {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"text\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Text))
+	}
+	if in.Type != "" {
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"attachments\":"
+		out.RawString(prefix)
+		if in.Attachments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v136, v137 := range in.Attachments {
+				if v136 > 0 {
+					out.RawByte(',')
+				}
+				if v137 == nil {
+					out.RawString("null")
+				} else {
+					(*v137).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"user\":"
+		out.RawString(prefix)
+		(in.User).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"mentioned_users\":"
+		out.RawString(prefix)
+		if in.MentionedUsers == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v138, v139 := range in.MentionedUsers {
+				if v138 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v139))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"parent_id\":"
+		out.RawString(prefix)
+		out.String(string(in.ParentID))
+	}
+	{
+		const prefix string = ",\"show_in_channel\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ShowInChannel))
+	}
+	if in.Pinned {
+		const prefix string = ",\"pinned\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Pinned))
+	}
+	if in.PinExpires != nil {
+		const prefix string = ",\"pin_expires\":"
+		out.RawString(prefix)
+		out.Raw((*in.PinExpires).MarshalJSON())
+	}
+	if in.QuotedMessageID != "" {
+		const prefix string = ",\"quoted_message_id\":"
+		out.RawString(prefix)
+		out.String(string(in.QuotedMessageID))
+	}
+	if in.Silent {
+		const prefix string = ",\"silent\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Silent))
+	}
+	if in.PollID != "" {
+		const prefix string = ",\"poll_id\":"
+		out.RawString(prefix)
+		out.String(string(in.PollID))
+	}
+	for k, v := range in.ExtraData {
+		switch k {
+		case "id", "text", "type", "attachments", "user", "mentioned_users", "parent_id", "show_in_channel", "pinned", "pin_expires", "quoted_message_id", "silent", "poll_id":
+			continue // don't allow field overwrites
+		}
+		out.RawByte(',')
+		out.String(string(k))
+		out.RawByte(':')
+		if m, ok := v.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := v.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(v))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v messageRequestMessage) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV266(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v messageRequestMessage) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV266(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *messageRequestMessage) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV266(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *messageRequestMessage) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV266(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV267(in *jlexer.Lexer, out *messageRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "message":
+			(out.Message).UnmarshalEasyJSON(in)
+		case "skip_push":
+			out.SkipPush = bool(in.Bool())
+		case "pending":
+			out.Pending = bool(in.Bool())
+		case "skip_enrich_url":
+			out.SkipEnrichURL = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV267(out *jwriter.Writer, in messageRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"message\":"
+		out.RawString(prefix[1:])
+		(in.Message).MarshalEasyJSON(out)
+	}
+	if in.SkipPush {
+		const prefix string = ",\"skip_push\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.SkipPush))
+	}
+	if in.Pending {
+		const prefix string = ",\"pending\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Pending))
+	}
+	if in.SkipEnrichURL {
+		const prefix string = ",\"skip_enrich_url\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.SkipEnrichURL))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v messageRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV267(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v messageRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV267(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *messageRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV267(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *messageRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV267(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV268(in *jlexer.Lexer, out *markUnreadRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = string(in.String())
+		case "message_id":
+			out.MessageID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV268(out *jwriter.Writer, in markUnreadRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.UserID))
+	}
+	{
+		const prefix string = ",\"message_id\":"
+		out.RawString(prefix)
+		out.String(string(in.MessageID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v markUnreadRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV268(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v markUnreadRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV268(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *markUnreadRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV268(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *markUnreadRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV268(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV269(in *jlexer.Lexer, out *markReadRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = string(in.String())
+		case "message_id":
+			out.MessageID = string(in.String())
+		case "thread_id":
+			out.ThreadID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV269(out *jwriter.Writer, in markReadRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.UserID))
+	}
+	if in.MessageID != "" {
+		const prefix string = ",\"message_id\":"
+		out.RawString(prefix)
+		out.String(string(in.MessageID))
+	}
+	if in.ThreadID != "" {
+		const prefix string = ",\"thread_id\":"
+		out.RawString(prefix)
+		out.String(string(in.ThreadID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v markReadRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV269(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v markReadRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV269(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *markReadRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV269(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *markReadRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV269(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV270(in *jlexer.Lexer, out *importsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "import_tasks":
+			if in.IsNull() {
+				in.Skip()
+				out.ImportTasks = nil
+			} else {
+				in.Delim('[')
+				if out.ImportTasks == nil {
+					if !in.IsDelim(']') {
+						out.ImportTasks = make([]*ImportTask, 0, 8)
+					} else {
+						out.ImportTasks = []*ImportTask{}
+					}
+				} else {
+					out.ImportTasks = (out.ImportTasks)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v140 *ImportTask
+					if in.IsNull() {
+						in.Skip()
+						v140 = nil
+					} else {
+						if v140 == nil {
+							v140 = new(ImportTask)
+						}
+						(*v140).UnmarshalEasyJSON(in)
+					}
+					out.ImportTasks = append(out.ImportTasks, v140)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV270(out *jwriter.Writer, in importsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"import_tasks\":"
+		out.RawString(prefix[1:])
+		if in.ImportTasks == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v141, v142 := range in.ImportTasks {
+				if v141 > 0 {
+					out.RawByte(',')
+				}
+				if v142 == nil {
+					out.RawString("null")
+				} else {
+					(*v142).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v importsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV270(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v importsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV270(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *importsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV270(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *importsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV270(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV271(in *jlexer.Lexer, out *importURLRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "filename":
+			out.Filename = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV271(out *jwriter.Writer, in importURLRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"filename\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Filename))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v importURLRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV271(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v importURLRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV271(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *importURLRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV271(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *importURLRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV271(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV272(in *jlexer.Lexer, out *importResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "import_task":
+			if in.IsNull() {
+				in.Skip()
+				out.ImportTask = nil
+			} else {
+				if out.ImportTask == nil {
+					out.ImportTask = new(ImportTask)
+				}
+				(*out.ImportTask).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV272(out *jwriter.Writer, in importResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"import_task\":"
+		out.RawString(prefix[1:])
+		if in.ImportTask == nil {
+			out.RawString("null")
+		} else {
+			(*in.ImportTask).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v importResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV272(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v importResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV272(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *importResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV272(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *importResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV272(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV273(in *jlexer.Lexer, out *importRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "path":
+			out.Path = string(in.String())
+		case "mode":
+			out.Mode = ImportMode(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV273(out *jwriter.Writer, in importRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"path\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Path))
+	}
+	{
+		const prefix string = ",\"mode\":"
+		out.RawString(prefix)
+		out.String(string(in.Mode))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v importRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV273(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v importRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV273(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *importRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV273(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *importRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV273(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV274(in *jlexer.Lexer, out *guestUserResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user":
+			if in.IsNull() {
+				in.Skip()
+				out.User = nil
+			} else {
+				if out.User == nil {
+					out.User = new(User)
+				}
+				(*out.User).UnmarshalEasyJSON(in)
+			}
+		case "access_token":
+			out.AccessToken = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV274(out *jwriter.Writer, in guestUserResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user\":"
+		out.RawString(prefix[1:])
+		if in.User == nil {
+			out.RawString("null")
+		} else {
+			(*in.User).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"access_token\":"
+		out.RawString(prefix)
+		out.String(string(in.AccessToken))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v guestUserResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV274(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v guestUserResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV274(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *guestUserResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV274(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *guestUserResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV274(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV275(in *jlexer.Lexer, out *guestUserRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user":
+			(out.User).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV275(out *jwriter.Writer, in guestUserRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user\":"
+		out.RawString(prefix[1:])
+		(in.User).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v guestUserRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV275(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v guestUserRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV275(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *guestUserRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV275(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *guestUserRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV275(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV276(in *jlexer.Lexer, out *flagRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "target_message_id":
+			out.TargetMessageID = string(in.String())
+		case "target_user_id":
+			out.TargetUserID = string(in.String())
+		case "user_id":
+			out.UserID = string(in.String())
+		case "reason":
+			out.Reason = string(in.String())
+		case "custom":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Custom = make(map[string]interface{})
+				} else {
+					out.Custom = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v143 interface{}
+					if m, ok := v143.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v143.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v143 = in.Interface()
+					}
+					(out.Custom)[key] = v143
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV276(out *jwriter.Writer, in flagRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.TargetMessageID != "" {
+		const prefix string = ",\"target_message_id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.TargetMessageID))
+	}
+	if in.TargetUserID != "" {
+		const prefix string = ",\"target_user_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.TargetUserID))
+	}
+	if in.UserID != "" {
+		const prefix string = ",\"user_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.UserID))
+	}
+	if in.Reason != "" {
+		const prefix string = ",\"reason\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Reason))
+	}
+	if len(in.Custom) != 0 {
+		const prefix string = ",\"custom\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('{')
+			v144First := true
+			for v144Name, v144Value := range in.Custom {
+				if v144First {
+					v144First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v144Name))
+				out.RawByte(':')
+				if m, ok := v144Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v144Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v144Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v flagRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV276(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v flagRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV276(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *flagRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV276(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *flagRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV276(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV277(in *jlexer.Lexer, out *flagReportsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "flag_reports":
+			if in.IsNull() {
+				in.Skip()
+				out.FlagReports = nil
+			} else {
+				in.Delim('[')
+				if out.FlagReports == nil {
+					if !in.IsDelim(']') {
+						out.FlagReports = make([]*FlagReport, 0, 8)
+					} else {
+						out.FlagReports = []*FlagReport{}
+					}
+				} else {
+					out.FlagReports = (out.FlagReports)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v145 *FlagReport
+					if in.IsNull() {
+						in.Skip()
+						v145 = nil
+					} else {
+						if v145 == nil {
+							v145 = new(FlagReport)
+						}
+						(*v145).UnmarshalEasyJSON(in)
+					}
+					out.FlagReports = append(out.FlagReports, v145)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV277(out *jwriter.Writer, in flagReportsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"flag_reports\":"
+		out.RawString(prefix[1:])
+		if in.FlagReports == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v146, v147 := range in.FlagReports {
+				if v146 > 0 {
+					out.RawByte(',')
+				}
+				if v147 == nil {
+					out.RawString("null")
+				} else {
+					(*v147).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v flagReportsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV277(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v flagReportsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV277(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *flagReportsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV277(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *flagReportsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV277(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV278(in *jlexer.Lexer, out *flagReportResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "flag_report":
+			if in.IsNull() {
+				in.Skip()
+				out.FlagReport = nil
+			} else {
+				if out.FlagReport == nil {
+					out.FlagReport = new(FlagReport)
+				}
+				(*out.FlagReport).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV278(out *jwriter.Writer, in flagReportResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"flag_report\":"
+		out.RawString(prefix[1:])
+		if in.FlagReport == nil {
+			out.RawString("null")
+		} else {
+			(*in.FlagReport).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v flagReportResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV278(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v flagReportResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV278(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *flagReportResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV278(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *flagReportResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV278(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV279(in *jlexer.Lexer, out *exportUsersRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.UserIDs = nil
+			} else {
+				in.Delim('[')
+				if out.UserIDs == nil {
+					if !in.IsDelim(']') {
+						out.UserIDs = make([]string, 0, 4)
+					} else {
+						out.UserIDs = []string{}
+					}
+				} else {
+					out.UserIDs = (out.UserIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v148 string
+					v148 = string(in.String())
+					out.UserIDs = append(out.UserIDs, v148)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV279(out *jwriter.Writer, in exportUsersRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_ids\":"
+		out.RawString(prefix[1:])
+		if in.UserIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v149, v150 := range in.UserIDs {
+				if v149 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v150))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v exportUsersRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV279(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v exportUsersRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV279(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *exportUsersRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV279(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *exportUsersRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV279(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV280(in *jlexer.Lexer, out *exportChannelsRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "channels":
+			if in.IsNull() {
+				in.Skip()
+				out.Channels = nil
+			} else {
+				in.Delim('[')
+				if out.Channels == nil {
+					if !in.IsDelim(']') {
+						out.Channels = make([]*ExportableChannel, 0, 8)
+					} else {
+						out.Channels = []*ExportableChannel{}
+					}
+				} else {
+					out.Channels = (out.Channels)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v151 *ExportableChannel
+					if in.IsNull() {
+						in.Skip()
+						v151 = nil
+					} else {
+						if v151 == nil {
+							v151 = new(ExportableChannel)
+						}
+						(*v151).UnmarshalEasyJSON(in)
+					}
+					out.Channels = append(out.Channels, v151)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "clear_deleted_message_text":
+			out.ClearDeletedMessageText = bool(in.Bool())
+		case "include_truncated_messages":
+			out.IncludeTruncatedMessages = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV280(out *jwriter.Writer, in exportChannelsRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"channels\":"
+		out.RawString(prefix[1:])
+		if in.Channels == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v152, v153 := range in.Channels {
+				if v152 > 0 {
+					out.RawByte(',')
+				}
+				if v153 == nil {
+					out.RawString("null")
+				} else {
+					(*v153).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.ClearDeletedMessageText {
+		const prefix string = ",\"clear_deleted_message_text\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ClearDeletedMessageText))
+	}
+	if in.IncludeTruncatedMessages {
+		const prefix string = ",\"include_truncated_messages\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IncludeTruncatedMessages))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v exportChannelsRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV280(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v exportChannelsRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV280(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *exportChannelsRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV280(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *exportChannelsRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV280(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV281(in *jlexer.Lexer, out *eventRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "event":
+			if in.IsNull() {
+				in.Skip()
+				out.Event = nil
+			} else {
+				if out.Event == nil {
+					out.Event = new(Event)
+				}
+				(*out.Event).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV281(out *jwriter.Writer, in eventRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"event\":"
+		out.RawString(prefix[1:])
+		if in.Event == nil {
+			out.RawString("null")
+		} else {
+			(*in.Event).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v eventRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV281(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v eventRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV281(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *eventRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV281(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *eventRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV281(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV282(in *jlexer.Lexer, out *devicesResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "devices":
+			if in.IsNull() {
+				in.Skip()
+				out.Devices = nil
+			} else {
+				in.Delim('[')
+				if out.Devices == nil {
+					if !in.IsDelim(']') {
+						out.Devices = make([]*Device, 0, 8)
+					} else {
+						out.Devices = []*Device{}
+					}
+				} else {
+					out.Devices = (out.Devices)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v154 *Device
+					if in.IsNull() {
+						in.Skip()
+						v154 = nil
+					} else {
+						if v154 == nil {
+							v154 = new(Device)
+						}
+						(*v154).UnmarshalEasyJSON(in)
+					}
+					out.Devices = append(out.Devices, v154)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV282(out *jwriter.Writer, in devicesResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"devices\":"
+		out.RawString(prefix[1:])
+		if in.Devices == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v155, v156 := range in.Devices {
+				if v155 > 0 {
+					out.RawByte(',')
+				}
+				if v156 == nil {
+					out.RawString("null")
+				} else {
+					(*v156).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v devicesResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV282(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v devicesResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV282(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *devicesResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV282(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *devicesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV282(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV283(in *jlexer.Lexer, out *deleteUsersRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.UserIDs = nil
+			} else {
+				in.Delim('[')
+				if out.UserIDs == nil {
+					if !in.IsDelim(']') {
+						out.UserIDs = make([]string, 0, 4)
+					} else {
+						out.UserIDs = []string{}
+					}
+				} else {
+					out.UserIDs = (out.UserIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v157 string
+					v157 = string(in.String())
+					out.UserIDs = append(out.UserIDs, v157)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "user":
+			out.User = DeleteType(in.String())
+		case "messages":
+			out.Messages = DeleteType(in.String())
+		case "conversations":
+			out.Conversations = DeleteType(in.String())
+		case "new_channel_owner_id":
+			out.NewChannelOwnerID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV283(out *jwriter.Writer, in deleteUsersRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_ids\":"
+		out.RawString(prefix[1:])
+		if in.UserIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v158, v159 := range in.UserIDs {
+				if v158 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v159))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"user\":"
+		out.RawString(prefix)
+		out.String(string(in.User))
+	}
+	if in.Messages != "" {
+		const prefix string = ",\"messages\":"
+		out.RawString(prefix)
+		out.String(string(in.Messages))
+	}
+	if in.Conversations != "" {
+		const prefix string = ",\"conversations\":"
+		out.RawString(prefix)
+		out.String(string(in.Conversations))
+	}
+	if in.NewChannelOwnerID != "" {
+		const prefix string = ",\"new_channel_owner_id\":"
+		out.RawString(prefix)
+		out.String(string(in.NewChannelOwnerID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v deleteUsersRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV283(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v deleteUsersRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV283(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *deleteUsersRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV283(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *deleteUsersRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV283(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV284(in *jlexer.Lexer, out *deleteChannelsRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "cids":
+			if in.IsNull() {
+				in.Skip()
+				out.CIDs = nil
+			} else {
+				in.Delim('[')
+				if out.CIDs == nil {
+					if !in.IsDelim(']') {
+						out.CIDs = make([]string, 0, 4)
+					} else {
+						out.CIDs = []string{}
+					}
+				} else {
+					out.CIDs = (out.CIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v160 string
+					v160 = string(in.String())
+					out.CIDs = append(out.CIDs, v160)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "hard_delete":
+			out.HardDelete = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV284(out *jwriter.Writer, in deleteChannelsRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"cids\":"
+		out.RawString(prefix[1:])
+		if in.CIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v161, v162 := range in.CIDs {
+				if v161 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v162))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"hard_delete\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.HardDelete))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v deleteChannelsRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV284(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v deleteChannelsRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV284(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *deleteChannelsRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV284(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *deleteChannelsRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV284(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV285(in *jlexer.Lexer, out *deactivateUsersRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.UserIDs = nil
+			} else {
+				in.Delim('[')
+				if out.UserIDs == nil {
+					if !in.IsDelim(']') {
+						out.UserIDs = make([]string, 0, 4)
+					} else {
+						out.UserIDs = []string{}
+					}
+				} else {
+					out.UserIDs = (out.UserIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v163 string
+					v163 = string(in.String())
+					out.UserIDs = append(out.UserIDs, v163)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "mark_messages_deleted":
+			out.MarkMessagesDeleted = bool(in.Bool())
+		case "created_by_id":
+			out.CreatedByID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV285(out *jwriter.Writer, in deactivateUsersRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_ids\":"
+		out.RawString(prefix[1:])
+		if in.UserIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v164, v165 := range in.UserIDs {
+				if v164 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v165))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.MarkMessagesDeleted {
+		const prefix string = ",\"mark_messages_deleted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.MarkMessagesDeleted))
+	}
+	if in.CreatedByID != "" {
+		const prefix string = ",\"created_by_id\":"
+		out.RawString(prefix)
+		out.String(string(in.CreatedByID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v deactivateUsersRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV285(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v deactivateUsersRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV285(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *deactivateUsersRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV285(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *deactivateUsersRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV285(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV286(in *jlexer.Lexer, out *commandsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "commands":
+			if in.IsNull() {
+				in.Skip()
+				out.Commands = nil
+			} else {
+				in.Delim('[')
+				if out.Commands == nil {
+					if !in.IsDelim(']') {
+						out.Commands = make([]*Command, 0, 8)
+					} else {
+						out.Commands = []*Command{}
+					}
+				} else {
+					out.Commands = (out.Commands)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v166 *Command
+					if in.IsNull() {
+						in.Skip()
+						v166 = nil
+					} else {
+						if v166 == nil {
+							v166 = new(Command)
+						}
+						(*v166).UnmarshalEasyJSON(in)
+					}
+					out.Commands = append(out.Commands, v166)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV286(out *jwriter.Writer, in commandsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"commands\":"
+		out.RawString(prefix[1:])
+		if in.Commands == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v167, v168 := range in.Commands {
+				if v167 > 0 {
+					out.RawByte(',')
+				}
+				if v168 == nil {
+					out.RawString("null")
+				} else {
+					(*v168).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v commandsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV286(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v commandsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV286(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *commandsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV286(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *commandsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV286(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV287(in *jlexer.Lexer, out *commandResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "command":
+			if in.IsNull() {
+				in.Skip()
+				out.Command = nil
+			} else {
+				if out.Command == nil {
+					out.Command = new(Command)
+				}
+				(*out.Command).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV287(out *jwriter.Writer, in commandResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"command\":"
+		out.RawString(prefix[1:])
+		if in.Command == nil {
+			out.RawString("null")
+		} else {
+			(*in.Command).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v commandResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV287(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v commandResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV287(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *commandResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV287(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *commandResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV287(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV288(in *jlexer.Lexer, out *channelTypeResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "channel_types":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.ChannelTypes = make(map[string]*ChannelType)
+				} else {
+					out.ChannelTypes = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v169 *ChannelType
+					if in.IsNull() {
+						in.Skip()
+						v169 = nil
+					} else {
+						if v169 == nil {
+							v169 = new(ChannelType)
+						}
+						(*v169).UnmarshalEasyJSON(in)
+					}
+					(out.ChannelTypes)[key] = v169
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV288(out *jwriter.Writer, in channelTypeResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"channel_types\":"
+		out.RawString(prefix[1:])
+		if in.ChannelTypes == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v170First := true
+			for v170Name, v170Value := range in.ChannelTypes {
+				if v170First {
+					v170First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v170Name))
+				out.RawByte(':')
+				if v170Value == nil {
+					out.RawString("null")
+				} else {
+					(*v170Value).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v channelTypeResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV288(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v channelTypeResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV288(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *channelTypeResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV288(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *channelTypeResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV288(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV289(in *jlexer.Lexer, out *channelTypeRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	out.ChannelType = new(ChannelType)
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "commands":
+			if in.IsNull() {
+				in.Skip()
+				out.Commands = nil
+			} else {
+				in.Delim('[')
+				if out.Commands == nil {
+					if !in.IsDelim(']') {
+						out.Commands = make([]string, 0, 4)
+					} else {
+						out.Commands = []string{}
+					}
+				} else {
+					out.Commands = (out.Commands)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v171 string
+					v171 = string(in.String())
+					out.Commands = append(out.Commands, v171)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "permissions":
+			if in.IsNull() {
+				in.Skip()
+				out.Permissions = nil
+			} else {
+				in.Delim('[')
+				if out.Permissions == nil {
+					if !in.IsDelim(']') {
+						out.Permissions = make([]*Permission, 0, 8)
+					} else {
+						out.Permissions = []*Permission{}
+					}
+				} else {
+					out.Permissions = (out.Permissions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v172 *Permission
+					if in.IsNull() {
+						in.Skip()
+						v172 = nil
+					} else {
+						if v172 == nil {
+							v172 = new(Permission)
+						}
+						(*v172).UnmarshalEasyJSON(in)
+					}
+					out.Permissions = append(out.Permissions, v172)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "name":
+			out.Name = string(in.String())
+		case "typing_events":
+			out.TypingEvents = bool(in.Bool())
+		case "read_events":
+			out.ReadEvents = bool(in.Bool())
+		case "connect_events":
+			out.ConnectEvents = bool(in.Bool())
+		case "search":
+			out.Search = bool(in.Bool())
+		case "reactions":
+			out.Reactions = bool(in.Bool())
+		case "replies":
+			out.Replies = bool(in.Bool())
+		case "mutes":
+			out.Mutes = bool(in.Bool())
+		case "url_enrichment":
+			out.URLEnrichment = bool(in.Bool())
+		case "message_retention":
+			out.MessageRetention = string(in.String())
+		case "max_message_length":
+			out.MaxMessageLength = int(in.Int())
+		case "automod":
+			out.Automod = modType(in.String())
+		case "automod_behavior":
+			out.ModBehavior = modBehaviour(in.String())
+		case "automod_thresholds":
+			if in.IsNull() {
+				in.Skip()
+				out.AutomodThresholds = nil
+			} else {
+				if out.AutomodThresholds == nil {
+					out.AutomodThresholds = new(Thresholds)
+				}
+				(*out.AutomodThresholds).UnmarshalEasyJSON(in)
+			}
+		case "blocklist":
+			out.Blocklist = string(in.String())
+		case "blocklist_behavior":
+			out.BlocklistBehavior = modBehaviour(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV289(out *jwriter.Writer, in channelTypeRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"commands\":"
+		out.RawString(prefix[1:])
+		if in.Commands == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v173, v174 := range in.Commands {
+				if v173 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v174))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"permissions\":"
+		out.RawString(prefix)
+		if in.Permissions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v175, v176 := range in.Permissions {
+				if v175 > 0 {
+					out.RawByte(',')
+				}
+				if v176 == nil {
+					out.RawString("null")
+				} else {
+					(*v176).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"typing_events\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.TypingEvents))
+	}
+	{
+		const prefix string = ",\"read_events\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ReadEvents))
+	}
+	{
+		const prefix string = ",\"connect_events\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ConnectEvents))
+	}
+	{
+		const prefix string = ",\"search\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Search))
+	}
+	{
+		const prefix string = ",\"reactions\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Reactions))
+	}
+	{
+		const prefix string = ",\"replies\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Replies))
+	}
+	{
+		const prefix string = ",\"mutes\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Mutes))
+	}
+	{
+		const prefix string = ",\"url_enrichment\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.URLEnrichment))
+	}
+	{
+		const prefix string = ",\"message_retention\":"
+		out.RawString(prefix)
+		out.String(string(in.MessageRetention))
+	}
+	{
+		const prefix string = ",\"max_message_length\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxMessageLength))
+	}
+	{
+		const prefix string = ",\"automod\":"
+		out.RawString(prefix)
+		out.String(string(in.Automod))
+	}
+	{
+		const prefix string = ",\"automod_behavior\":"
+		out.RawString(prefix)
+		out.String(string(in.ModBehavior))
+	}
+	if in.AutomodThresholds != nil {
+		const prefix string = ",\"automod_thresholds\":"
+		out.RawString(prefix)
+		(*in.AutomodThresholds).MarshalEasyJSON(out)
+	}
+	if in.Blocklist != "" {
+		const prefix string = ",\"blocklist\":"
+		out.RawString(prefix)
+		out.String(string(in.Blocklist))
+	}
+	if in.BlocklistBehavior != "" {
+		const prefix string = ",\"blocklist_behavior\":"
+		out.RawString(prefix)
+		out.String(string(in.BlocklistBehavior))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v channelTypeRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV289(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v channelTypeRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV289(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *channelTypeRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV289(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *channelTypeRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV289(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV290(in *jlexer.Lexer, out *channelPartialUpdateRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "set":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Set = make(map[string]interface{})
+				} else {
+					out.Set = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v177 interface{}
+					if m, ok := v177.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v177.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v177 = in.Interface()
+					}
+					(out.Set)[key] = v177
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "unset":
+			if in.IsNull() {
+				in.Skip()
+				out.Unset = nil
+			} else {
+				in.Delim('[')
+				if out.Unset == nil {
+					if !in.IsDelim(']') {
+						out.Unset = make([]string, 0, 4)
+					} else {
+						out.Unset = []string{}
+					}
+				} else {
+					out.Unset = (out.Unset)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v178 string
+					v178 = string(in.String())
+					out.Unset = append(out.Unset, v178)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV290(out *jwriter.Writer, in channelPartialUpdateRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if len(in.Set) != 0 {
+		const prefix string = ",\"set\":"
+		first = false
+		out.RawString(prefix[1:])
+		{
+			out.RawByte('{')
+			v179First := true
+			for v179Name, v179Value := range in.Set {
+				if v179First {
+					v179First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v179Name))
+				out.RawByte(':')
+				if m, ok := v179Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v179Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v179Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	if len(in.Unset) != 0 {
+		const prefix string = ",\"unset\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v180, v181 := range in.Unset {
+				if v180 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v181))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v channelPartialUpdateRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV290(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v channelPartialUpdateRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV290(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *channelPartialUpdateRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV290(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *channelPartialUpdateRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV290(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV291(in *jlexer.Lexer, out *channelMuteResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "channel_mute":
+			if in.IsNull() {
+				in.Skip()
+				out.ChannelMute = nil
+			} else {
+				if out.ChannelMute == nil {
+					out.ChannelMute = new(ChannelMute)
+				}
+				(*out.ChannelMute).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV291(out *jwriter.Writer, in channelMuteResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"channel_mute\":"
+		out.RawString(prefix[1:])
+		if in.ChannelMute == nil {
+			out.RawString("null")
+		} else {
+			(*in.ChannelMute).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v channelMuteResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV291(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v channelMuteResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV291(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *channelMuteResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV291(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *channelMuteResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV291(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV292(in *jlexer.Lexer, out *channelMemberResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "channel_member":
+			if in.IsNull() {
+				in.Skip()
+				out.ChannelMember = nil
+			} else {
+				if out.ChannelMember == nil {
+					out.ChannelMember = new(ChannelMember)
+				}
+				(*out.ChannelMember).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV292(out *jwriter.Writer, in channelMemberResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"channel_member\":"
+		out.RawString(prefix[1:])
+		if in.ChannelMember == nil {
+			out.RawString("null")
+		} else {
+			(*in.ChannelMember).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v channelMemberResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV292(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v channelMemberResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV292(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *channelMemberResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV292(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *channelMemberResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV292(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV293(in *jlexer.Lexer, out *castVoteRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "vote":
+			(out.Vote).UnmarshalEasyJSON(in)
+		case "user_id":
+			out.UserID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV293(out *jwriter.Writer, in castVoteRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"vote\":"
+		out.RawString(prefix[1:])
+		(in.Vote).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.String(string(in.UserID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v castVoteRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV293(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v castVoteRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV293(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *castVoteRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV293(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *castVoteRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV293(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV294(in *jlexer.Lexer, out *campaignResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "campaign":
+			if in.IsNull() {
+				in.Skip()
+				out.Campaign = nil
+			} else {
+				if out.Campaign == nil {
+					out.Campaign = new(Campaign)
+				}
+				(*out.Campaign).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV294(out *jwriter.Writer, in campaignResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"campaign\":"
+		out.RawString(prefix[1:])
+		if in.Campaign == nil {
+			out.RawString("null")
+		} else {
+			(*in.Campaign).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v campaignResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV294(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v campaignResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV294(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *campaignResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV294(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *campaignResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV294(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV295(in *jlexer.Lexer, out *campaignRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "sender_id":
+			out.SenderID = string(in.String())
+		case "segment_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.SegmentIDs = nil
+			} else {
+				in.Delim('[')
+				if out.SegmentIDs == nil {
+					if !in.IsDelim(']') {
+						out.SegmentIDs = make([]string, 0, 4)
+					} else {
+						out.SegmentIDs = []string{}
+					}
+				} else {
+					out.SegmentIDs = (out.SegmentIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v182 string
+					v182 = string(in.String())
+					out.SegmentIDs = append(out.SegmentIDs, v182)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "user_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.UserIDs = nil
+			} else {
+				in.Delim('[')
+				if out.UserIDs == nil {
+					if !in.IsDelim(']') {
+						out.UserIDs = make([]string, 0, 4)
+					} else {
+						out.UserIDs = []string{}
+					}
+				} else {
+					out.UserIDs = (out.UserIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v183 string
+					v183 = string(in.String())
+					out.UserIDs = append(out.UserIDs, v183)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "message_template":
+			if in.IsNull() {
+				in.Skip()
+				out.MessageTemplate = nil
+			} else {
+				if out.MessageTemplate == nil {
+					out.MessageTemplate = new(CampaignMessageTemplate)
+				}
+				(*out.MessageTemplate).UnmarshalEasyJSON(in)
+			}
+		case "channel_template":
+			if in.IsNull() {
+				in.Skip()
+				out.ChannelTemplate = nil
+			} else {
+				if out.ChannelTemplate == nil {
+					out.ChannelTemplate = new(CampaignChannelTemplate)
+				}
+				(*out.ChannelTemplate).UnmarshalEasyJSON(in)
+			}
+		case "create_channels":
+			out.CreateChannels = bool(in.Bool())
+		case "skip_push":
+			out.SkipPush = bool(in.Bool())
+		case "skip_webhook":
+			out.SkipWebhook = bool(in.Bool())
+		case "scheduled_for":
+			if in.IsNull() {
+				in.Skip()
+				out.ScheduledFor = nil
+			} else {
+				if out.ScheduledFor == nil {
+					out.ScheduledFor = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ScheduledFor).UnmarshalJSON(data))
+				}
+			}
+		case "stop_at":
+			if in.IsNull() {
+				in.Skip()
+				out.StopAt = nil
+			} else {
+				if out.StopAt == nil {
+					out.StopAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.StopAt).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV295(out *jwriter.Writer, in campaignRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.ID != "" {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Name))
+	}
+	if in.Description != "" {
+		const prefix string = ",\"description\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"sender_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.SenderID))
+	}
+	if len(in.SegmentIDs) != 0 {
+		const prefix string = ",\"segment_ids\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v184, v185 := range in.SegmentIDs {
+				if v184 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v185))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.UserIDs) != 0 {
+		const prefix string = ",\"user_ids\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v186, v187 := range in.UserIDs {
+				if v186 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v187))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"message_template\":"
+		out.RawString(prefix)
+		if in.MessageTemplate == nil {
+			out.RawString("null")
+		} else {
+			(*in.MessageTemplate).MarshalEasyJSON(out)
+		}
+	}
+	if in.ChannelTemplate != nil {
+		const prefix string = ",\"channel_template\":"
+		out.RawString(prefix)
+		(*in.ChannelTemplate).MarshalEasyJSON(out)
+	}
+	if in.CreateChannels {
+		const prefix string = ",\"create_channels\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CreateChannels))
+	}
+	if in.SkipPush {
+		const prefix string = ",\"skip_push\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.SkipPush))
+	}
+	if in.SkipWebhook {
+		const prefix string = ",\"skip_webhook\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.SkipWebhook))
+	}
+	if in.ScheduledFor != nil {
+		const prefix string = ",\"scheduled_for\":"
+		out.RawString(prefix)
+		out.Raw((*in.ScheduledFor).MarshalJSON())
+	}
+	if in.StopAt != nil {
+		const prefix string = ",\"stop_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.StopAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v campaignRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV295(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v campaignRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV295(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *campaignRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV295(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *campaignRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV295(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV296(in *jlexer.Lexer, out *blocklistsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "blocklists":
+			if in.IsNull() {
+				in.Skip()
+				out.Blocklists = nil
+			} else {
+				in.Delim('[')
+				if out.Blocklists == nil {
+					if !in.IsDelim(']') {
+						out.Blocklists = make([]*Blocklist, 0, 8)
+					} else {
+						out.Blocklists = []*Blocklist{}
+					}
+				} else {
+					out.Blocklists = (out.Blocklists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v188 *Blocklist
+					if in.IsNull() {
+						in.Skip()
+						v188 = nil
+					} else {
+						if v188 == nil {
+							v188 = new(Blocklist)
+						}
+						(*v188).UnmarshalEasyJSON(in)
+					}
+					out.Blocklists = append(out.Blocklists, v188)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV296(out *jwriter.Writer, in blocklistsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"blocklists\":"
+		out.RawString(prefix[1:])
+		if in.Blocklists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v189, v190 := range in.Blocklists {
+				if v189 > 0 {
+					out.RawByte(',')
+				}
+				if v190 == nil {
+					out.RawString("null")
+				} else {
+					(*v190).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v blocklistsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV296(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v blocklistsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV296(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *blocklistsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV296(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *blocklistsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV296(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV297(in *jlexer.Lexer, out *blocklistResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "blocklist":
+			if in.IsNull() {
+				in.Skip()
+				out.Blocklist = nil
+			} else {
+				if out.Blocklist == nil {
+					out.Blocklist = new(Blocklist)
+				}
+				(*out.Blocklist).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV297(out *jwriter.Writer, in blocklistResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"blocklist\":"
+		out.RawString(prefix[1:])
+		if in.Blocklist == nil {
+			out.RawString("null")
+		} else {
+			(*in.Blocklist).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v blocklistResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV297(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v blocklistResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV297(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *blocklistResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV297(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *blocklistResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV297(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV298(in *jlexer.Lexer, out *blocklistRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "words":
+			if in.IsNull() {
+				in.Skip()
+				out.Words = nil
+			} else {
+				in.Delim('[')
+				if out.Words == nil {
+					if !in.IsDelim(']') {
+						out.Words = make([]string, 0, 4)
+					} else {
+						out.Words = []string{}
+					}
+				} else {
+					out.Words = (out.Words)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v191 string
+					v191 = string(in.String())
+					out.Words = append(out.Words, v191)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV298(out *jwriter.Writer, in blocklistRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"words\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		if in.Words == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v192, v193 := range in.Words {
+				if v192 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v193))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v blocklistRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV298(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v blocklistRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV298(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *blocklistRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV298(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *blocklistRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV298(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV299(in *jlexer.Lexer, out *blockedUsersResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "blocks":
+			if in.IsNull() {
+				in.Skip()
+				out.Blocks = nil
+			} else {
+				in.Delim('[')
+				if out.Blocks == nil {
+					if !in.IsDelim(']') {
+						out.Blocks = make([]*UserBlock, 0, 8)
+					} else {
+						out.Blocks = []*UserBlock{}
+					}
+				} else {
+					out.Blocks = (out.Blocks)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v194 *UserBlock
+					if in.IsNull() {
+						in.Skip()
+						v194 = nil
+					} else {
+						if v194 == nil {
+							v194 = new(UserBlock)
+						}
+						(*v194).UnmarshalEasyJSON(in)
+					}
+					out.Blocks = append(out.Blocks, v194)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV299(out *jwriter.Writer, in blockedUsersResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"blocks\":"
+		out.RawString(prefix[1:])
+		if in.Blocks == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v195, v196 := range in.Blocks {
+				if v195 > 0 {
+					out.RawByte(',')
+				}
+				if v196 == nil {
+					out.RawString("null")
+				} else {
+					(*v196).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v blockedUsersResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV299(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v blockedUsersResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV299(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *blockedUsersResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV299(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *blockedUsersResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV299(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2100(in *jlexer.Lexer, out *blockUserRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "blocked_user_id":
+			out.BlockedUserID = string(in.String())
+		case "user_id":
+			out.UserID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2100(out *jwriter.Writer, in blockUserRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"blocked_user_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.BlockedUserID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.String(string(in.UserID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v blockUserRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2100(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v blockUserRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2100(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *blockUserRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2100(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *blockUserRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2100(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2101(in *jlexer.Lexer, out *banRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "target_user_id":
+			out.TargetUserID = string(in.String())
+		case "user_id":
+			out.UserID = string(in.String())
+		case "timeout":
+			out.Timeout = int64(in.Int64())
+		case "reason":
+			out.Reason = string(in.String())
+		case "ip_ban":
+			out.IPBan = bool(in.Bool())
+		case "shadow":
+			out.Shadow = bool(in.Bool())
+		case "banned_by_id":
+			out.BannedByID = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		case "id":
+			out.ID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2101(out *jwriter.Writer, in banRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"target_user_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.TargetUserID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.String(string(in.UserID))
+	}
+	if in.Timeout != 0 {
+		const prefix string = ",\"timeout\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Timeout))
+	}
+	if in.Reason != "" {
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	if in.IPBan {
+		const prefix string = ",\"ip_ban\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IPBan))
+	}
+	if in.Shadow {
+		const prefix string = ",\"shadow\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Shadow))
+	}
+	if in.BannedByID != "" {
+		const prefix string = ",\"banned_by_id\":"
+		out.RawString(prefix)
+		out.String(string(in.BannedByID))
+	}
+	if in.Type != "" {
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	if in.ID != "" {
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.String(string(in.ID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v banRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2101(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v banRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2101(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *banRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2101(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *banRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2101(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2102(in *jlexer.Lexer, out *appResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "app":
+			if in.IsNull() {
+				in.Skip()
+				out.App = nil
+			} else {
+				if out.App == nil {
+					out.App = new(AppConfig)
+				}
+				(*out.App).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2102(out *jwriter.Writer, in appResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"app\":"
+		out.RawString(prefix[1:])
+		if in.App == nil {
+			out.RawString("null")
+		} else {
+			(*in.App).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v appResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2102(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v appResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2102(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *appResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2102(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *appResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2102(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2103(in *jlexer.Lexer, out *XiaomiPushConfig) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "xiaomi_package_name":
+			out.XiaomiPackageName = string(in.String())
+		case "xiaomi_app_secret":
+			out.XiaomiAppSecret = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2103(out *jwriter.Writer, in XiaomiPushConfig) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.XiaomiPackageName != "" {
+		const prefix string = ",\"xiaomi_package_name\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.XiaomiPackageName))
+	}
+	if in.XiaomiAppSecret != "" {
+		const prefix string = ",\"xiaomi_app_secret\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.XiaomiAppSecret))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v XiaomiPushConfig) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2103(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v XiaomiPushConfig) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2103(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *XiaomiPushConfig) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2103(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *XiaomiPushConfig) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2103(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2104(in *jlexer.Lexer, out *WebSocket) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2104(out *jwriter.Writer, in WebSocket) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WebSocket) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2104(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WebSocket) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2104(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WebSocket) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2104(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WebSocket) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2104(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2105(in *jlexer.Lexer, out *UserIterator) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2105(out *jwriter.Writer, in UserIterator) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UserIterator) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2105(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UserIterator) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2105(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UserIterator) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2105(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UserIterator) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2105(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2106(in *jlexer.Lexer, out *UserExport) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user":
+			if in.IsNull() {
+				in.Skip()
+				out.User = nil
+			} else {
+				if out.User == nil {
+					out.User = new(User)
+				}
+				(*out.User).UnmarshalEasyJSON(in)
+			}
+		case "messages":
+			if in.IsNull() {
+				in.Skip()
+				out.Messages = nil
+			} else {
+				in.Delim('[')
+				if out.Messages == nil {
+					if !in.IsDelim(']') {
+						out.Messages = make([]*Message, 0, 8)
+					} else {
+						out.Messages = []*Message{}
+					}
+				} else {
+					out.Messages = (out.Messages)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v197 *Message
+					if in.IsNull() {
+						in.Skip()
+						v197 = nil
+					} else {
+						if v197 == nil {
+							v197 = new(Message)
+						}
+						(*v197).UnmarshalEasyJSON(in)
+					}
+					out.Messages = append(out.Messages, v197)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "reactions":
+			if in.IsNull() {
+				in.Skip()
+				out.Reactions = nil
+			} else {
+				in.Delim('[')
+				if out.Reactions == nil {
+					if !in.IsDelim(']') {
+						out.Reactions = make([]*Reaction, 0, 8)
+					} else {
+						out.Reactions = []*Reaction{}
+					}
+				} else {
+					out.Reactions = (out.Reactions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v198 *Reaction
+					if in.IsNull() {
+						in.Skip()
+						v198 = nil
+					} else {
+						if v198 == nil {
+							v198 = new(Reaction)
+						}
+						(*v198).UnmarshalEasyJSON(in)
+					}
+					out.Reactions = append(out.Reactions, v198)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "channels":
+			if in.IsNull() {
+				in.Skip()
+				out.Channels = nil
+			} else {
+				in.Delim('[')
+				if out.Channels == nil {
+					if !in.IsDelim(']') {
+						out.Channels = make([]*Channel, 0, 8)
+					} else {
+						out.Channels = []*Channel{}
+					}
+				} else {
+					out.Channels = (out.Channels)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v199 *Channel
+					if in.IsNull() {
+						in.Skip()
+						v199 = nil
+					} else {
+						if v199 == nil {
+							v199 = new(Channel)
+						}
+						(*v199).UnmarshalEasyJSON(in)
+					}
+					out.Channels = append(out.Channels, v199)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2106(out *jwriter.Writer, in UserExport) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user\":"
+		out.RawString(prefix[1:])
+		if in.User == nil {
+			out.RawString("null")
+		} else {
+			(*in.User).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"messages\":"
+		out.RawString(prefix)
+		if in.Messages == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v200, v201 := range in.Messages {
+				if v200 > 0 {
+					out.RawByte(',')
+				}
+				if v201 == nil {
+					out.RawString("null")
+				} else {
+					(*v201).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"reactions\":"
+		out.RawString(prefix)
+		if in.Reactions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v202, v203 := range in.Reactions {
+				if v202 > 0 {
+					out.RawByte(',')
+				}
+				if v203 == nil {
+					out.RawString("null")
+				} else {
+					(*v203).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"channels\":"
+		out.RawString(prefix)
+		if in.Channels == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v204, v205 := range in.Channels {
+				if v204 > 0 {
+					out.RawByte(',')
+				}
+				if v205 == nil {
+					out.RawString("null")
+				} else {
+					(*v205).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UserExport) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2106(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UserExport) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2106(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UserExport) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2106(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UserExport) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2106(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2107(in *jlexer.Lexer, out *UserBlock) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = string(in.String())
+		case "blocked_user_id":
+			out.BlockedUserID = string(in.String())
+		case "blocked_user":
+			if in.IsNull() {
+				in.Skip()
+				out.BlockedUser = nil
+			} else {
+				if out.BlockedUser == nil {
+					out.BlockedUser = new(User)
+				}
+				(*out.BlockedUser).UnmarshalEasyJSON(in)
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2107(out *jwriter.Writer, in UserBlock) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.UserID))
+	}
+	{
+		const prefix string = ",\"blocked_user_id\":"
+		out.RawString(prefix)
+		out.String(string(in.BlockedUserID))
+	}
+	if in.BlockedUser != nil {
+		const prefix string = ",\"blocked_user\":"
+		out.RawString(prefix)
+		(*in.BlockedUser).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UserBlock) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2107(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UserBlock) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2107(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UserBlock) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2107(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UserBlock) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2107(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2108(in *jlexer.Lexer, out *User) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	for key := range out.ExtraData {
+		delete(out.ExtraData, key)
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "image":
+			out.Image = string(in.String())
+		case "role":
+			out.Role = string(in.String())
+		case "language":
+			out.Language = string(in.String())
+		case "teams":
+			if in.IsNull() {
+				in.Skip()
+				out.Teams = nil
+			} else {
+				in.Delim('[')
+				if out.Teams == nil {
+					if !in.IsDelim(']') {
+						out.Teams = make([]string, 0, 4)
+					} else {
+						out.Teams = []string{}
+					}
+				} else {
+					out.Teams = (out.Teams)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v206 string
+					v206 = string(in.String())
+					out.Teams = append(out.Teams, v206)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "online":
+			out.Online = bool(in.Bool())
+		case "invisible":
+			out.Invisible = bool(in.Bool())
+		case "banned":
+			out.Banned = bool(in.Bool())
+		case "ban_expires":
+			if in.IsNull() {
+				in.Skip()
+				out.BanExpires = nil
+			} else {
+				if out.BanExpires == nil {
+					out.BanExpires = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.BanExpires).UnmarshalJSON(data))
+				}
+			}
+		case "shadow_banned":
+			out.ShadowBanned = bool(in.Bool())
+		case "created_at":
+			if in.IsNull() {
+				in.Skip()
+				out.CreatedAt = nil
+			} else {
+				if out.CreatedAt == nil {
+					out.CreatedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CreatedAt).UnmarshalJSON(data))
+				}
+			}
+		case "updated_at":
+			if in.IsNull() {
+				in.Skip()
+				out.UpdatedAt = nil
+			} else {
+				if out.UpdatedAt == nil {
+					out.UpdatedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.UpdatedAt).UnmarshalJSON(data))
+				}
+			}
+		case "last_active":
+			if in.IsNull() {
+				in.Skip()
+				out.LastActive = nil
+			} else {
+				if out.LastActive == nil {
+					out.LastActive = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.LastActive).UnmarshalJSON(data))
+				}
+			}
+		case "deactivated_at":
+			if in.IsNull() {
+				in.Skip()
+				out.DeactivatedAt = nil
+			} else {
+				if out.DeactivatedAt == nil {
+					out.DeactivatedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.DeactivatedAt).UnmarshalJSON(data))
+				}
+			}
+		case "deleted_at":
+			if in.IsNull() {
+				in.Skip()
+				out.DeletedAt = nil
+			} else {
+				if out.DeletedAt == nil {
+					out.DeletedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.DeletedAt).UnmarshalJSON(data))
+				}
+			}
+		case "mutes":
+			if in.IsNull() {
+				in.Skip()
+				out.Mutes = nil
+			} else {
+				in.Delim('[')
+				if out.Mutes == nil {
+					if !in.IsDelim(']') {
+						out.Mutes = make([]*Mute, 0, 8)
+					} else {
+						out.Mutes = []*Mute{}
+					}
+				} else {
+					out.Mutes = (out.Mutes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v207 *Mute
+					if in.IsNull() {
+						in.Skip()
+						v207 = nil
+					} else {
+						if v207 == nil {
+							v207 = new(Mute)
+						}
+						(*v207).UnmarshalEasyJSON(in)
+					}
+					out.Mutes = append(out.Mutes, v207)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "channel_mutes":
+			if in.IsNull() {
+				in.Skip()
+				out.ChannelMutes = nil
+			} else {
+				in.Delim('[')
+				if out.ChannelMutes == nil {
+					if !in.IsDelim(']') {
+						out.ChannelMutes = make([]*ChannelMute, 0, 8)
+					} else {
+						out.ChannelMutes = []*ChannelMute{}
+					}
+				} else {
+					out.ChannelMutes = (out.ChannelMutes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v208 *ChannelMute
+					if in.IsNull() {
+						in.Skip()
+						v208 = nil
+					} else {
+						if v208 == nil {
+							v208 = new(ChannelMute)
+						}
+						(*v208).UnmarshalEasyJSON(in)
+					}
+					out.ChannelMutes = append(out.ChannelMutes, v208)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			if out.ExtraData == nil {
+				out.ExtraData = make(map[string]interface{})
+			}
+			out.ExtraData[key] = in.Interface()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2108(out *jwriter.Writer, in User) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	if in.Image != "" {
+		const prefix string = ",\"image\":"
+		out.RawString(prefix)
+		out.String(string(in.Image))
+	}
+	if in.Role != "" {
+		const prefix string = ",\"role\":"
+		out.RawString(prefix)
+		out.String(string(in.Role))
+	}
+	if in.Language != "" {
+		const prefix string = ",\"language\":"
+		out.RawString(prefix)
+		out.String(string(in.Language))
+	}
+	if len(in.Teams) != 0 {
+		const prefix string = ",\"teams\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v209, v210 := range in.Teams {
+				if v209 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v210))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Online {
+		const prefix string = ",\"online\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Online))
+	}
+	if in.Invisible {
+		const prefix string = ",\"invisible\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Invisible))
+	}
+	if in.Banned {
+		const prefix string = ",\"banned\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Banned))
+	}
+	if in.BanExpires != nil {
+		const prefix string = ",\"ban_expires\":"
+		out.RawString(prefix)
+		out.Raw((*in.BanExpires).MarshalJSON())
+	}
+	if in.ShadowBanned {
+		const prefix string = ",\"shadow_banned\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ShadowBanned))
+	}
+	if in.CreatedAt != nil {
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.CreatedAt).MarshalJSON())
+	}
+	if in.UpdatedAt != nil {
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.UpdatedAt).MarshalJSON())
+	}
+	if in.LastActive != nil {
+		const prefix string = ",\"last_active\":"
+		out.RawString(prefix)
+		out.Raw((*in.LastActive).MarshalJSON())
+	}
+	if in.DeactivatedAt != nil {
+		const prefix string = ",\"deactivated_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.DeactivatedAt).MarshalJSON())
+	}
+	if in.DeletedAt != nil {
+		const prefix string = ",\"deleted_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.DeletedAt).MarshalJSON())
+	}
+	if len(in.Mutes) != 0 {
+		const prefix string = ",\"mutes\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v211, v212 := range in.Mutes {
+				if v211 > 0 {
+					out.RawByte(',')
+				}
+				if v212 == nil {
+					out.RawString("null")
+				} else {
+					(*v212).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.ChannelMutes) != 0 {
+		const prefix string = ",\"channel_mutes\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v213, v214 := range in.ChannelMutes {
+				if v213 > 0 {
+					out.RawByte(',')
+				}
+				if v214 == nil {
+					out.RawString("null")
+				} else {
+					(*v214).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	for k, v := range in.ExtraData {
+		switch k {
+		case "id", "name", "image", "role", "language", "teams", "online", "invisible", "banned", "ban_expires", "shadow_banned", "created_at", "updated_at", "last_active", "deactivated_at", "deleted_at", "mutes", "channel_mutes":
+			continue // don't allow field overwrites
+		}
+		out.RawByte(',')
+		out.String(string(k))
+		out.RawByte(':')
+		if m, ok := v.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := v.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(v))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v User) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2108(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v User) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2108(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *User) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2108(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *User) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2108(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2109(in *jlexer.Lexer, out *UpsertPushPreferencesResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_preferences":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.UserPreferences = make(map[string]*PushPreferences)
+				} else {
+					out.UserPreferences = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v215 *PushPreferences
+					if in.IsNull() {
+						in.Skip()
+						v215 = nil
+					} else {
+						if v215 == nil {
+							v215 = new(PushPreferences)
+						}
+						(*v215).UnmarshalEasyJSON(in)
+					}
+					(out.UserPreferences)[key] = v215
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "user_channel_preferences":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.UserChannelPreferences = make(map[string]map[string]*PushPreferences)
+				} else {
+					out.UserChannelPreferences = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v216 map[string]*PushPreferences
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						in.Delim('{')
+						if !in.IsDelim('}') {
+							v216 = make(map[string]*PushPreferences)
+						} else {
+							v216 = nil
+						}
+						for !in.IsDelim('}') {
+							key := string(in.String())
+							in.WantColon()
+							var v217 *PushPreferences
+							if in.IsNull() {
+								in.Skip()
+								v217 = nil
+							} else {
+								if v217 == nil {
+									v217 = new(PushPreferences)
+								}
+								(*v217).UnmarshalEasyJSON(in)
+							}
+							(v216)[key] = v217
+							in.WantComma()
+						}
+						in.Delim('}')
+					}
+					(out.UserChannelPreferences)[key] = v216
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2109(out *jwriter.Writer, in UpsertPushPreferencesResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_preferences\":"
+		out.RawString(prefix[1:])
+		if in.UserPreferences == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v218First := true
+			for v218Name, v218Value := range in.UserPreferences {
+				if v218First {
+					v218First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v218Name))
+				out.RawByte(':')
+				if v218Value == nil {
+					out.RawString("null")
+				} else {
+					(*v218Value).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"user_channel_preferences\":"
+		out.RawString(prefix)
+		if in.UserChannelPreferences == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v219First := true
+			for v219Name, v219Value := range in.UserChannelPreferences {
+				if v219First {
+					v219First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v219Name))
+				out.RawByte(':')
+				if v219Value == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+					out.RawString(`null`)
+				} else {
+					out.RawByte('{')
+					v220First := true
+					for v220Name, v220Value := range v219Value {
+						if v220First {
+							v220First = false
+						} else {
+							out.RawByte(',')
+						}
+						out.String(string(v220Name))
+						out.RawByte(':')
+						if v220Value == nil {
+							out.RawString("null")
+						} else {
+							(*v220Value).MarshalEasyJSON(out)
+						}
+					}
+					out.RawByte('}')
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UpsertPushPreferencesResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2109(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UpsertPushPreferencesResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2109(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UpsertPushPreferencesResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2109(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UpsertPushPreferencesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2109(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2110(in *jlexer.Lexer, out *TruncateOptions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "HardDelete":
+			out.HardDelete = bool(in.Bool())
+		case "SkipPush":
+			out.SkipPush = bool(in.Bool())
+		case "TruncatedAt":
+			if in.IsNull() {
+				in.Skip()
+				out.TruncatedAt = nil
+			} else {
+				if out.TruncatedAt == nil {
+					out.TruncatedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.TruncatedAt).UnmarshalJSON(data))
+				}
+			}
+		case "Message":
+			if in.IsNull() {
+				in.Skip()
+				out.Message = nil
+			} else {
+				if out.Message == nil {
+					out.Message = new(Message)
+				}
+				(*out.Message).UnmarshalEasyJSON(in)
+			}
+		case "UserID":
+			out.UserID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2110(out *jwriter.Writer, in TruncateOptions) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"HardDelete\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.HardDelete))
+	}
+	{
+		const prefix string = ",\"SkipPush\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.SkipPush))
+	}
+	{
+		const prefix string = ",\"TruncatedAt\":"
+		out.RawString(prefix)
+		if in.TruncatedAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.TruncatedAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"Message\":"
+		out.RawString(prefix)
+		if in.Message == nil {
+			out.RawString("null")
+		} else {
+			(*in.Message).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"UserID\":"
+		out.RawString(prefix)
+		out.String(string(in.UserID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TruncateOptions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2110(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TruncateOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2110(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TruncateOptions) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2110(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TruncateOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2110(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2111(in *jlexer.Lexer, out *TransportOptions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "MaxIdleConns":
+			out.MaxIdleConns = int(in.Int())
+		case "MaxIdleConnsPerHost":
+			out.MaxIdleConnsPerHost = int(in.Int())
+		case "MaxConnsPerHost":
+			out.MaxConnsPerHost = int(in.Int())
+		case "IdleConnTimeout":
+			out.IdleConnTimeout = time.Duration(in.Int64())
+		case "KeepAlive":
+			out.KeepAlive = time.Duration(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2111(out *jwriter.Writer, in TransportOptions) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"MaxIdleConns\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.MaxIdleConns))
+	}
+	{
+		const prefix string = ",\"MaxIdleConnsPerHost\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxIdleConnsPerHost))
+	}
+	{
+		const prefix string = ",\"MaxConnsPerHost\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxConnsPerHost))
+	}
+	{
+		const prefix string = ",\"IdleConnTimeout\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.IdleConnTimeout))
+	}
+	{
+		const prefix string = ",\"KeepAlive\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.KeepAlive))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TransportOptions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2111(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TransportOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2111(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TransportOptions) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2111(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TransportOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2111(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2112(in *jlexer.Lexer, out *Thresholds) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "explicit":
+			if in.IsNull() {
+				in.Skip()
+				out.Explicit = nil
+			} else {
+				if out.Explicit == nil {
+					out.Explicit = new(LabelThresholds)
+				}
+				(*out.Explicit).UnmarshalEasyJSON(in)
+			}
+		case "spam":
+			if in.IsNull() {
+				in.Skip()
+				out.Spam = nil
+			} else {
+				if out.Spam == nil {
+					out.Spam = new(LabelThresholds)
+				}
+				(*out.Spam).UnmarshalEasyJSON(in)
+			}
+		case "toxic":
+			if in.IsNull() {
+				in.Skip()
+				out.Toxic = nil
+			} else {
+				if out.Toxic == nil {
+					out.Toxic = new(LabelThresholds)
+				}
+				(*out.Toxic).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2112(out *jwriter.Writer, in Thresholds) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Explicit != nil {
+		const prefix string = ",\"explicit\":"
+		first = false
+		out.RawString(prefix[1:])
+		(*in.Explicit).MarshalEasyJSON(out)
+	}
+	if in.Spam != nil {
+		const prefix string = ",\"spam\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.Spam).MarshalEasyJSON(out)
+	}
+	if in.Toxic != nil {
+		const prefix string = ",\"toxic\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.Toxic).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Thresholds) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2112(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Thresholds) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2112(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Thresholds) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2112(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Thresholds) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2112(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2113(in *jlexer.Lexer, out *ThreadParticipant) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "channel_cid":
+			out.ChannelCID = string(in.String())
+		case "user_id":
+			out.UserID = string(in.String())
+		case "user":
+			if in.IsNull() {
+				in.Skip()
+				out.User = nil
+			} else {
+				if out.User == nil {
+					out.User = new(User)
+				}
+				(*out.User).UnmarshalEasyJSON(in)
+			}
+		case "last_read_at":
+			if in.IsNull() {
+				in.Skip()
+				out.LastReadAt = nil
+			} else {
+				if out.LastReadAt == nil {
+					out.LastReadAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.LastReadAt).UnmarshalJSON(data))
+				}
+			}
+		case "last_thread_message_at":
+			if in.IsNull() {
+				in.Skip()
+				out.LastThreadMessageAt = nil
+			} else {
+				if out.LastThreadMessageAt == nil {
+					out.LastThreadMessageAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.LastThreadMessageAt).UnmarshalJSON(data))
+				}
+			}
+		case "left_thread_at":
+			if in.IsNull() {
+				in.Skip()
+				out.LeftThreadAt = nil
+			} else {
+				if out.LeftThreadAt == nil {
+					out.LeftThreadAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.LeftThreadAt).UnmarshalJSON(data))
+				}
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2113(out *jwriter.Writer, in ThreadParticipant) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"channel_cid\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ChannelCID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.String(string(in.UserID))
+	}
+	if in.User != nil {
+		const prefix string = ",\"user\":"
+		out.RawString(prefix)
+		(*in.User).MarshalEasyJSON(out)
+	}
+	if in.LastReadAt != nil {
+		const prefix string = ",\"last_read_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.LastReadAt).MarshalJSON())
+	}
+	if in.LastThreadMessageAt != nil {
+		const prefix string = ",\"last_thread_message_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.LastThreadMessageAt).MarshalJSON())
+	}
+	if in.LeftThreadAt != nil {
+		const prefix string = ",\"left_thread_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.LeftThreadAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ThreadParticipant) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2113(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ThreadParticipant) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2113(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ThreadParticipant) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2113(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ThreadParticipant) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2113(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2114(in *jlexer.Lexer, out *ThreadOptions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "reply_limit":
+			out.ReplyLimit = int(in.Int())
+		case "participant_limit":
+			out.ParticipantLimit = int(in.Int())
+		case "member_limit":
+			out.MemberLimit = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2114(out *jwriter.Writer, in ThreadOptions) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.ReplyLimit != 0 {
+		const prefix string = ",\"reply_limit\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Int(int(in.ReplyLimit))
+	}
+	if in.ParticipantLimit != 0 {
+		const prefix string = ",\"participant_limit\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.ParticipantLimit))
+	}
+	if in.MemberLimit != 0 {
+		const prefix string = ",\"member_limit\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.MemberLimit))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ThreadOptions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2114(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ThreadOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2114(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ThreadOptions) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2114(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ThreadOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2114(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2115(in *jlexer.Lexer, out *Thread) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	for key := range out.ExtraData {
+		delete(out.ExtraData, key)
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "channel_cid":
+			out.ChannelCID = string(in.String())
+		case "channel":
+			if in.IsNull() {
+				in.Skip()
+				out.Channel = nil
+			} else {
+				if out.Channel == nil {
+					out.Channel = new(Channel)
+				}
+				(*out.Channel).UnmarshalEasyJSON(in)
+			}
+		case "parent_message_id":
+			out.ParentMessageID = string(in.String())
+		case "parent_message":
+			if in.IsNull() {
+				in.Skip()
+				out.ParentMessage = nil
+			} else {
+				if out.ParentMessage == nil {
+					out.ParentMessage = new(Message)
+				}
+				(*out.ParentMessage).UnmarshalEasyJSON(in)
+			}
+		case "created_by_user_id":
+			out.CreatedByUserID = string(in.String())
+		case "created_by":
+			if in.IsNull() {
+				in.Skip()
+				out.CreatedBy = nil
+			} else {
+				if out.CreatedBy == nil {
+					out.CreatedBy = new(User)
+				}
+				(*out.CreatedBy).UnmarshalEasyJSON(in)
+			}
+		case "title":
+			out.Title = string(in.String())
+		case "reply_count":
+			out.ReplyCount = int(in.Int())
+		case "participant_count":
+			out.ParticipantCount = int(in.Int())
+		case "thread_participants":
+			if in.IsNull() {
+				in.Skip()
+				out.Participants = nil
+			} else {
+				in.Delim('[')
+				if out.Participants == nil {
+					if !in.IsDelim(']') {
+						out.Participants = make([]*ThreadParticipant, 0, 8)
+					} else {
+						out.Participants = []*ThreadParticipant{}
+					}
+				} else {
+					out.Participants = (out.Participants)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v221 *ThreadParticipant
+					if in.IsNull() {
+						in.Skip()
+						v221 = nil
+					} else {
+						if v221 == nil {
+							v221 = new(ThreadParticipant)
+						}
+						(*v221).UnmarshalEasyJSON(in)
+					}
+					out.Participants = append(out.Participants, v221)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "latest_replies":
+			if in.IsNull() {
+				in.Skip()
+				out.LatestReplies = nil
+			} else {
+				in.Delim('[')
+				if out.LatestReplies == nil {
+					if !in.IsDelim(']') {
+						out.LatestReplies = make([]*Message, 0, 8)
+					} else {
+						out.LatestReplies = []*Message{}
+					}
+				} else {
+					out.LatestReplies = (out.LatestReplies)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v222 *Message
+					if in.IsNull() {
+						in.Skip()
+						v222 = nil
+					} else {
+						if v222 == nil {
+							v222 = new(Message)
+						}
+						(*v222).UnmarshalEasyJSON(in)
+					}
+					out.LatestReplies = append(out.LatestReplies, v222)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "read":
+			if in.IsNull() {
+				in.Skip()
+				out.Read = nil
+			} else {
+				in.Delim('[')
+				if out.Read == nil {
+					if !in.IsDelim(']') {
+						out.Read = make([]*ChannelRead, 0, 8)
+					} else {
+						out.Read = []*ChannelRead{}
+					}
+				} else {
+					out.Read = (out.Read)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v223 *ChannelRead
+					if in.IsNull() {
+						in.Skip()
+						v223 = nil
+					} else {
+						if v223 == nil {
+							v223 = new(ChannelRead)
+						}
+						(*v223).UnmarshalEasyJSON(in)
+					}
+					out.Read = append(out.Read, v223)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "last_message_at":
+			if in.IsNull() {
+				in.Skip()
+				out.LastMessageAt = nil
+			} else {
+				if out.LastMessageAt == nil {
+					out.LastMessageAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.LastMessageAt).UnmarshalJSON(data))
+				}
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		case "deleted_at":
+			if in.IsNull() {
+				in.Skip()
+				out.DeletedAt = nil
+			} else {
+				if out.DeletedAt == nil {
+					out.DeletedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.DeletedAt).UnmarshalJSON(data))
+				}
+			}
+		default:
+			if out.ExtraData == nil {
+				out.ExtraData = make(map[string]interface{})
+			}
+			out.ExtraData[key] = in.Interface()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2115(out *jwriter.Writer, in Thread) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"channel_cid\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ChannelCID))
+	}
+	if in.Channel != nil {
+		const prefix string = ",\"channel\":"
+		out.RawString(prefix)
+		(*in.Channel).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"parent_message_id\":"
+		out.RawString(prefix)
+		out.String(string(in.ParentMessageID))
+	}
+	if in.ParentMessage != nil {
+		const prefix string = ",\"parent_message\":"
+		out.RawString(prefix)
+		(*in.ParentMessage).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"created_by_user_id\":"
+		out.RawString(prefix)
+		out.String(string(in.CreatedByUserID))
+	}
+	if in.CreatedBy != nil {
+		const prefix string = ",\"created_by\":"
+		out.RawString(prefix)
+		(*in.CreatedBy).MarshalEasyJSON(out)
+	}
+	if in.Title != "" {
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"reply_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReplyCount))
+	}
+	{
+		const prefix string = ",\"participant_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.ParticipantCount))
+	}
+	{
+		const prefix string = ",\"thread_participants\":"
+		out.RawString(prefix)
+		if in.Participants == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v224, v225 := range in.Participants {
+				if v224 > 0 {
+					out.RawByte(',')
+				}
+				if v225 == nil {
+					out.RawString("null")
+				} else {
+					(*v225).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"latest_replies\":"
+		out.RawString(prefix)
+		if in.LatestReplies == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v226, v227 := range in.LatestReplies {
+				if v226 > 0 {
+					out.RawByte(',')
+				}
+				if v227 == nil {
+					out.RawString("null")
+				} else {
+					(*v227).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"read\":"
+		out.RawString(prefix)
+		if in.Read == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v228, v229 := range in.Read {
+				if v228 > 0 {
+					out.RawByte(',')
+				}
+				if v229 == nil {
+					out.RawString("null")
+				} else {
+					(*v229).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.LastMessageAt != nil {
+		const prefix string = ",\"last_message_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.LastMessageAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	if in.DeletedAt != nil {
+		const prefix string = ",\"deleted_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.DeletedAt).MarshalJSON())
+	}
+	for k, v := range in.ExtraData {
+		switch k {
+		case "channel_cid", "channel", "parent_message_id", "parent_message", "created_by_user_id", "created_by", "title", "reply_count", "participant_count", "thread_participants", "latest_replies", "read", "last_message_at", "created_at", "updated_at", "deleted_at":
+			continue // don't allow field overwrites
+		}
+		out.RawByte(',')
+		out.String(string(k))
+		out.RawByte(':')
+		if m, ok := v.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := v.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(v))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Thread) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2115(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Thread) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2115(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Thread) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2115(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Thread) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2115(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2116(in *jlexer.Lexer, out *Task) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "task_id":
+			out.TaskID = string(in.String())
+		case "status":
+			out.Status = TaskStatus(in.String())
+		case "result":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Result = make(map[string]interface{})
+				} else {
+					out.Result = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v230 interface{}
+					if m, ok := v230.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v230.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v230 = in.Interface()
+					}
+					(out.Result)[key] = v230
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "error":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Error = make(map[string]interface{})
+				} else {
+					out.Error = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v231 interface{}
+					if m, ok := v231.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v231.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v231 = in.Interface()
+					}
+					(out.Error)[key] = v231
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2116(out *jwriter.Writer, in Task) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"task_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.TaskID))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	if len(in.Result) != 0 {
+		const prefix string = ",\"result\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v232First := true
+			for v232Name, v232Value := range in.Result {
+				if v232First {
+					v232First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v232Name))
+				out.RawByte(':')
+				if m, ok := v232Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v232Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v232Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	if len(in.Error) != 0 {
+		const prefix string = ",\"error\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v233First := true
+			for v233Name, v233Value := range in.Error {
+				if v233First {
+					v233First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v233Name))
+				out.RawByte(':')
+				if m, ok := v233Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v233Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v233Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Task) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2116(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Task) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2116(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Task) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2116(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Task) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2116(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2117(in *jlexer.Lexer, out *SubmitModerationActionRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "item_id":
+			out.ItemID = string(in.String())
+		case "action_type":
+			out.ActionType = ModerationActionType(in.String())
+		case "user_id":
+			out.UserID = string(in.String())
+		case "ban":
+			if in.IsNull() {
+				in.Skip()
+				out.Ban = nil
+			} else {
+				if out.Ban == nil {
+					out.Ban = new(ModerationBan)
+				}
+				(*out.Ban).UnmarshalEasyJSON(in)
+			}
+		case "delete_message":
+			if in.IsNull() {
+				in.Skip()
+				out.DeleteMessage = nil
+			} else {
+				if out.DeleteMessage == nil {
+					out.DeleteMessage = new(ModerationDeleteMessage)
+				}
+				(*out.DeleteMessage).UnmarshalEasyJSON(in)
+			}
+		case "delete_user":
+			if in.IsNull() {
+				in.Skip()
+				out.DeleteUser = nil
+			} else {
+				if out.DeleteUser == nil {
+					out.DeleteUser = new(ModerationDeleteUser)
+				}
+				(*out.DeleteUser).UnmarshalEasyJSON(in)
+			}
+		case "custom":
+			if in.IsNull() {
+				in.Skip()
+				out.Custom = nil
+			} else {
+				if out.Custom == nil {
+					out.Custom = new(ModerationCustomAction)
+				}
+				(*out.Custom).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2117(out *jwriter.Writer, in SubmitModerationActionRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"item_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ItemID))
+	}
+	{
+		const prefix string = ",\"action_type\":"
+		out.RawString(prefix)
+		out.String(string(in.ActionType))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.String(string(in.UserID))
+	}
+	if in.Ban != nil {
+		const prefix string = ",\"ban\":"
+		out.RawString(prefix)
+		(*in.Ban).MarshalEasyJSON(out)
+	}
+	if in.DeleteMessage != nil {
+		const prefix string = ",\"delete_message\":"
+		out.RawString(prefix)
+		(*in.DeleteMessage).MarshalEasyJSON(out)
+	}
+	if in.DeleteUser != nil {
+		const prefix string = ",\"delete_user\":"
+		out.RawString(prefix)
+		(*in.DeleteUser).MarshalEasyJSON(out)
+	}
+	if in.Custom != nil {
+		const prefix string = ",\"custom\":"
+		out.RawString(prefix)
+		(*in.Custom).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SubmitModerationActionRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2117(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SubmitModerationActionRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2117(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SubmitModerationActionRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2117(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SubmitModerationActionRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2117(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2118(in *jlexer.Lexer, out *StreamError) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "code":
+			out.Code = int(in.Int())
+		case "message":
+			out.Message = string(in.String())
+		case "StatusCode":
+			out.StatusCode = int(in.Int())
+		case "more_info":
+			out.MoreInfo = string(in.String())
+		case "exception_fields":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.ExceptionFields = make(map[string]string)
+				} else {
+					out.ExceptionFields = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v234 string
+					v234 = string(in.String())
+					(out.ExceptionFields)[key] = v234
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2118(out *jwriter.Writer, in StreamError) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"code\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Code))
+	}
+	{
+		const prefix string = ",\"message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	{
+		const prefix string = ",\"StatusCode\":"
+		out.RawString(prefix)
+		out.Int(int(in.StatusCode))
+	}
+	{
+		const prefix string = ",\"more_info\":"
+		out.RawString(prefix)
+		out.String(string(in.MoreInfo))
+	}
+	if len(in.ExceptionFields) != 0 {
+		const prefix string = ",\"exception_fields\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v235First := true
+			for v235Name, v235Value := range in.ExceptionFields {
+				if v235First {
+					v235First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v235Name))
+				out.RawByte(':')
+				out.String(string(v235Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v StreamError) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2118(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v StreamError) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2118(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *StreamError) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2118(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *StreamError) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2118(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2119(in *jlexer.Lexer, out *StartCampaignOptions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "scheduled_for":
+			if in.IsNull() {
+				in.Skip()
+				out.ScheduledFor = nil
+			} else {
+				if out.ScheduledFor == nil {
+					out.ScheduledFor = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ScheduledFor).UnmarshalJSON(data))
+				}
+			}
+		case "stop_at":
+			if in.IsNull() {
+				in.Skip()
+				out.StopAt = nil
+			} else {
+				if out.StopAt == nil {
+					out.StopAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.StopAt).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2119(out *jwriter.Writer, in StartCampaignOptions) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.ScheduledFor != nil {
+		const prefix string = ",\"scheduled_for\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Raw((*in.ScheduledFor).MarshalJSON())
+	}
+	if in.StopAt != nil {
+		const prefix string = ",\"stop_at\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.StopAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v StartCampaignOptions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2119(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v StartCampaignOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2119(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *StartCampaignOptions) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2119(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *StartCampaignOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2119(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2120(in *jlexer.Lexer, out *SortOption) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "field":
+			out.Field = string(in.String())
+		case "direction":
+			out.Direction = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2120(out *jwriter.Writer, in SortOption) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"field\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Field))
+	}
+	{
+		const prefix string = ",\"direction\":"
+		out.RawString(prefix)
+		out.Int(int(in.Direction))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SortOption) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2120(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SortOption) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2120(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SortOption) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2120(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SortOption) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2120(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2121(in *jlexer.Lexer, out *SendFileRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "FileName":
+			out.FileName = string(in.String())
+		case "User":
+			if in.IsNull() {
+				in.Skip()
+				out.User = nil
+			} else {
+				if out.User == nil {
+					out.User = new(User)
+				}
+				(*out.User).UnmarshalEasyJSON(in)
+			}
+		case "ContentType":
+			out.ContentType = string(in.String())
+		case "Size":
+			out.Size = int64(in.Int64())
+		case "MaxSize":
+			out.MaxSize = int64(in.Int64())
+		case "UploadSizes":
+			if in.IsNull() {
+				in.Skip()
+				out.UploadSizes = nil
+			} else {
+				in.Delim('[')
+				if out.UploadSizes == nil {
+					if !in.IsDelim(']') {
+						out.UploadSizes = make([]ImageSize, 0, 1)
+					} else {
+						out.UploadSizes = []ImageSize{}
+					}
+				} else {
+					out.UploadSizes = (out.UploadSizes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v236 ImageSize
+					(v236).UnmarshalEasyJSON(in)
+					out.UploadSizes = append(out.UploadSizes, v236)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2121(out *jwriter.Writer, in SendFileRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"FileName\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.FileName))
+	}
+	{
+		const prefix string = ",\"User\":"
+		out.RawString(prefix)
+		if in.User == nil {
+			out.RawString("null")
+		} else {
+			(*in.User).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"ContentType\":"
+		out.RawString(prefix)
+		out.String(string(in.ContentType))
+	}
+	{
+		const prefix string = ",\"Size\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Size))
+	}
+	{
+		const prefix string = ",\"MaxSize\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.MaxSize))
+	}
+	{
+		const prefix string = ",\"UploadSizes\":"
+		out.RawString(prefix)
+		if in.UploadSizes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v237, v238 := range in.UploadSizes {
+				if v237 > 0 {
+					out.RawByte(',')
+				}
+				(v238).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SendFileRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2121(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SendFileRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2121(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SendFileRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2121(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SendFileRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2121(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2122(in *jlexer.Lexer, out *Segment) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "type":
+			out.Type = SegmentType(in.String())
+		case "filter":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Filter = make(map[string]interface{})
+				} else {
+					out.Filter = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v239 interface{}
+					if m, ok := v239.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v239.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v239 = in.Interface()
+					}
+					(out.Filter)[key] = v239
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "size":
+			out.Size = int(in.Int())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2122(out *jwriter.Writer, in Segment) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"filter\":"
+		out.RawString(prefix)
+		if in.Filter == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v240First := true
+			for v240Name, v240Value := range in.Filter {
+				if v240First {
+					v240First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v240Name))
+				out.RawByte(':')
+				if m, ok := v240Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v240Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v240Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"size\":"
+		out.RawString(prefix)
+		out.Int(int(in.Size))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Segment) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2122(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Segment) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2122(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Segment) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2122(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Segment) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2122(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2123(in *jlexer.Lexer, out *SearchRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "query":
+			out.Query = string(in.String())
+		case "filter_conditions":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Filters = make(map[string]interface{})
+				} else {
+					out.Filters = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v241 interface{}
+					if m, ok := v241.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v241.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v241 = in.Interface()
+					}
+					(out.Filters)[key] = v241
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "limit":
+			out.Limit = int(in.Int())
+		case "offset":
+			out.Offset = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2123(out *jwriter.Writer, in SearchRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"query\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Query))
+	}
+	{
+		const prefix string = ",\"filter_conditions\":"
+		out.RawString(prefix)
+		if in.Filters == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v242First := true
+			for v242Name, v242Value := range in.Filters {
+				if v242First {
+					v242First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v242Name))
+				out.RawByte(':')
+				if m, ok := v242Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v242Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v242Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	if in.Limit != 0 {
+		const prefix string = ",\"limit\":"
+		out.RawString(prefix)
+		out.Int(int(in.Limit))
+	}
+	if in.Offset != 0 {
+		const prefix string = ",\"offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.Offset))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SearchRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2123(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SearchRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2123(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SearchRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2123(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SearchRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2123(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2124(in *jlexer.Lexer, out *SearchIterator) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2124(out *jwriter.Writer, in SearchIterator) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SearchIterator) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2124(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SearchIterator) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2124(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SearchIterator) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2124(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SearchIterator) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2124(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2125(in *jlexer.Lexer, out *RoleAssignment) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = string(in.String())
+		case "channel_role":
+			out.ChannelRole = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2125(out *jwriter.Writer, in RoleAssignment) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.UserID))
+	}
+	{
+		const prefix string = ",\"channel_role\":"
+		out.RawString(prefix)
+		out.String(string(in.ChannelRole))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RoleAssignment) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2125(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RoleAssignment) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2125(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RoleAssignment) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2125(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RoleAssignment) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2125(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2126(in *jlexer.Lexer, out *Role) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "custom":
+			out.Custom = bool(in.Bool())
+		case "scopes":
+			if in.IsNull() {
+				in.Skip()
+				out.Scopes = nil
+			} else {
+				in.Delim('[')
+				if out.Scopes == nil {
+					if !in.IsDelim(']') {
+						out.Scopes = make([]string, 0, 4)
+					} else {
+						out.Scopes = []string{}
+					}
+				} else {
+					out.Scopes = (out.Scopes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v243 string
+					v243 = string(in.String())
+					out.Scopes = append(out.Scopes, v243)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2126(out *jwriter.Writer, in Role) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"custom\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Custom))
+	}
+	{
+		const prefix string = ",\"scopes\":"
+		out.RawString(prefix)
+		if in.Scopes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v244, v245 := range in.Scopes {
+				if v244 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v245))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Role) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2126(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Role) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2126(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Role) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2126(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Role) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2126(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2127(in *jlexer.Lexer, out *ReviewQueueItem) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "entity_type":
+			out.EntityType = string(in.String())
+		case "entity_id":
+			out.EntityID = string(in.String())
+		case "entity_creator_id":
+			out.EntityCreatorID = string(in.String())
+		case "entity_creator":
+			if in.IsNull() {
+				in.Skip()
+				out.EntityCreator = nil
+			} else {
+				if out.EntityCreator == nil {
+					out.EntityCreator = new(User)
+				}
+				(*out.EntityCreator).UnmarshalEasyJSON(in)
+			}
+		case "message":
+			if in.IsNull() {
+				in.Skip()
+				out.Message = nil
+			} else {
+				if out.Message == nil {
+					out.Message = new(Message)
+				}
+				(*out.Message).UnmarshalEasyJSON(in)
+			}
+		case "status":
+			out.Status = string(in.String())
+		case "recommended_action":
+			out.RecommendedAction = string(in.String())
+		case "languages":
+			if in.IsNull() {
+				in.Skip()
+				out.Languages = nil
+			} else {
+				in.Delim('[')
+				if out.Languages == nil {
+					if !in.IsDelim(']') {
+						out.Languages = make([]string, 0, 4)
+					} else {
+						out.Languages = []string{}
+					}
+				} else {
+					out.Languages = (out.Languages)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v246 string
+					v246 = string(in.String())
+					out.Languages = append(out.Languages, v246)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "severity":
+			out.Severity = int(in.Int())
+		case "flags":
+			if in.IsNull() {
+				in.Skip()
+				out.Flags = nil
+			} else {
+				in.Delim('[')
+				if out.Flags == nil {
+					if !in.IsDelim(']') {
+						out.Flags = make([]*ModerationFlag, 0, 8)
+					} else {
+						out.Flags = []*ModerationFlag{}
+					}
+				} else {
+					out.Flags = (out.Flags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v247 *ModerationFlag
+					if in.IsNull() {
+						in.Skip()
+						v247 = nil
+					} else {
+						if v247 == nil {
+							v247 = new(ModerationFlag)
+						}
+						(*v247).UnmarshalEasyJSON(in)
+					}
+					out.Flags = append(out.Flags, v247)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "flags_count":
+			out.FlagsCount = int(in.Int())
+		case "reviewed_by":
+			out.ReviewedBy = string(in.String())
+		case "reviewed_at":
+			if in.IsNull() {
+				in.Skip()
+				out.ReviewedAt = nil
+			} else {
+				if out.ReviewedAt == nil {
+					out.ReviewedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ReviewedAt).UnmarshalJSON(data))
+				}
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2127(out *jwriter.Writer, in ReviewQueueItem) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"entity_type\":"
+		out.RawString(prefix)
+		out.String(string(in.EntityType))
+	}
+	{
+		const prefix string = ",\"entity_id\":"
+		out.RawString(prefix)
+		out.String(string(in.EntityID))
+	}
+	{
+		const prefix string = ",\"entity_creator_id\":"
+		out.RawString(prefix)
+		out.String(string(in.EntityCreatorID))
+	}
+	if in.EntityCreator != nil {
+		const prefix string = ",\"entity_creator\":"
+		out.RawString(prefix)
+		(*in.EntityCreator).MarshalEasyJSON(out)
+	}
+	if in.Message != nil {
+		const prefix string = ",\"message\":"
+		out.RawString(prefix)
+		(*in.Message).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"recommended_action\":"
+		out.RawString(prefix)
+		out.String(string(in.RecommendedAction))
+	}
+	if len(in.Languages) != 0 {
+		const prefix string = ",\"languages\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v248, v249 := range in.Languages {
+				if v248 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v249))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"severity\":"
+		out.RawString(prefix)
+		out.Int(int(in.Severity))
+	}
+	{
+		const prefix string = ",\"flags\":"
+		out.RawString(prefix)
+		if in.Flags == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v250, v251 := range in.Flags {
+				if v250 > 0 {
+					out.RawByte(',')
+				}
+				if v251 == nil {
+					out.RawString("null")
+				} else {
+					(*v251).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"flags_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.FlagsCount))
+	}
+	if in.ReviewedBy != "" {
+		const prefix string = ",\"reviewed_by\":"
+		out.RawString(prefix)
+		out.String(string(in.ReviewedBy))
+	}
+	if in.ReviewedAt != nil {
+		const prefix string = ",\"reviewed_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.ReviewedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReviewQueueItem) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2127(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReviewQueueItem) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2127(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReviewQueueItem) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2127(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReviewQueueItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2127(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2128(in *jlexer.Lexer, out *RepliesResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "messages":
+			if in.IsNull() {
+				in.Skip()
+				out.Messages = nil
+			} else {
+				in.Delim('[')
+				if out.Messages == nil {
+					if !in.IsDelim(']') {
+						out.Messages = make([]*Message, 0, 8)
+					} else {
+						out.Messages = []*Message{}
+					}
+				} else {
+					out.Messages = (out.Messages)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v252 *Message
+					if in.IsNull() {
+						in.Skip()
+						v252 = nil
+					} else {
+						if v252 == nil {
+							v252 = new(Message)
+						}
+						(*v252).UnmarshalEasyJSON(in)
+					}
+					out.Messages = append(out.Messages, v252)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2128(out *jwriter.Writer, in RepliesResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"messages\":"
+		out.RawString(prefix[1:])
+		if in.Messages == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v253, v254 := range in.Messages {
+				if v253 > 0 {
+					out.RawByte(',')
+				}
+				if v254 == nil {
+					out.RawString("null")
+				} else {
+					(*v254).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RepliesResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2128(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RepliesResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2128(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RepliesResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2128(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RepliesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2128(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2129(in *jlexer.Lexer, out *RepliesOptions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Limit":
+			out.Limit = int(in.Int())
+		case "IDLT":
+			out.IDLT = string(in.String())
+		case "IDLTE":
+			out.IDLTE = string(in.String())
+		case "IDGT":
+			out.IDGT = string(in.String())
+		case "IDGTE":
+			out.IDGTE = string(in.String())
+		case "CreatedAtBefore":
+			if in.IsNull() {
+				in.Skip()
+				out.CreatedAtBefore = nil
+			} else {
+				if out.CreatedAtBefore == nil {
+					out.CreatedAtBefore = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CreatedAtBefore).UnmarshalJSON(data))
+				}
+			}
+		case "CreatedAtBeforeOrEqual":
+			if in.IsNull() {
+				in.Skip()
+				out.CreatedAtBeforeOrEqual = nil
+			} else {
+				if out.CreatedAtBeforeOrEqual == nil {
+					out.CreatedAtBeforeOrEqual = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CreatedAtBeforeOrEqual).UnmarshalJSON(data))
+				}
+			}
+		case "CreatedAtAfter":
+			if in.IsNull() {
+				in.Skip()
+				out.CreatedAtAfter = nil
+			} else {
+				if out.CreatedAtAfter == nil {
+					out.CreatedAtAfter = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CreatedAtAfter).UnmarshalJSON(data))
+				}
+			}
+		case "CreatedAtAfterOrEqual":
+			if in.IsNull() {
+				in.Skip()
+				out.CreatedAtAfterOrEqual = nil
+			} else {
+				if out.CreatedAtAfterOrEqual == nil {
+					out.CreatedAtAfterOrEqual = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CreatedAtAfterOrEqual).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2129(out *jwriter.Writer, in RepliesOptions) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Limit\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Limit))
+	}
+	{
+		const prefix string = ",\"IDLT\":"
+		out.RawString(prefix)
+		out.String(string(in.IDLT))
+	}
+	{
+		const prefix string = ",\"IDLTE\":"
+		out.RawString(prefix)
+		out.String(string(in.IDLTE))
+	}
+	{
+		const prefix string = ",\"IDGT\":"
+		out.RawString(prefix)
+		out.String(string(in.IDGT))
+	}
+	{
+		const prefix string = ",\"IDGTE\":"
+		out.RawString(prefix)
+		out.String(string(in.IDGTE))
+	}
+	{
+		const prefix string = ",\"CreatedAtBefore\":"
+		out.RawString(prefix)
+		if in.CreatedAtBefore == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.CreatedAtBefore).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"CreatedAtBeforeOrEqual\":"
+		out.RawString(prefix)
+		if in.CreatedAtBeforeOrEqual == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.CreatedAtBeforeOrEqual).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"CreatedAtAfter\":"
+		out.RawString(prefix)
+		if in.CreatedAtAfter == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.CreatedAtAfter).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"CreatedAtAfterOrEqual\":"
+		out.RawString(prefix)
+		if in.CreatedAtAfterOrEqual == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.CreatedAtAfterOrEqual).MarshalJSON())
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RepliesOptions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2129(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RepliesOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2129(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RepliesOptions) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2129(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RepliesOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2129(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2130(in *jlexer.Lexer, out *Reminder) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "channel_cid":
+			out.ChannelCID = string(in.String())
+		case "message_id":
+			out.MessageID = string(in.String())
+		case "message":
+			if in.IsNull() {
+				in.Skip()
+				out.Message = nil
+			} else {
+				if out.Message == nil {
+					out.Message = new(Message)
+				}
+				(*out.Message).UnmarshalEasyJSON(in)
+			}
+		case "user_id":
+			out.UserID = string(in.String())
+		case "user":
+			if in.IsNull() {
+				in.Skip()
+				out.User = nil
+			} else {
+				if out.User == nil {
+					out.User = new(User)
+				}
+				(*out.User).UnmarshalEasyJSON(in)
+			}
+		case "remind_at":
+			if in.IsNull() {
+				in.Skip()
+				out.RemindAt = nil
+			} else {
+				if out.RemindAt == nil {
+					out.RemindAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.RemindAt).UnmarshalJSON(data))
+				}
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2130(out *jwriter.Writer, in Reminder) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"channel_cid\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ChannelCID))
+	}
+	{
+		const prefix string = ",\"message_id\":"
+		out.RawString(prefix)
+		out.String(string(in.MessageID))
+	}
+	if in.Message != nil {
+		const prefix string = ",\"message\":"
+		out.RawString(prefix)
+		(*in.Message).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.String(string(in.UserID))
+	}
+	if in.User != nil {
+		const prefix string = ",\"user\":"
+		out.RawString(prefix)
+		(*in.User).MarshalEasyJSON(out)
+	}
+	if in.RemindAt != nil {
+		const prefix string = ",\"remind_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.RemindAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Reminder) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2130(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Reminder) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2130(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Reminder) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2130(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Reminder) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2130(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2131(in *jlexer.Lexer, out *ReactivateUserOptions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "restore_messages":
+			out.RestoreMessages = bool(in.Bool())
+		case "created_by_id":
+			out.CreatedByID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2131(out *jwriter.Writer, in ReactivateUserOptions) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.RestoreMessages {
+		const prefix string = ",\"restore_messages\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.RestoreMessages))
+	}
+	if in.CreatedByID != "" {
+		const prefix string = ",\"created_by_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.CreatedByID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReactivateUserOptions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2131(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReactivateUserOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2131(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReactivateUserOptions) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2131(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReactivateUserOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2131(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2132(in *jlexer.Lexer, out *ReactionsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "reactions":
+			if in.IsNull() {
+				in.Skip()
+				out.Reactions = nil
+			} else {
+				in.Delim('[')
+				if out.Reactions == nil {
+					if !in.IsDelim(']') {
+						out.Reactions = make([]*Reaction, 0, 8)
+					} else {
+						out.Reactions = []*Reaction{}
+					}
+				} else {
+					out.Reactions = (out.Reactions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v255 *Reaction
+					if in.IsNull() {
+						in.Skip()
+						v255 = nil
+					} else {
+						if v255 == nil {
+							v255 = new(Reaction)
+						}
+						(*v255).UnmarshalEasyJSON(in)
+					}
+					out.Reactions = append(out.Reactions, v255)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2132(out *jwriter.Writer, in ReactionsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"reactions\":"
+		out.RawString(prefix[1:])
+		if in.Reactions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v256, v257 := range in.Reactions {
+				if v256 > 0 {
+					out.RawByte(',')
+				}
+				if v257 == nil {
+					out.RawString("null")
+				} else {
+					(*v257).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReactionsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2132(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReactionsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2132(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReactionsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2132(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReactionsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2132(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2133(in *jlexer.Lexer, out *ReactionsOptions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Limit":
+			out.Limit = int(in.Int())
+		case "Offset":
+			out.Offset = int(in.Int())
+		case "Totals":
+			out.Totals = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2133(out *jwriter.Writer, in ReactionsOptions) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Limit\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Limit))
+	}
+	{
+		const prefix string = ",\"Offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.Offset))
+	}
+	{
+		const prefix string = ",\"Totals\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Totals))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReactionsOptions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2133(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReactionsOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2133(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReactionsOptions) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2133(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReactionsOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2133(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2134(in *jlexer.Lexer, out *Reaction) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	for key := range out.ExtraData {
+		delete(out.ExtraData, key)
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "message_id":
+			out.MessageID = string(in.String())
+		case "user_id":
+			out.UserID = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		case "score":
+			out.Score = int(in.Int())
+		default:
+			if out.ExtraData == nil {
+				out.ExtraData = make(map[string]interface{})
+			}
+			out.ExtraData[key] = in.Interface()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2134(out *jwriter.Writer, in Reaction) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"message_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.MessageID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.String(string(in.UserID))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	if in.Score != 0 {
+		const prefix string = ",\"score\":"
+		out.RawString(prefix)
+		out.Int(int(in.Score))
+	}
+	for k, v := range in.ExtraData {
+		switch k {
+		case "message_id", "user_id", "type", "score":
+			continue // don't allow field overwrites
+		}
+		out.RawByte(',')
+		out.String(string(k))
+		out.RawByte(':')
+		if m, ok := v.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := v.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(v))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Reaction) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2134(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Reaction) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2134(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Reaction) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2134(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Reaction) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2134(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2135(in *jlexer.Lexer, out *RateLimitInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "limit":
+			out.Limit = int64(in.Int64())
+		case "remaining":
+			out.Remaining = int64(in.Int64())
+		case "reset":
+			out.Reset = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2135(out *jwriter.Writer, in RateLimitInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"limit\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Limit))
+	}
+	{
+		const prefix string = ",\"remaining\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Remaining))
+	}
+	{
+		const prefix string = ",\"reset\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Reset))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RateLimitInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2135(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RateLimitInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2135(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RateLimitInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2135(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RateLimitInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2135(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2136(in *jlexer.Lexer, out *QueryThreadsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "threads":
+			if in.IsNull() {
+				in.Skip()
+				out.Threads = nil
+			} else {
+				in.Delim('[')
+				if out.Threads == nil {
+					if !in.IsDelim(']') {
+						out.Threads = make([]*Thread, 0, 8)
+					} else {
+						out.Threads = []*Thread{}
+					}
+				} else {
+					out.Threads = (out.Threads)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v258 *Thread
+					if in.IsNull() {
+						in.Skip()
+						v258 = nil
+					} else {
+						if v258 == nil {
+							v258 = new(Thread)
+						}
+						(*v258).UnmarshalEasyJSON(in)
+					}
+					out.Threads = append(out.Threads, v258)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "next":
+			out.Next = string(in.String())
+		case "prev":
+			out.Prev = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2136(out *jwriter.Writer, in QueryThreadsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"threads\":"
+		out.RawString(prefix[1:])
+		if in.Threads == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v259, v260 := range in.Threads {
+				if v259 > 0 {
+					out.RawByte(',')
+				}
+				if v260 == nil {
+					out.RawString("null")
+				} else {
+					(*v260).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"next\":"
+		out.RawString(prefix)
+		out.String(string(in.Next))
+	}
+	{
+		const prefix string = ",\"prev\":"
+		out.RawString(prefix)
+		out.String(string(in.Prev))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryThreadsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2136(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QueryThreadsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2136(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryThreadsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2136(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QueryThreadsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2136(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2137(in *jlexer.Lexer, out *QueryThreadsRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "filter":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Filter = make(map[string]interface{})
+				} else {
+					out.Filter = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v261 interface{}
+					if m, ok := v261.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v261.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v261 = in.Interface()
+					}
+					(out.Filter)[key] = v261
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "sort":
+			if in.IsNull() {
+				in.Skip()
+				out.Sort = nil
+			} else {
+				in.Delim('[')
+				if out.Sort == nil {
+					if !in.IsDelim(']') {
+						out.Sort = make([]*SortOption, 0, 8)
+					} else {
+						out.Sort = []*SortOption{}
+					}
+				} else {
+					out.Sort = (out.Sort)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v262 *SortOption
+					if in.IsNull() {
+						in.Skip()
+						v262 = nil
+					} else {
+						if v262 == nil {
+							v262 = new(SortOption)
+						}
+						(*v262).UnmarshalEasyJSON(in)
+					}
+					out.Sort = append(out.Sort, v262)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "limit":
+			out.Limit = int(in.Int())
+		case "next":
+			out.Next = string(in.String())
+		case "prev":
+			out.Prev = string(in.String())
+		case "reply_limit":
+			out.ReplyLimit = int(in.Int())
+		case "participant_limit":
+			out.ParticipantLimit = int(in.Int())
+		case "member_limit":
+			out.MemberLimit = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2137(out *jwriter.Writer, in QueryThreadsRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if len(in.Filter) != 0 {
+		const prefix string = ",\"filter\":"
+		first = false
+		out.RawString(prefix[1:])
+		{
+			out.RawByte('{')
+			v263First := true
+			for v263Name, v263Value := range in.Filter {
+				if v263First {
+					v263First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v263Name))
+				out.RawByte(':')
+				if m, ok := v263Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v263Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v263Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	if len(in.Sort) != 0 {
+		const prefix string = ",\"sort\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v264, v265 := range in.Sort {
+				if v264 > 0 {
+					out.RawByte(',')
+				}
+				if v265 == nil {
+					out.RawString("null")
+				} else {
+					(*v265).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Limit != 0 {
+		const prefix string = ",\"limit\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.Limit))
+	}
+	if in.Next != "" {
+		const prefix string = ",\"next\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Next))
+	}
+	if in.Prev != "" {
+		const prefix string = ",\"prev\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Prev))
+	}
+	if in.ReplyLimit != 0 {
+		const prefix string = ",\"reply_limit\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.ReplyLimit))
+	}
+	if in.ParticipantLimit != 0 {
+		const prefix string = ",\"participant_limit\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.ParticipantLimit))
+	}
+	if in.MemberLimit != 0 {
+		const prefix string = ",\"member_limit\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.MemberLimit))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryThreadsRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2137(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QueryThreadsRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2137(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryThreadsRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2137(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QueryThreadsRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2137(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2138(in *jlexer.Lexer, out *QuerySegmentsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "segments":
+			if in.IsNull() {
+				in.Skip()
+				out.Segments = nil
+			} else {
+				in.Delim('[')
+				if out.Segments == nil {
+					if !in.IsDelim(']') {
+						out.Segments = make([]*Segment, 0, 8)
+					} else {
+						out.Segments = []*Segment{}
+					}
+				} else {
+					out.Segments = (out.Segments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v266 *Segment
+					if in.IsNull() {
+						in.Skip()
+						v266 = nil
+					} else {
+						if v266 == nil {
+							v266 = new(Segment)
+						}
+						(*v266).UnmarshalEasyJSON(in)
+					}
+					out.Segments = append(out.Segments, v266)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "next":
+			out.Next = string(in.String())
+		case "prev":
+			out.Prev = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2138(out *jwriter.Writer, in QuerySegmentsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"segments\":"
+		out.RawString(prefix[1:])
+		if in.Segments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v267, v268 := range in.Segments {
+				if v267 > 0 {
+					out.RawByte(',')
+				}
+				if v268 == nil {
+					out.RawString("null")
+				} else {
+					(*v268).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"next\":"
+		out.RawString(prefix)
+		out.String(string(in.Next))
+	}
+	{
+		const prefix string = ",\"prev\":"
+		out.RawString(prefix)
+		out.String(string(in.Prev))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QuerySegmentsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2138(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QuerySegmentsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2138(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QuerySegmentsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2138(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QuerySegmentsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2138(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2139(in *jlexer.Lexer, out *QuerySegmentsRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "filter":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Filter = make(map[string]interface{})
+				} else {
+					out.Filter = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v269 interface{}
+					if m, ok := v269.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v269.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v269 = in.Interface()
+					}
+					(out.Filter)[key] = v269
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "sort":
+			if in.IsNull() {
+				in.Skip()
+				out.Sort = nil
+			} else {
+				in.Delim('[')
+				if out.Sort == nil {
+					if !in.IsDelim(']') {
+						out.Sort = make([]*SortOption, 0, 8)
+					} else {
+						out.Sort = []*SortOption{}
+					}
+				} else {
+					out.Sort = (out.Sort)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v270 *SortOption
+					if in.IsNull() {
+						in.Skip()
+						v270 = nil
+					} else {
+						if v270 == nil {
+							v270 = new(SortOption)
+						}
+						(*v270).UnmarshalEasyJSON(in)
+					}
+					out.Sort = append(out.Sort, v270)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "limit":
+			out.Limit = int(in.Int())
+		case "next":
+			out.Next = string(in.String())
+		case "prev":
+			out.Prev = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2139(out *jwriter.Writer, in QuerySegmentsRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"filter\":"
+		out.RawString(prefix[1:])
+		if in.Filter == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v271First := true
+			for v271Name, v271Value := range in.Filter {
+				if v271First {
+					v271First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v271Name))
+				out.RawByte(':')
+				if m, ok := v271Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v271Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v271Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	if len(in.Sort) != 0 {
+		const prefix string = ",\"sort\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v272, v273 := range in.Sort {
+				if v272 > 0 {
+					out.RawByte(',')
+				}
+				if v273 == nil {
+					out.RawString("null")
+				} else {
+					(*v273).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Limit != 0 {
+		const prefix string = ",\"limit\":"
+		out.RawString(prefix)
+		out.Int(int(in.Limit))
+	}
+	if in.Next != "" {
+		const prefix string = ",\"next\":"
+		out.RawString(prefix)
+		out.String(string(in.Next))
+	}
+	if in.Prev != "" {
+		const prefix string = ",\"prev\":"
+		out.RawString(prefix)
+		out.String(string(in.Prev))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QuerySegmentsRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2139(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QuerySegmentsRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2139(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QuerySegmentsRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2139(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QuerySegmentsRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2139(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2140(in *jlexer.Lexer, out *QueryReviewQueueResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "items":
+			if in.IsNull() {
+				in.Skip()
+				out.Items = nil
+			} else {
+				in.Delim('[')
+				if out.Items == nil {
+					if !in.IsDelim(']') {
+						out.Items = make([]*ReviewQueueItem, 0, 8)
+					} else {
+						out.Items = []*ReviewQueueItem{}
+					}
+				} else {
+					out.Items = (out.Items)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v274 *ReviewQueueItem
+					if in.IsNull() {
+						in.Skip()
+						v274 = nil
+					} else {
+						if v274 == nil {
+							v274 = new(ReviewQueueItem)
+						}
+						(*v274).UnmarshalEasyJSON(in)
+					}
+					out.Items = append(out.Items, v274)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "next":
+			out.Next = string(in.String())
+		case "prev":
+			out.Prev = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2140(out *jwriter.Writer, in QueryReviewQueueResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"items\":"
+		out.RawString(prefix[1:])
+		if in.Items == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v275, v276 := range in.Items {
+				if v275 > 0 {
+					out.RawByte(',')
+				}
+				if v276 == nil {
+					out.RawString("null")
+				} else {
+					(*v276).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"next\":"
+		out.RawString(prefix)
+		out.String(string(in.Next))
+	}
+	{
+		const prefix string = ",\"prev\":"
+		out.RawString(prefix)
+		out.String(string(in.Prev))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryReviewQueueResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2140(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QueryReviewQueueResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2140(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryReviewQueueResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2140(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QueryReviewQueueResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2140(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2141(in *jlexer.Lexer, out *QueryReviewQueueRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "filter":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Filter = make(map[string]interface{})
+				} else {
+					out.Filter = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v277 interface{}
+					if m, ok := v277.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v277.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v277 = in.Interface()
+					}
+					(out.Filter)[key] = v277
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "sort":
+			if in.IsNull() {
+				in.Skip()
+				out.Sort = nil
+			} else {
+				in.Delim('[')
+				if out.Sort == nil {
+					if !in.IsDelim(']') {
+						out.Sort = make([]*SortOption, 0, 8)
+					} else {
+						out.Sort = []*SortOption{}
+					}
+				} else {
+					out.Sort = (out.Sort)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v278 *SortOption
+					if in.IsNull() {
+						in.Skip()
+						v278 = nil
+					} else {
+						if v278 == nil {
+							v278 = new(SortOption)
+						}
+						(*v278).UnmarshalEasyJSON(in)
+					}
+					out.Sort = append(out.Sort, v278)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "limit":
+			out.Limit = int(in.Int())
+		case "next":
+			out.Next = string(in.String())
+		case "prev":
+			out.Prev = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2141(out *jwriter.Writer, in QueryReviewQueueRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"filter\":"
+		out.RawString(prefix[1:])
+		if in.Filter == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v279First := true
+			for v279Name, v279Value := range in.Filter {
+				if v279First {
+					v279First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v279Name))
+				out.RawByte(':')
+				if m, ok := v279Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v279Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v279Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	if len(in.Sort) != 0 {
+		const prefix string = ",\"sort\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v280, v281 := range in.Sort {
+				if v280 > 0 {
+					out.RawByte(',')
+				}
+				if v281 == nil {
+					out.RawString("null")
+				} else {
+					(*v281).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Limit != 0 {
+		const prefix string = ",\"limit\":"
+		out.RawString(prefix)
+		out.Int(int(in.Limit))
+	}
+	if in.Next != "" {
+		const prefix string = ",\"next\":"
+		out.RawString(prefix)
+		out.String(string(in.Next))
+	}
+	if in.Prev != "" {
+		const prefix string = ",\"prev\":"
+		out.RawString(prefix)
+		out.String(string(in.Prev))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryReviewQueueRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2141(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QueryReviewQueueRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2141(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryReviewQueueRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2141(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QueryReviewQueueRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2141(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2142(in *jlexer.Lexer, out *QueryRemindersResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "reminders":
+			if in.IsNull() {
+				in.Skip()
+				out.Reminders = nil
+			} else {
+				in.Delim('[')
+				if out.Reminders == nil {
+					if !in.IsDelim(']') {
+						out.Reminders = make([]*Reminder, 0, 8)
+					} else {
+						out.Reminders = []*Reminder{}
+					}
+				} else {
+					out.Reminders = (out.Reminders)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v282 *Reminder
+					if in.IsNull() {
+						in.Skip()
+						v282 = nil
+					} else {
+						if v282 == nil {
+							v282 = new(Reminder)
+						}
+						(*v282).UnmarshalEasyJSON(in)
+					}
+					out.Reminders = append(out.Reminders, v282)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "next":
+			out.Next = string(in.String())
+		case "prev":
+			out.Prev = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2142(out *jwriter.Writer, in QueryRemindersResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"reminders\":"
+		out.RawString(prefix[1:])
+		if in.Reminders == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v283, v284 := range in.Reminders {
+				if v283 > 0 {
+					out.RawByte(',')
+				}
+				if v284 == nil {
+					out.RawString("null")
+				} else {
+					(*v284).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"next\":"
+		out.RawString(prefix)
+		out.String(string(in.Next))
+	}
+	{
+		const prefix string = ",\"prev\":"
+		out.RawString(prefix)
+		out.String(string(in.Prev))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryRemindersResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2142(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QueryRemindersResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2142(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryRemindersResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2142(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QueryRemindersResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2142(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2143(in *jlexer.Lexer, out *QueryRemindersRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = string(in.String())
+		case "filter":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Filter = make(map[string]interface{})
+				} else {
+					out.Filter = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v285 interface{}
+					if m, ok := v285.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v285.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v285 = in.Interface()
+					}
+					(out.Filter)[key] = v285
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "sort":
+			if in.IsNull() {
+				in.Skip()
+				out.Sort = nil
+			} else {
+				in.Delim('[')
+				if out.Sort == nil {
+					if !in.IsDelim(']') {
+						out.Sort = make([]*SortOption, 0, 8)
+					} else {
+						out.Sort = []*SortOption{}
+					}
+				} else {
+					out.Sort = (out.Sort)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v286 *SortOption
+					if in.IsNull() {
+						in.Skip()
+						v286 = nil
+					} else {
+						if v286 == nil {
+							v286 = new(SortOption)
+						}
+						(*v286).UnmarshalEasyJSON(in)
+					}
+					out.Sort = append(out.Sort, v286)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "limit":
+			out.Limit = int(in.Int())
+		case "next":
+			out.Next = string(in.String())
+		case "prev":
+			out.Prev = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2143(out *jwriter.Writer, in QueryRemindersRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.UserID))
+	}
+	{
+		const prefix string = ",\"filter\":"
+		out.RawString(prefix)
+		if in.Filter == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v287First := true
+			for v287Name, v287Value := range in.Filter {
+				if v287First {
+					v287First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v287Name))
+				out.RawByte(':')
+				if m, ok := v287Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v287Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v287Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	if len(in.Sort) != 0 {
+		const prefix string = ",\"sort\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v288, v289 := range in.Sort {
+				if v288 > 0 {
+					out.RawByte(',')
+				}
+				if v289 == nil {
+					out.RawString("null")
+				} else {
+					(*v289).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Limit != 0 {
+		const prefix string = ",\"limit\":"
+		out.RawString(prefix)
+		out.Int(int(in.Limit))
+	}
+	if in.Next != "" {
+		const prefix string = ",\"next\":"
+		out.RawString(prefix)
+		out.String(string(in.Next))
+	}
+	if in.Prev != "" {
+		const prefix string = ",\"prev\":"
+		out.RawString(prefix)
+		out.String(string(in.Prev))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryRemindersRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2143(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QueryRemindersRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2143(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryRemindersRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2143(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QueryRemindersRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2143(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2144(in *jlexer.Lexer, out *QueryPollsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "polls":
+			if in.IsNull() {
+				in.Skip()
+				out.Polls = nil
+			} else {
+				in.Delim('[')
+				if out.Polls == nil {
+					if !in.IsDelim(']') {
+						out.Polls = make([]*Poll, 0, 8)
+					} else {
+						out.Polls = []*Poll{}
+					}
+				} else {
+					out.Polls = (out.Polls)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v290 *Poll
+					if in.IsNull() {
+						in.Skip()
+						v290 = nil
+					} else {
+						if v290 == nil {
+							v290 = new(Poll)
+						}
+						(*v290).UnmarshalEasyJSON(in)
+					}
+					out.Polls = append(out.Polls, v290)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "next":
+			out.Next = string(in.String())
+		case "prev":
+			out.Prev = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2144(out *jwriter.Writer, in QueryPollsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"polls\":"
+		out.RawString(prefix[1:])
+		if in.Polls == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v291, v292 := range in.Polls {
+				if v291 > 0 {
+					out.RawByte(',')
+				}
+				if v292 == nil {
+					out.RawString("null")
+				} else {
+					(*v292).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"next\":"
+		out.RawString(prefix)
+		out.String(string(in.Next))
+	}
+	{
+		const prefix string = ",\"prev\":"
+		out.RawString(prefix)
+		out.String(string(in.Prev))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryPollsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2144(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QueryPollsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2144(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryPollsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2144(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QueryPollsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2144(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2145(in *jlexer.Lexer, out *QueryPollsRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "filter":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Filter = make(map[string]interface{})
+				} else {
+					out.Filter = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v293 interface{}
+					if m, ok := v293.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v293.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v293 = in.Interface()
+					}
+					(out.Filter)[key] = v293
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "sort":
+			if in.IsNull() {
+				in.Skip()
+				out.Sort = nil
+			} else {
+				in.Delim('[')
+				if out.Sort == nil {
+					if !in.IsDelim(']') {
+						out.Sort = make([]*SortOption, 0, 8)
+					} else {
+						out.Sort = []*SortOption{}
+					}
+				} else {
+					out.Sort = (out.Sort)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v294 *SortOption
+					if in.IsNull() {
+						in.Skip()
+						v294 = nil
+					} else {
+						if v294 == nil {
+							v294 = new(SortOption)
+						}
+						(*v294).UnmarshalEasyJSON(in)
+					}
+					out.Sort = append(out.Sort, v294)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "limit":
+			out.Limit = int(in.Int())
+		case "next":
+			out.Next = string(in.String())
+		case "prev":
+			out.Prev = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2145(out *jwriter.Writer, in QueryPollsRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"filter\":"
+		out.RawString(prefix[1:])
+		if in.Filter == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v295First := true
+			for v295Name, v295Value := range in.Filter {
+				if v295First {
+					v295First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v295Name))
+				out.RawByte(':')
+				if m, ok := v295Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v295Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v295Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	if len(in.Sort) != 0 {
+		const prefix string = ",\"sort\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v296, v297 := range in.Sort {
+				if v296 > 0 {
+					out.RawByte(',')
+				}
+				if v297 == nil {
+					out.RawString("null")
+				} else {
+					(*v297).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Limit != 0 {
+		const prefix string = ",\"limit\":"
+		out.RawString(prefix)
+		out.Int(int(in.Limit))
+	}
+	if in.Next != "" {
+		const prefix string = ",\"next\":"
+		out.RawString(prefix)
+		out.String(string(in.Next))
+	}
+	if in.Prev != "" {
+		const prefix string = ",\"prev\":"
+		out.RawString(prefix)
+		out.String(string(in.Prev))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryPollsRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2145(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QueryPollsRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2145(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryPollsRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2145(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QueryPollsRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2145(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2146(in *jlexer.Lexer, out *QueryOption) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	for key := range out.Filter {
+		delete(out.Filter, key)
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "limit":
+			out.Limit = int(in.Int())
+		case "offset":
+			out.Offset = int(in.Int())
+		default:
+			if out.Filter == nil {
+				out.Filter = make(map[string]interface{})
+			}
+			out.Filter[key] = in.Interface()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2146(out *jwriter.Writer, in QueryOption) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Limit != 0 {
+		const prefix string = ",\"limit\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Int(int(in.Limit))
+	}
+	if in.Offset != 0 {
+		const prefix string = ",\"offset\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.Offset))
+	}
+	for k, v := range in.Filter {
+		switch k {
+		case "limit", "offset":
+			continue // don't allow field overwrites
+		}
+		if first {
+			first = false
+		} else {
+			out.RawByte(',')
+		}
+		out.String(string(k))
+		out.RawByte(':')
+		if m, ok := v.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := v.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(v))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryOption) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2146(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QueryOption) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2146(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryOption) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2146(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QueryOption) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2146(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2147(in *jlexer.Lexer, out *QueryModerationConfigsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "configs":
+			if in.IsNull() {
+				in.Skip()
+				out.Configs = nil
+			} else {
+				in.Delim('[')
+				if out.Configs == nil {
+					if !in.IsDelim(']') {
+						out.Configs = make([]*ModerationConfig, 0, 8)
+					} else {
+						out.Configs = []*ModerationConfig{}
+					}
+				} else {
+					out.Configs = (out.Configs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v298 *ModerationConfig
+					if in.IsNull() {
+						in.Skip()
+						v298 = nil
+					} else {
+						if v298 == nil {
+							v298 = new(ModerationConfig)
+						}
+						(*v298).UnmarshalEasyJSON(in)
+					}
+					out.Configs = append(out.Configs, v298)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "next":
+			out.Next = string(in.String())
+		case "prev":
+			out.Prev = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2147(out *jwriter.Writer, in QueryModerationConfigsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"configs\":"
+		out.RawString(prefix[1:])
+		if in.Configs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v299, v300 := range in.Configs {
+				if v299 > 0 {
+					out.RawByte(',')
+				}
+				if v300 == nil {
+					out.RawString("null")
+				} else {
+					(*v300).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"next\":"
+		out.RawString(prefix)
+		out.String(string(in.Next))
+	}
+	{
+		const prefix string = ",\"prev\":"
+		out.RawString(prefix)
+		out.String(string(in.Prev))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryModerationConfigsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2147(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QueryModerationConfigsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2147(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryModerationConfigsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2147(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QueryModerationConfigsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2147(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2148(in *jlexer.Lexer, out *QueryModerationConfigsRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "filter":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Filter = make(map[string]interface{})
+				} else {
+					out.Filter = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v301 interface{}
+					if m, ok := v301.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v301.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v301 = in.Interface()
+					}
+					(out.Filter)[key] = v301
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "sort":
+			if in.IsNull() {
+				in.Skip()
+				out.Sort = nil
+			} else {
+				in.Delim('[')
+				if out.Sort == nil {
+					if !in.IsDelim(']') {
+						out.Sort = make([]*SortOption, 0, 8)
+					} else {
+						out.Sort = []*SortOption{}
+					}
+				} else {
+					out.Sort = (out.Sort)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v302 *SortOption
+					if in.IsNull() {
+						in.Skip()
+						v302 = nil
+					} else {
+						if v302 == nil {
+							v302 = new(SortOption)
+						}
+						(*v302).UnmarshalEasyJSON(in)
+					}
+					out.Sort = append(out.Sort, v302)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "limit":
+			out.Limit = int(in.Int())
+		case "next":
+			out.Next = string(in.String())
+		case "prev":
+			out.Prev = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2148(out *jwriter.Writer, in QueryModerationConfigsRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"filter\":"
+		out.RawString(prefix[1:])
+		if in.Filter == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v303First := true
+			for v303Name, v303Value := range in.Filter {
+				if v303First {
+					v303First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v303Name))
+				out.RawByte(':')
+				if m, ok := v303Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v303Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v303Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	if len(in.Sort) != 0 {
+		const prefix string = ",\"sort\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v304, v305 := range in.Sort {
+				if v304 > 0 {
+					out.RawByte(',')
+				}
+				if v305 == nil {
+					out.RawString("null")
+				} else {
+					(*v305).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Limit != 0 {
+		const prefix string = ",\"limit\":"
+		out.RawString(prefix)
+		out.Int(int(in.Limit))
+	}
+	if in.Next != "" {
+		const prefix string = ",\"next\":"
+		out.RawString(prefix)
+		out.String(string(in.Next))
+	}
+	if in.Prev != "" {
+		const prefix string = ",\"prev\":"
+		out.RawString(prefix)
+		out.String(string(in.Prev))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryModerationConfigsRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2148(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QueryModerationConfigsRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2148(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryModerationConfigsRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2148(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QueryModerationConfigsRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2148(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2149(in *jlexer.Lexer, out *QueryMessageHistoryResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "message_history":
+			if in.IsNull() {
+				in.Skip()
+				out.MessageHistory = nil
+			} else {
+				in.Delim('[')
+				if out.MessageHistory == nil {
+					if !in.IsDelim(']') {
+						out.MessageHistory = make([]*MessageHistoryEntry, 0, 8)
+					} else {
+						out.MessageHistory = []*MessageHistoryEntry{}
+					}
+				} else {
+					out.MessageHistory = (out.MessageHistory)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v306 *MessageHistoryEntry
+					if in.IsNull() {
+						in.Skip()
+						v306 = nil
+					} else {
+						if v306 == nil {
+							v306 = new(MessageHistoryEntry)
+						}
+						(*v306).UnmarshalEasyJSON(in)
+					}
+					out.MessageHistory = append(out.MessageHistory, v306)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "next":
+			out.Next = string(in.String())
+		case "prev":
+			out.Prev = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2149(out *jwriter.Writer, in QueryMessageHistoryResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"message_history\":"
+		out.RawString(prefix[1:])
+		if in.MessageHistory == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v307, v308 := range in.MessageHistory {
+				if v307 > 0 {
+					out.RawByte(',')
+				}
+				if v308 == nil {
+					out.RawString("null")
+				} else {
+					(*v308).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"next\":"
+		out.RawString(prefix)
+		out.String(string(in.Next))
+	}
+	{
+		const prefix string = ",\"prev\":"
+		out.RawString(prefix)
+		out.String(string(in.Prev))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryMessageHistoryResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2149(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QueryMessageHistoryResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2149(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryMessageHistoryResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2149(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QueryMessageHistoryResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2149(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2150(in *jlexer.Lexer, out *QueryMessageHistoryRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "filter":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Filter = make(map[string]interface{})
+				} else {
+					out.Filter = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v309 interface{}
+					if m, ok := v309.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v309.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v309 = in.Interface()
+					}
+					(out.Filter)[key] = v309
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "sort":
+			if in.IsNull() {
+				in.Skip()
+				out.Sort = nil
+			} else {
+				in.Delim('[')
+				if out.Sort == nil {
+					if !in.IsDelim(']') {
+						out.Sort = make([]*SortOption, 0, 8)
+					} else {
+						out.Sort = []*SortOption{}
+					}
+				} else {
+					out.Sort = (out.Sort)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v310 *SortOption
+					if in.IsNull() {
+						in.Skip()
+						v310 = nil
+					} else {
+						if v310 == nil {
+							v310 = new(SortOption)
+						}
+						(*v310).UnmarshalEasyJSON(in)
+					}
+					out.Sort = append(out.Sort, v310)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "limit":
+			out.Limit = int(in.Int())
+		case "next":
+			out.Next = string(in.String())
+		case "prev":
+			out.Prev = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2150(out *jwriter.Writer, in QueryMessageHistoryRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"filter\":"
+		out.RawString(prefix[1:])
+		if in.Filter == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v311First := true
+			for v311Name, v311Value := range in.Filter {
+				if v311First {
+					v311First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v311Name))
+				out.RawByte(':')
+				if m, ok := v311Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v311Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v311Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	if len(in.Sort) != 0 {
+		const prefix string = ",\"sort\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v312, v313 := range in.Sort {
+				if v312 > 0 {
+					out.RawByte(',')
+				}
+				if v313 == nil {
+					out.RawString("null")
+				} else {
+					(*v313).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Limit != 0 {
+		const prefix string = ",\"limit\":"
+		out.RawString(prefix)
+		out.Int(int(in.Limit))
+	}
+	if in.Next != "" {
+		const prefix string = ",\"next\":"
+		out.RawString(prefix)
+		out.String(string(in.Next))
+	}
+	if in.Prev != "" {
+		const prefix string = ",\"prev\":"
+		out.RawString(prefix)
+		out.String(string(in.Prev))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryMessageHistoryRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2150(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QueryMessageHistoryRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2150(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryMessageHistoryRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2150(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QueryMessageHistoryRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2150(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2151(in *jlexer.Lexer, out *QueryFlagReportsRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "filter_conditions":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Filter = make(map[string]interface{})
+				} else {
+					out.Filter = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v314 interface{}
+					if m, ok := v314.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v314.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v314 = in.Interface()
+					}
+					(out.Filter)[key] = v314
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "limit":
+			out.Limit = int(in.Int())
+		case "offset":
+			out.Offset = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2151(out *jwriter.Writer, in QueryFlagReportsRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"filter_conditions\":"
+		out.RawString(prefix[1:])
+		if in.Filter == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v315First := true
+			for v315Name, v315Value := range in.Filter {
+				if v315First {
+					v315First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v315Name))
+				out.RawByte(':')
+				if m, ok := v315Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v315Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v315Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	if in.Limit != 0 {
+		const prefix string = ",\"limit\":"
+		out.RawString(prefix)
+		out.Int(int(in.Limit))
+	}
+	if in.Offset != 0 {
+		const prefix string = ",\"offset\":"
+		out.RawString(prefix)
+		out.Int(int(in.Offset))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryFlagReportsRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2151(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QueryFlagReportsRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2151(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryFlagReportsRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2151(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QueryFlagReportsRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2151(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2152(in *jlexer.Lexer, out *QueryCampaignsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "campaigns":
+			if in.IsNull() {
+				in.Skip()
+				out.Campaigns = nil
+			} else {
+				in.Delim('[')
+				if out.Campaigns == nil {
+					if !in.IsDelim(']') {
+						out.Campaigns = make([]*Campaign, 0, 8)
+					} else {
+						out.Campaigns = []*Campaign{}
+					}
+				} else {
+					out.Campaigns = (out.Campaigns)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v316 *Campaign
+					if in.IsNull() {
+						in.Skip()
+						v316 = nil
+					} else {
+						if v316 == nil {
+							v316 = new(Campaign)
+						}
+						(*v316).UnmarshalEasyJSON(in)
+					}
+					out.Campaigns = append(out.Campaigns, v316)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "next":
+			out.Next = string(in.String())
+		case "prev":
+			out.Prev = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2152(out *jwriter.Writer, in QueryCampaignsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"campaigns\":"
+		out.RawString(prefix[1:])
+		if in.Campaigns == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v317, v318 := range in.Campaigns {
+				if v317 > 0 {
+					out.RawByte(',')
+				}
+				if v318 == nil {
+					out.RawString("null")
+				} else {
+					(*v318).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"next\":"
+		out.RawString(prefix)
+		out.String(string(in.Next))
+	}
+	{
+		const prefix string = ",\"prev\":"
+		out.RawString(prefix)
+		out.String(string(in.Prev))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryCampaignsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2152(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QueryCampaignsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2152(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryCampaignsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2152(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QueryCampaignsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2152(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2153(in *jlexer.Lexer, out *QueryCampaignsRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "filter":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Filter = make(map[string]interface{})
+				} else {
+					out.Filter = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v319 interface{}
+					if m, ok := v319.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v319.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v319 = in.Interface()
+					}
+					(out.Filter)[key] = v319
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "sort":
+			if in.IsNull() {
+				in.Skip()
+				out.Sort = nil
+			} else {
+				in.Delim('[')
+				if out.Sort == nil {
+					if !in.IsDelim(']') {
+						out.Sort = make([]*SortOption, 0, 8)
+					} else {
+						out.Sort = []*SortOption{}
+					}
+				} else {
+					out.Sort = (out.Sort)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v320 *SortOption
+					if in.IsNull() {
+						in.Skip()
+						v320 = nil
+					} else {
+						if v320 == nil {
+							v320 = new(SortOption)
+						}
+						(*v320).UnmarshalEasyJSON(in)
+					}
+					out.Sort = append(out.Sort, v320)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "limit":
+			out.Limit = int(in.Int())
+		case "next":
+			out.Next = string(in.String())
+		case "prev":
+			out.Prev = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2153(out *jwriter.Writer, in QueryCampaignsRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"filter\":"
+		out.RawString(prefix[1:])
+		if in.Filter == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v321First := true
+			for v321Name, v321Value := range in.Filter {
+				if v321First {
+					v321First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v321Name))
+				out.RawByte(':')
+				if m, ok := v321Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v321Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v321Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	if len(in.Sort) != 0 {
+		const prefix string = ",\"sort\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v322, v323 := range in.Sort {
+				if v322 > 0 {
+					out.RawByte(',')
+				}
+				if v323 == nil {
+					out.RawString("null")
+				} else {
+					(*v323).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Limit != 0 {
+		const prefix string = ",\"limit\":"
+		out.RawString(prefix)
+		out.Int(int(in.Limit))
+	}
+	if in.Next != "" {
+		const prefix string = ",\"next\":"
+		out.RawString(prefix)
+		out.String(string(in.Next))
+	}
+	if in.Prev != "" {
+		const prefix string = ",\"prev\":"
+		out.RawString(prefix)
+		out.String(string(in.Prev))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryCampaignsRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2153(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QueryCampaignsRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2153(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryCampaignsRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2153(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QueryCampaignsRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2153(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2154(in *jlexer.Lexer, out *PushProvider) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "disabled_at":
+			if in.IsNull() {
+				in.Skip()
+				out.DisabledAt = nil
+			} else {
+				if out.DisabledAt == nil {
+					out.DisabledAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.DisabledAt).UnmarshalJSON(data))
+				}
+			}
+		case "disabled_reason":
+			out.DisabledReason = string(in.String())
+		case "created_at":
+			if in.IsNull() {
+				in.Skip()
+				out.CreatedAt = nil
+			} else {
+				if out.CreatedAt == nil {
+					out.CreatedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CreatedAt).UnmarshalJSON(data))
+				}
+			}
+		case "updated_at":
+			if in.IsNull() {
+				in.Skip()
+				out.UpdatedAt = nil
+			} else {
+				if out.UpdatedAt == nil {
+					out.UpdatedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.UpdatedAt).UnmarshalJSON(data))
+				}
+			}
+		case "xiaomi_package_name":
+			out.XiaomiPackageName = string(in.String())
+		case "xiaomi_app_secret":
+			out.XiaomiAppSecret = string(in.String())
+		case "huawei_app_id":
+			out.HuaweiAppID = string(in.String())
+		case "huawei_app_secret":
+			out.HuaweiAppSecret = string(in.String())
+		case "firebase_credentials":
+			out.FirebaseCredentials = string(in.String())
+		case "firebase_notification_template":
+			out.FirebaseNotificationTemplate = string(in.String())
+		case "firebase_apn_template":
+			out.FirebaseAPNTemplate = string(in.String())
+		case "firebase_data_template":
+			out.FirebaseDataTemplate = string(in.String())
+		case "apn_auth_type":
+			out.APNAuthType = string(in.String())
+		case "apn_auth_key":
+			out.APNAuthKey = string(in.String())
+		case "apn_key_id":
+			out.APNKeyID = string(in.String())
+		case "apn_team_id":
+			out.APNTeamID = string(in.String())
+		case "apn_topic":
+			out.APNTopic = string(in.String())
+		case "apn_p12_cert":
+			out.APNP12Cert = string(in.String())
+		case "apn_development":
+			out.APNDevelopment = bool(in.Bool())
+		case "apn_notification_template":
+			out.APNNotificationTemplate = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2154(out *jwriter.Writer, in PushProvider) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	if in.Description != "" {
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	if in.DisabledAt != nil {
+		const prefix string = ",\"disabled_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.DisabledAt).MarshalJSON())
+	}
+	if in.DisabledReason != "" {
+		const prefix string = ",\"disabled_reason\":"
+		out.RawString(prefix)
+		out.String(string(in.DisabledReason))
+	}
+	if in.CreatedAt != nil {
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.CreatedAt).MarshalJSON())
+	}
+	if in.UpdatedAt != nil {
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.UpdatedAt).MarshalJSON())
+	}
+	if in.XiaomiPackageName != "" {
+		const prefix string = ",\"xiaomi_package_name\":"
+		out.RawString(prefix)
+		out.String(string(in.XiaomiPackageName))
+	}
+	if in.XiaomiAppSecret != "" {
+		const prefix string = ",\"xiaomi_app_secret\":"
+		out.RawString(prefix)
+		out.String(string(in.XiaomiAppSecret))
+	}
+	if in.HuaweiAppID != "" {
+		const prefix string = ",\"huawei_app_id\":"
+		out.RawString(prefix)
+		out.String(string(in.HuaweiAppID))
+	}
+	if in.HuaweiAppSecret != "" {
+		const prefix string = ",\"huawei_app_secret\":"
+		out.RawString(prefix)
+		out.String(string(in.HuaweiAppSecret))
+	}
+	if in.FirebaseCredentials != "" {
+		const prefix string = ",\"firebase_credentials\":"
+		out.RawString(prefix)
+		out.String(string(in.FirebaseCredentials))
+	}
+	if in.FirebaseNotificationTemplate != "" {
+		const prefix string = ",\"firebase_notification_template\":"
+		out.RawString(prefix)
+		out.String(string(in.FirebaseNotificationTemplate))
+	}
+	if in.FirebaseAPNTemplate != "" {
+		const prefix string = ",\"firebase_apn_template\":"
+		out.RawString(prefix)
+		out.String(string(in.FirebaseAPNTemplate))
+	}
+	if in.FirebaseDataTemplate != "" {
+		const prefix string = ",\"firebase_data_template\":"
+		out.RawString(prefix)
+		out.String(string(in.FirebaseDataTemplate))
+	}
+	if in.APNAuthType != "" {
+		const prefix string = ",\"apn_auth_type\":"
+		out.RawString(prefix)
+		out.String(string(in.APNAuthType))
+	}
+	if in.APNAuthKey != "" {
+		const prefix string = ",\"apn_auth_key\":"
+		out.RawString(prefix)
+		out.String(string(in.APNAuthKey))
+	}
+	if in.APNKeyID != "" {
+		const prefix string = ",\"apn_key_id\":"
+		out.RawString(prefix)
+		out.String(string(in.APNKeyID))
+	}
+	if in.APNTeamID != "" {
+		const prefix string = ",\"apn_team_id\":"
+		out.RawString(prefix)
+		out.String(string(in.APNTeamID))
+	}
+	if in.APNTopic != "" {
+		const prefix string = ",\"apn_topic\":"
+		out.RawString(prefix)
+		out.String(string(in.APNTopic))
+	}
+	if in.APNP12Cert != "" {
+		const prefix string = ",\"apn_p12_cert\":"
+		out.RawString(prefix)
+		out.String(string(in.APNP12Cert))
+	}
+	if in.APNDevelopment {
+		const prefix string = ",\"apn_development\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.APNDevelopment))
+	}
+	if in.APNNotificationTemplate != "" {
+		const prefix string = ",\"apn_notification_template\":"
+		out.RawString(prefix)
+		out.String(string(in.APNNotificationTemplate))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PushProvider) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2154(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PushProvider) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2154(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PushProvider) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2154(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PushProvider) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2154(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2155(in *jlexer.Lexer, out *PushPreferences) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "chat_level":
+			out.ChatLevel = PushLevel(in.String())
+		case "disabled_until":
+			if in.IsNull() {
+				in.Skip()
+				out.DisabledUntil = nil
+			} else {
+				if out.DisabledUntil == nil {
+					out.DisabledUntil = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.DisabledUntil).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2155(out *jwriter.Writer, in PushPreferences) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.ChatLevel != "" {
+		const prefix string = ",\"chat_level\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.ChatLevel))
+	}
+	if in.DisabledUntil != nil {
+		const prefix string = ",\"disabled_until\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.DisabledUntil).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PushPreferences) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2155(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PushPreferences) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2155(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PushPreferences) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2155(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PushPreferences) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2155(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2156(in *jlexer.Lexer, out *PushPreferenceInput) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = string(in.String())
+		case "channel_cid":
+			out.ChannelCID = string(in.String())
+		case "chat_level":
+			out.ChatLevel = PushLevel(in.String())
+		case "disabled_until":
+			if in.IsNull() {
+				in.Skip()
+				out.DisabledUntil = nil
+			} else {
+				if out.DisabledUntil == nil {
+					out.DisabledUntil = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.DisabledUntil).UnmarshalJSON(data))
+				}
+			}
+		case "remove_disable":
+			out.RemoveDisable = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2156(out *jwriter.Writer, in PushPreferenceInput) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.UserID))
+	}
+	if in.ChannelCID != "" {
+		const prefix string = ",\"channel_cid\":"
+		out.RawString(prefix)
+		out.String(string(in.ChannelCID))
+	}
+	if in.ChatLevel != "" {
+		const prefix string = ",\"chat_level\":"
+		out.RawString(prefix)
+		out.String(string(in.ChatLevel))
+	}
+	if in.DisabledUntil != nil {
+		const prefix string = ",\"disabled_until\":"
+		out.RawString(prefix)
+		out.Raw((*in.DisabledUntil).MarshalJSON())
+	}
+	if in.RemoveDisable {
+		const prefix string = ",\"remove_disable\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.RemoveDisable))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PushPreferenceInput) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2156(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PushPreferenceInput) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2156(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PushPreferenceInput) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2156(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PushPreferenceInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2156(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2157(in *jlexer.Lexer, out *PushNotificationFields) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "apn":
+			(out.APNConfig).UnmarshalEasyJSON(in)
+		case "firebase":
+			(out.FirebaseConfig).UnmarshalEasyJSON(in)
+		case "huawei":
+			(out.HuaweiConfig).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2157(out *jwriter.Writer, in PushNotificationFields) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"apn\":"
+		out.RawString(prefix[1:])
+		(in.APNConfig).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"firebase\":"
+		out.RawString(prefix)
+		(in.FirebaseConfig).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"huawei\":"
+		out.RawString(prefix)
+		(in.HuaweiConfig).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PushNotificationFields) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2157(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PushNotificationFields) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2157(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PushNotificationFields) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2157(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PushNotificationFields) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2157(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2158(in *jlexer.Lexer, out *PollVoteRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "option_id":
+			out.OptionID = string(in.String())
+		case "answer_text":
+			out.AnswerText = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2158(out *jwriter.Writer, in PollVoteRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.OptionID != "" {
+		const prefix string = ",\"option_id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.OptionID))
+	}
+	if in.AnswerText != "" {
+		const prefix string = ",\"answer_text\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.AnswerText))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PollVoteRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2158(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PollVoteRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2158(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PollVoteRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2158(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PollVoteRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2158(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2159(in *jlexer.Lexer, out *PollVote) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "poll_id":
+			out.PollID = string(in.String())
+		case "option_id":
+			out.OptionID = string(in.String())
+		case "answer_text":
+			out.AnswerText = string(in.String())
+		case "is_answer":
+			out.IsAnswer = bool(in.Bool())
+		case "user_id":
+			out.UserID = string(in.String())
+		case "user":
+			if in.IsNull() {
+				in.Skip()
+				out.User = nil
+			} else {
+				if out.User == nil {
+					out.User = new(User)
+				}
+				(*out.User).UnmarshalEasyJSON(in)
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2159(out *jwriter.Writer, in PollVote) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"poll_id\":"
+		out.RawString(prefix)
+		out.String(string(in.PollID))
+	}
+	if in.OptionID != "" {
+		const prefix string = ",\"option_id\":"
+		out.RawString(prefix)
+		out.String(string(in.OptionID))
+	}
+	if in.AnswerText != "" {
+		const prefix string = ",\"answer_text\":"
+		out.RawString(prefix)
+		out.String(string(in.AnswerText))
+	}
+	if in.IsAnswer {
+		const prefix string = ",\"is_answer\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsAnswer))
+	}
+	if in.UserID != "" {
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.String(string(in.UserID))
+	}
+	if in.User != nil {
+		const prefix string = ",\"user\":"
+		out.RawString(prefix)
+		(*in.User).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PollVote) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2159(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PollVote) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2159(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PollVote) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2159(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PollVote) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2159(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2160(in *jlexer.Lexer, out *PollOption) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	for key := range out.ExtraData {
+		delete(out.ExtraData, key)
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "text":
+			out.Text = string(in.String())
+		default:
+			if out.ExtraData == nil {
+				out.ExtraData = make(map[string]interface{})
+			}
+			out.ExtraData[key] = in.Interface()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2160(out *jwriter.Writer, in PollOption) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.ID != "" {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"text\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Text))
+	}
+	for k, v := range in.ExtraData {
+		switch k {
+		case "id", "text":
+			continue // don't allow field overwrites
+		}
+		out.RawByte(',')
+		out.String(string(k))
+		out.RawByte(':')
+		if m, ok := v.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := v.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(v))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PollOption) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2160(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PollOption) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2160(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PollOption) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2160(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PollOption) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2160(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2161(in *jlexer.Lexer, out *Poll) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	for key := range out.ExtraData {
+		delete(out.ExtraData, key)
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "voting_visibility":
+			out.VotingVisibility = PollVotingVisibility(in.String())
+		case "enforce_unique_vote":
+			out.EnforceUniqueVote = bool(in.Bool())
+		case "max_votes_allowed":
+			out.MaxVotesAllowed = int(in.Int())
+		case "allow_user_suggested_options":
+			out.AllowUserSuggestedOptions = bool(in.Bool())
+		case "allow_answers":
+			out.AllowAnswers = bool(in.Bool())
+		case "is_closed":
+			out.IsClosed = bool(in.Bool())
+		case "options":
+			if in.IsNull() {
+				in.Skip()
+				out.Options = nil
+			} else {
+				in.Delim('[')
+				if out.Options == nil {
+					if !in.IsDelim(']') {
+						out.Options = make([]*PollOption, 0, 8)
+					} else {
+						out.Options = []*PollOption{}
+					}
+				} else {
+					out.Options = (out.Options)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v324 *PollOption
+					if in.IsNull() {
+						in.Skip()
+						v324 = nil
+					} else {
+						if v324 == nil {
+							v324 = new(PollOption)
+						}
+						(*v324).UnmarshalEasyJSON(in)
+					}
+					out.Options = append(out.Options, v324)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "vote_count":
+			out.VoteCount = int(in.Int())
+		case "vote_counts_by_option":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.VoteCountsByOption = make(map[string]int)
+				} else {
+					out.VoteCountsByOption = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v325 int
+					v325 = int(in.Int())
+					(out.VoteCountsByOption)[key] = v325
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "answers_count":
+			out.AnswersCount = int(in.Int())
+		case "latest_answers":
+			if in.IsNull() {
+				in.Skip()
+				out.LatestAnswers = nil
+			} else {
+				in.Delim('[')
+				if out.LatestAnswers == nil {
+					if !in.IsDelim(']') {
+						out.LatestAnswers = make([]*PollVote, 0, 8)
+					} else {
+						out.LatestAnswers = []*PollVote{}
+					}
+				} else {
+					out.LatestAnswers = (out.LatestAnswers)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v326 *PollVote
+					if in.IsNull() {
+						in.Skip()
+						v326 = nil
+					} else {
+						if v326 == nil {
+							v326 = new(PollVote)
+						}
+						(*v326).UnmarshalEasyJSON(in)
+					}
+					out.LatestAnswers = append(out.LatestAnswers, v326)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "own_votes":
+			if in.IsNull() {
+				in.Skip()
+				out.OwnVotes = nil
+			} else {
+				in.Delim('[')
+				if out.OwnVotes == nil {
+					if !in.IsDelim(']') {
+						out.OwnVotes = make([]*PollVote, 0, 8)
+					} else {
+						out.OwnVotes = []*PollVote{}
+					}
+				} else {
+					out.OwnVotes = (out.OwnVotes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v327 *PollVote
+					if in.IsNull() {
+						in.Skip()
+						v327 = nil
+					} else {
+						if v327 == nil {
+							v327 = new(PollVote)
+						}
+						(*v327).UnmarshalEasyJSON(in)
+					}
+					out.OwnVotes = append(out.OwnVotes, v327)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "created_by_id":
+			out.CreatedByID = string(in.String())
+		case "created_by":
+			if in.IsNull() {
+				in.Skip()
+				out.CreatedBy = nil
+			} else {
+				if out.CreatedBy == nil {
+					out.CreatedBy = new(User)
+				}
+				(*out.CreatedBy).UnmarshalEasyJSON(in)
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			if out.ExtraData == nil {
+				out.ExtraData = make(map[string]interface{})
+			}
+			out.ExtraData[key] = in.Interface()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2161(out *jwriter.Writer, in Poll) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	if in.Description != "" {
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	if in.VotingVisibility != "" {
+		const prefix string = ",\"voting_visibility\":"
+		out.RawString(prefix)
+		out.String(string(in.VotingVisibility))
+	}
+	{
+		const prefix string = ",\"enforce_unique_vote\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.EnforceUniqueVote))
+	}
+	if in.MaxVotesAllowed != 0 {
+		const prefix string = ",\"max_votes_allowed\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxVotesAllowed))
+	}
+	{
+		const prefix string = ",\"allow_user_suggested_options\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.AllowUserSuggestedOptions))
+	}
+	{
+		const prefix string = ",\"allow_answers\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.AllowAnswers))
+	}
+	{
+		const prefix string = ",\"is_closed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsClosed))
+	}
+	{
+		const prefix string = ",\"options\":"
+		out.RawString(prefix)
+		if in.Options == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v328, v329 := range in.Options {
+				if v328 > 0 {
+					out.RawByte(',')
+				}
+				if v329 == nil {
+					out.RawString("null")
+				} else {
+					(*v329).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"vote_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.VoteCount))
+	}
+	{
+		const prefix string = ",\"vote_counts_by_option\":"
+		out.RawString(prefix)
+		if in.VoteCountsByOption == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v330First := true
+			for v330Name, v330Value := range in.VoteCountsByOption {
+				if v330First {
+					v330First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v330Name))
+				out.RawByte(':')
+				out.Int(int(v330Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"answers_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.AnswersCount))
+	}
+	{
+		const prefix string = ",\"latest_answers\":"
+		out.RawString(prefix)
+		if in.LatestAnswers == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v331, v332 := range in.LatestAnswers {
+				if v331 > 0 {
+					out.RawByte(',')
+				}
+				if v332 == nil {
+					out.RawString("null")
+				} else {
+					(*v332).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"own_votes\":"
+		out.RawString(prefix)
+		if in.OwnVotes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v333, v334 := range in.OwnVotes {
+				if v333 > 0 {
+					out.RawByte(',')
+				}
+				if v334 == nil {
+					out.RawString("null")
+				} else {
+					(*v334).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"created_by_id\":"
+		out.RawString(prefix)
+		out.String(string(in.CreatedByID))
+	}
+	{
+		const prefix string = ",\"created_by\":"
+		out.RawString(prefix)
+		if in.CreatedBy == nil {
+			out.RawString("null")
+		} else {
+			(*in.CreatedBy).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	for k, v := range in.ExtraData {
+		switch k {
+		case "id", "name", "description", "voting_visibility", "enforce_unique_vote", "max_votes_allowed", "allow_user_suggested_options", "allow_answers", "is_closed", "options", "vote_count", "vote_counts_by_option", "answers_count", "latest_answers", "own_votes", "created_by_id", "created_by", "created_at", "updated_at":
+			continue // don't allow field overwrites
+		}
+		out.RawByte(',')
+		out.String(string(k))
+		out.RawByte(':')
+		if m, ok := v.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := v.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(v))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Poll) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2161(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Poll) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2161(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Poll) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2161(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Poll) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2161(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2162(in *jlexer.Lexer, out *Policy) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "resources":
+			if in.IsNull() {
+				in.Skip()
+				out.Resources = nil
+			} else {
+				in.Delim('[')
+				if out.Resources == nil {
+					if !in.IsDelim(']') {
+						out.Resources = make([]string, 0, 4)
+					} else {
+						out.Resources = []string{}
+					}
+				} else {
+					out.Resources = (out.Resources)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v335 string
+					v335 = string(in.String())
+					out.Resources = append(out.Resources, v335)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "roles":
+			if in.IsNull() {
+				in.Skip()
+				out.Roles = nil
+			} else {
+				in.Delim('[')
+				if out.Roles == nil {
+					if !in.IsDelim(']') {
+						out.Roles = make([]string, 0, 4)
+					} else {
+						out.Roles = []string{}
+					}
+				} else {
+					out.Roles = (out.Roles)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v336 string
+					v336 = string(in.String())
+					out.Roles = append(out.Roles, v336)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "action":
+			out.Action = int(in.Int())
+		case "owner":
+			out.Owner = bool(in.Bool())
+		case "priority":
+			out.Priority = int(in.Int())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2162(out *jwriter.Writer, in Policy) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"resources\":"
+		out.RawString(prefix)
+		if in.Resources == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v337, v338 := range in.Resources {
+				if v337 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v338))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"roles\":"
+		out.RawString(prefix)
+		if in.Roles == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v339, v340 := range in.Roles {
+				if v339 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v340))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"action\":"
+		out.RawString(prefix)
+		out.Int(int(in.Action))
+	}
+	{
+		const prefix string = ",\"owner\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Owner))
+	}
+	{
+		const prefix string = ",\"priority\":"
+		out.RawString(prefix)
+		out.Int(int(in.Priority))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Policy) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2162(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Policy) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2162(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Policy) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2162(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Policy) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2162(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2163(in *jlexer.Lexer, out *PinnedMessagesOptions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "limit":
+			out.Limit = int(in.Int())
+		case "offset":
+			out.Offset = int(in.Int())
+		case "pinned_at_before":
+			if in.IsNull() {
+				in.Skip()
+				out.PinnedAtBefore = nil
+			} else {
+				if out.PinnedAtBefore == nil {
+					out.PinnedAtBefore = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.PinnedAtBefore).UnmarshalJSON(data))
+				}
+			}
+		case "pinned_at_after":
+			if in.IsNull() {
+				in.Skip()
+				out.PinnedAtAfter = nil
+			} else {
+				if out.PinnedAtAfter == nil {
+					out.PinnedAtAfter = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.PinnedAtAfter).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2163(out *jwriter.Writer, in PinnedMessagesOptions) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Limit != 0 {
+		const prefix string = ",\"limit\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Int(int(in.Limit))
+	}
+	if in.Offset != 0 {
+		const prefix string = ",\"offset\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.Offset))
+	}
+	if in.PinnedAtBefore != nil {
+		const prefix string = ",\"pinned_at_before\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.PinnedAtBefore).MarshalJSON())
+	}
+	if in.PinnedAtAfter != nil {
+		const prefix string = ",\"pinned_at_after\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.PinnedAtAfter).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PinnedMessagesOptions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2163(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PinnedMessagesOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2163(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PinnedMessagesOptions) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2163(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PinnedMessagesOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2163(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2164(in *jlexer.Lexer, out *Permission) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "action":
+			out.Action = string(in.String())
+		case "resources":
+			if in.IsNull() {
+				in.Skip()
+				out.Resources = nil
+			} else {
+				in.Delim('[')
+				if out.Resources == nil {
+					if !in.IsDelim(']') {
+						out.Resources = make([]string, 0, 4)
+					} else {
+						out.Resources = []string{}
+					}
+				} else {
+					out.Resources = (out.Resources)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v341 string
+					v341 = string(in.String())
+					out.Resources = append(out.Resources, v341)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "roles":
+			if in.IsNull() {
+				in.Skip()
+				out.Roles = nil
+			} else {
+				in.Delim('[')
+				if out.Roles == nil {
+					if !in.IsDelim(']') {
+						out.Roles = make([]string, 0, 4)
+					} else {
+						out.Roles = []string{}
+					}
+				} else {
+					out.Roles = (out.Roles)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v342 string
+					v342 = string(in.String())
+					out.Roles = append(out.Roles, v342)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "owner":
+			out.Owner = bool(in.Bool())
+		case "priority":
+			out.Priority = int(in.Int())
+		case "id":
+			out.ID = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "same_team":
+			out.SameTeam = bool(in.Bool())
+		case "condition":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Condition = make(map[string]interface{})
+				} else {
+					out.Condition = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v343 interface{}
+					if m, ok := v343.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v343.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v343 = in.Interface()
+					}
+					(out.Condition)[key] = v343
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "custom":
+			out.Custom = bool(in.Bool())
+		case "level":
+			out.Level = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2164(out *jwriter.Writer, in Permission) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"action\":"
+		out.RawString(prefix)
+		out.String(string(in.Action))
+	}
+	{
+		const prefix string = ",\"resources\":"
+		out.RawString(prefix)
+		if in.Resources == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v344, v345 := range in.Resources {
+				if v344 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v345))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"roles\":"
+		out.RawString(prefix)
+		if in.Roles == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v346, v347 := range in.Roles {
+				if v346 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v347))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"owner\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Owner))
+	}
+	{
+		const prefix string = ",\"priority\":"
+		out.RawString(prefix)
+		out.Int(int(in.Priority))
+	}
+	if in.ID != "" {
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.String(string(in.ID))
+	}
+	if in.Description != "" {
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	if in.SameTeam {
+		const prefix string = ",\"same_team\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.SameTeam))
+	}
+	if len(in.Condition) != 0 {
+		const prefix string = ",\"condition\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v348First := true
+			for v348Name, v348Value := range in.Condition {
+				if v348First {
+					v348First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v348Name))
+				out.RawByte(':')
+				if m, ok := v348Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v348Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v348Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	if in.Custom {
+		const prefix string = ",\"custom\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Custom))
+	}
+	if in.Level != "" {
+		const prefix string = ",\"level\":"
+		out.RawString(prefix)
+		out.String(string(in.Level))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Permission) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2164(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Permission) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2164(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Permission) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2164(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Permission) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2164(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2165(in *jlexer.Lexer, out *PartialUserUpdate) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "set":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Set = make(map[string]interface{})
+				} else {
+					out.Set = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v349 interface{}
+					if m, ok := v349.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v349.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v349 = in.Interface()
+					}
+					(out.Set)[key] = v349
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "unset":
+			if in.IsNull() {
+				in.Skip()
+				out.Unset = nil
+			} else {
+				in.Delim('[')
+				if out.Unset == nil {
+					if !in.IsDelim(']') {
+						out.Unset = make([]string, 0, 4)
+					} else {
+						out.Unset = []string{}
+					}
+				} else {
+					out.Unset = (out.Unset)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v350 string
+					v350 = string(in.String())
+					out.Unset = append(out.Unset, v350)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2165(out *jwriter.Writer, in PartialUserUpdate) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	if len(in.Set) != 0 {
+		const prefix string = ",\"set\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v351First := true
+			for v351Name, v351Value := range in.Set {
+				if v351First {
+					v351First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v351Name))
+				out.RawByte(':')
+				if m, ok := v351Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v351Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v351Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	if len(in.Unset) != 0 {
+		const prefix string = ",\"unset\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v352, v353 := range in.Unset {
+				if v352 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v353))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PartialUserUpdate) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2165(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PartialUserUpdate) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2165(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PartialUserUpdate) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2165(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PartialUserUpdate) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2165(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2166(in *jlexer.Lexer, out *PaginationParams) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "limit":
+			out.Limit = int(in.Int())
+		case "offset":
+			out.Offset = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2166(out *jwriter.Writer, in PaginationParams) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Limit != 0 {
+		const prefix string = ",\"limit\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Int(int(in.Limit))
+	}
+	if in.Offset != 0 {
+		const prefix string = ",\"offset\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.Offset))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PaginationParams) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2166(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PaginationParams) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2166(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PaginationParams) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2166(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PaginationParams) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2166(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2167(in *jlexer.Lexer, out *Mute) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user":
+			(out.User).UnmarshalEasyJSON(in)
+		case "target":
+			(out.Target).UnmarshalEasyJSON(in)
+		case "expires":
+			if in.IsNull() {
+				in.Skip()
+				out.Expires = nil
+			} else {
+				if out.Expires == nil {
+					out.Expires = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.Expires).UnmarshalJSON(data))
+				}
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2167(out *jwriter.Writer, in Mute) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user\":"
+		out.RawString(prefix[1:])
+		(in.User).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"target\":"
+		out.RawString(prefix)
+		(in.Target).MarshalEasyJSON(out)
+	}
+	if in.Expires != nil {
+		const prefix string = ",\"expires\":"
+		out.RawString(prefix)
+		out.Raw((*in.Expires).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Mute) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2167(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Mute) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2167(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Mute) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2167(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Mute) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2167(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2168(in *jlexer.Lexer, out *ModerationRule) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "label":
+			out.Label = string(in.String())
+		case "action":
+			out.Action = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2168(out *jwriter.Writer, in ModerationRule) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"label\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Label))
+	}
+	{
+		const prefix string = ",\"action\":"
+		out.RawString(prefix)
+		out.String(string(in.Action))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ModerationRule) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2168(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ModerationRule) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2168(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ModerationRule) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2168(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ModerationRule) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2168(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2169(in *jlexer.Lexer, out *ModerationFlag) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	for key := range out.ExtraData {
+		delete(out.ExtraData, key)
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "reason":
+			out.Reason = string(in.String())
+		case "user_id":
+			out.UserID = string(in.String())
+		case "user":
+			if in.IsNull() {
+				in.Skip()
+				out.User = nil
+			} else {
+				if out.User == nil {
+					out.User = new(User)
+				}
+				(*out.User).UnmarshalEasyJSON(in)
+			}
+		case "labels":
+			if in.IsNull() {
+				in.Skip()
+				out.Labels = nil
+			} else {
+				in.Delim('[')
+				if out.Labels == nil {
+					if !in.IsDelim(']') {
+						out.Labels = make([]string, 0, 4)
+					} else {
+						out.Labels = []string{}
+					}
+				} else {
+					out.Labels = (out.Labels)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v354 string
+					v354 = string(in.String())
+					out.Labels = append(out.Labels, v354)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			if out.ExtraData == nil {
+				out.ExtraData = make(map[string]interface{})
+			}
+			out.ExtraData[key] = in.Interface()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2169(out *jwriter.Writer, in ModerationFlag) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	if in.Reason != "" {
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	if in.UserID != "" {
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.String(string(in.UserID))
+	}
+	if in.User != nil {
+		const prefix string = ",\"user\":"
+		out.RawString(prefix)
+		(*in.User).MarshalEasyJSON(out)
+	}
+	if len(in.Labels) != 0 {
+		const prefix string = ",\"labels\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v355, v356 := range in.Labels {
+				if v355 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v356))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	for k, v := range in.ExtraData {
+		switch k {
+		case "type", "reason", "user_id", "user", "labels", "created_at":
+			continue // don't allow field overwrites
+		}
+		out.RawByte(',')
+		out.String(string(k))
+		out.RawByte(':')
+		if m, ok := v.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := v.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(v))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ModerationFlag) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2169(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ModerationFlag) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2169(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ModerationFlag) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2169(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ModerationFlag) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2169(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2170(in *jlexer.Lexer, out *ModerationEngineConfig) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "enabled":
+			out.Enabled = bool(in.Bool())
+		case "rules":
+			if in.IsNull() {
+				in.Skip()
+				out.Rules = nil
+			} else {
+				in.Delim('[')
+				if out.Rules == nil {
+					if !in.IsDelim(']') {
+						out.Rules = make([]*ModerationRule, 0, 8)
+					} else {
+						out.Rules = []*ModerationRule{}
+					}
+				} else {
+					out.Rules = (out.Rules)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v357 *ModerationRule
+					if in.IsNull() {
+						in.Skip()
+						v357 = nil
+					} else {
+						if v357 == nil {
+							v357 = new(ModerationRule)
+						}
+						(*v357).UnmarshalEasyJSON(in)
+					}
+					out.Rules = append(out.Rules, v357)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2170(out *jwriter.Writer, in ModerationEngineConfig) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"enabled\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Enabled))
+	}
+	if len(in.Rules) != 0 {
+		const prefix string = ",\"rules\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v358, v359 := range in.Rules {
+				if v358 > 0 {
+					out.RawByte(',')
+				}
+				if v359 == nil {
+					out.RawString("null")
+				} else {
+					(*v359).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ModerationEngineConfig) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2170(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ModerationEngineConfig) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2170(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ModerationEngineConfig) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2170(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ModerationEngineConfig) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2170(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2171(in *jlexer.Lexer, out *ModerationDeleteUser) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "hard_delete":
+			out.HardDelete = bool(in.Bool())
+		case "mark_messages_deleted":
+			out.MarkMessagesDeleted = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2171(out *jwriter.Writer, in ModerationDeleteUser) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.HardDelete {
+		const prefix string = ",\"hard_delete\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.HardDelete))
+	}
+	if in.MarkMessagesDeleted {
+		const prefix string = ",\"mark_messages_deleted\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.MarkMessagesDeleted))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ModerationDeleteUser) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2171(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ModerationDeleteUser) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2171(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ModerationDeleteUser) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2171(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ModerationDeleteUser) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2171(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2172(in *jlexer.Lexer, out *ModerationDeleteMessage) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "hard_delete":
+			out.HardDelete = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2172(out *jwriter.Writer, in ModerationDeleteMessage) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.HardDelete {
+		const prefix string = ",\"hard_delete\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.HardDelete))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ModerationDeleteMessage) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2172(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ModerationDeleteMessage) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2172(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ModerationDeleteMessage) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2172(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ModerationDeleteMessage) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2172(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2173(in *jlexer.Lexer, out *ModerationCustomAction) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "options":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Options = make(map[string]interface{})
+				} else {
+					out.Options = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v360 interface{}
+					if m, ok := v360.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v360.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v360 = in.Interface()
+					}
+					(out.Options)[key] = v360
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2173(out *jwriter.Writer, in ModerationCustomAction) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	if len(in.Options) != 0 {
+		const prefix string = ",\"options\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v361First := true
+			for v361Name, v361Value := range in.Options {
+				if v361First {
+					v361First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v361Name))
+				out.RawByte(':')
+				if m, ok := v361Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v361Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v361Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ModerationCustomAction) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2173(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ModerationCustomAction) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2173(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ModerationCustomAction) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2173(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ModerationCustomAction) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2173(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2174(in *jlexer.Lexer, out *ModerationConfig) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "key":
+			out.Key = string(in.String())
+		case "async":
+			out.Async = bool(in.Bool())
+		case "ai_text_config":
+			if in.IsNull() {
+				in.Skip()
+				out.AITextConfig = nil
+			} else {
+				if out.AITextConfig == nil {
+					out.AITextConfig = new(ModerationEngineConfig)
+				}
+				(*out.AITextConfig).UnmarshalEasyJSON(in)
+			}
+		case "ai_image_config":
+			if in.IsNull() {
+				in.Skip()
+				out.AIImageConfig = nil
+			} else {
+				if out.AIImageConfig == nil {
+					out.AIImageConfig = new(ModerationEngineConfig)
+				}
+				(*out.AIImageConfig).UnmarshalEasyJSON(in)
+			}
+		case "block_list_config":
+			if in.IsNull() {
+				in.Skip()
+				out.BlockListConfig = nil
+			} else {
+				if out.BlockListConfig == nil {
+					out.BlockListConfig = new(ModerationEngineConfig)
+				}
+				(*out.BlockListConfig).UnmarshalEasyJSON(in)
+			}
+		case "created_at":
+			if in.IsNull() {
+				in.Skip()
+				out.CreatedAt = nil
+			} else {
+				if out.CreatedAt == nil {
+					out.CreatedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CreatedAt).UnmarshalJSON(data))
+				}
+			}
+		case "updated_at":
+			if in.IsNull() {
+				in.Skip()
+				out.UpdatedAt = nil
+			} else {
+				if out.UpdatedAt == nil {
+					out.UpdatedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.UpdatedAt).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2174(out *jwriter.Writer, in ModerationConfig) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"key\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Key))
+	}
+	if in.Async {
+		const prefix string = ",\"async\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Async))
+	}
+	if in.AITextConfig != nil {
+		const prefix string = ",\"ai_text_config\":"
+		out.RawString(prefix)
+		(*in.AITextConfig).MarshalEasyJSON(out)
+	}
+	if in.AIImageConfig != nil {
+		const prefix string = ",\"ai_image_config\":"
+		out.RawString(prefix)
+		(*in.AIImageConfig).MarshalEasyJSON(out)
+	}
+	if in.BlockListConfig != nil {
+		const prefix string = ",\"block_list_config\":"
+		out.RawString(prefix)
+		(*in.BlockListConfig).MarshalEasyJSON(out)
+	}
+	if in.CreatedAt != nil {
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.CreatedAt).MarshalJSON())
+	}
+	if in.UpdatedAt != nil {
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ModerationConfig) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2174(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ModerationConfig) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2174(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ModerationConfig) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2174(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ModerationConfig) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2174(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2175(in *jlexer.Lexer, out *ModerationBan) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "reason":
+			out.Reason = string(in.String())
+		case "timeout":
+			out.Timeout = int(in.Int())
+		case "channel_ban_only":
+			out.ChannelBanOnly = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2175(out *jwriter.Writer, in ModerationBan) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Reason != "" {
+		const prefix string = ",\"reason\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Reason))
+	}
+	if in.Timeout != 0 {
+		const prefix string = ",\"timeout\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.Timeout))
+	}
+	if in.ChannelBanOnly {
+		const prefix string = ",\"channel_ban_only\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.ChannelBanOnly))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ModerationBan) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2175(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ModerationBan) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2175(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ModerationBan) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2175(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ModerationBan) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2175(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2176(in *jlexer.Lexer, out *MessageResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "message":
+			if in.IsNull() {
+				in.Skip()
+				out.Message = nil
+			} else {
+				if out.Message == nil {
+					out.Message = new(Message)
+				}
+				(*out.Message).UnmarshalEasyJSON(in)
+			}
+		case "duration":
+			out.Duration = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2176(out *jwriter.Writer, in MessageResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"message\":"
+		out.RawString(prefix[1:])
+		if in.Message == nil {
+			out.RawString("null")
+		} else {
+			(*in.Message).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"duration\":"
+		out.RawString(prefix)
+		out.String(string(in.Duration))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MessageResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2176(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MessageResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2176(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MessageResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2176(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MessageResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2176(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2177(in *jlexer.Lexer, out *MessagePaginationParams) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "limit":
+			out.Limit = int(in.Int())
+		case "id_lt":
+			out.IDLT = string(in.String())
+		case "id_lte":
+			out.IDLTE = string(in.String())
+		case "id_gt":
+			out.IDGT = string(in.String())
+		case "id_gte":
+			out.IDGTE = string(in.String())
+		case "created_at_before":
+			if in.IsNull() {
+				in.Skip()
+				out.CreatedAtBefore = nil
+			} else {
+				if out.CreatedAtBefore == nil {
+					out.CreatedAtBefore = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CreatedAtBefore).UnmarshalJSON(data))
+				}
+			}
+		case "created_at_before_or_equal":
+			if in.IsNull() {
+				in.Skip()
+				out.CreatedAtBeforeOrEqual = nil
+			} else {
+				if out.CreatedAtBeforeOrEqual == nil {
+					out.CreatedAtBeforeOrEqual = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CreatedAtBeforeOrEqual).UnmarshalJSON(data))
+				}
+			}
+		case "created_at_after":
+			if in.IsNull() {
+				in.Skip()
+				out.CreatedAtAfter = nil
+			} else {
+				if out.CreatedAtAfter == nil {
+					out.CreatedAtAfter = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CreatedAtAfter).UnmarshalJSON(data))
+				}
+			}
+		case "created_at_after_or_equal":
+			if in.IsNull() {
+				in.Skip()
+				out.CreatedAtAfterOrEqual = nil
+			} else {
+				if out.CreatedAtAfterOrEqual == nil {
+					out.CreatedAtAfterOrEqual = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CreatedAtAfterOrEqual).UnmarshalJSON(data))
+				}
+			}
+		case "created_at_around":
+			if in.IsNull() {
+				in.Skip()
+				out.CreatedAtAround = nil
+			} else {
+				if out.CreatedAtAround == nil {
+					out.CreatedAtAround = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CreatedAtAround).UnmarshalJSON(data))
+				}
+			}
+		case "id_around":
+			out.IDAround = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2177(out *jwriter.Writer, in MessagePaginationParams) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Limit != 0 {
+		const prefix string = ",\"limit\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Int(int(in.Limit))
+	}
+	if in.IDLT != "" {
+		const prefix string = ",\"id_lt\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.IDLT))
+	}
+	if in.IDLTE != "" {
+		const prefix string = ",\"id_lte\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.IDLTE))
+	}
+	if in.IDGT != "" {
+		const prefix string = ",\"id_gt\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.IDGT))
+	}
+	if in.IDGTE != "" {
+		const prefix string = ",\"id_gte\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.IDGTE))
+	}
+	if in.CreatedAtBefore != nil {
+		const prefix string = ",\"created_at_before\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.CreatedAtBefore).MarshalJSON())
+	}
+	if in.CreatedAtBeforeOrEqual != nil {
+		const prefix string = ",\"created_at_before_or_equal\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.CreatedAtBeforeOrEqual).MarshalJSON())
+	}
+	if in.CreatedAtAfter != nil {
+		const prefix string = ",\"created_at_after\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.CreatedAtAfter).MarshalJSON())
+	}
+	if in.CreatedAtAfterOrEqual != nil {
+		const prefix string = ",\"created_at_after_or_equal\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.CreatedAtAfterOrEqual).MarshalJSON())
+	}
+	if in.CreatedAtAround != nil {
+		const prefix string = ",\"created_at_around\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.CreatedAtAround).MarshalJSON())
+	}
+	if in.IDAround != "" {
+		const prefix string = ",\"id_around\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.IDAround))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MessagePaginationParams) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2177(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MessagePaginationParams) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2177(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MessagePaginationParams) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2177(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MessagePaginationParams) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2177(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2178(in *jlexer.Lexer, out *MessageIterator) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2178(out *jwriter.Writer, in MessageIterator) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MessageIterator) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2178(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MessageIterator) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2178(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MessageIterator) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2178(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MessageIterator) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2178(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2179(in *jlexer.Lexer, out *MessageHistoryEntry) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	for key := range out.ExtraData {
+		delete(out.ExtraData, key)
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "message_id":
+			out.MessageID = string(in.String())
+		case "text":
+			out.Text = string(in.String())
+		case "attachments":
+			if in.IsNull() {
+				in.Skip()
+				out.Attachments = nil
+			} else {
+				in.Delim('[')
+				if out.Attachments == nil {
+					if !in.IsDelim(']') {
+						out.Attachments = make([]*Attachment, 0, 8)
+					} else {
+						out.Attachments = []*Attachment{}
+					}
+				} else {
+					out.Attachments = (out.Attachments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v362 *Attachment
+					if in.IsNull() {
+						in.Skip()
+						v362 = nil
+					} else {
+						if v362 == nil {
+							v362 = new(Attachment)
+						}
+						(*v362).UnmarshalEasyJSON(in)
+					}
+					out.Attachments = append(out.Attachments, v362)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "message_updated_by_id":
+			out.MessageUpdatedByID = string(in.String())
+		case "message_updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.MessageUpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			if out.ExtraData == nil {
+				out.ExtraData = make(map[string]interface{})
+			}
+			out.ExtraData[key] = in.Interface()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2179(out *jwriter.Writer, in MessageHistoryEntry) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"message_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.MessageID))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"attachments\":"
+		out.RawString(prefix)
+		if in.Attachments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v363, v364 := range in.Attachments {
+				if v363 > 0 {
+					out.RawByte(',')
+				}
+				if v364 == nil {
+					out.RawString("null")
+				} else {
+					(*v364).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"message_updated_by_id\":"
+		out.RawString(prefix)
+		out.String(string(in.MessageUpdatedByID))
+	}
+	{
+		const prefix string = ",\"message_updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.MessageUpdatedAt).MarshalJSON())
+	}
+	for k, v := range in.ExtraData {
+		switch k {
+		case "message_id", "text", "attachments", "message_updated_by_id", "message_updated_at":
+			continue // don't allow field overwrites
+		}
+		out.RawByte(',')
+		out.String(string(k))
+		out.RawByte(':')
+		if m, ok := v.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := v.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(v))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MessageHistoryEntry) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2179(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MessageHistoryEntry) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2179(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MessageHistoryEntry) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2179(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MessageHistoryEntry) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2179(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2180(in *jlexer.Lexer, out *MessageFlag) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "created_by_automod":
+			out.CreatedByAutomod = bool(in.Bool())
+		case "user":
+			if in.IsNull() {
+				in.Skip()
+				out.User = nil
+			} else {
+				if out.User == nil {
+					out.User = new(User)
+				}
+				(*out.User).UnmarshalEasyJSON(in)
+			}
+		case "message":
+			if in.IsNull() {
+				in.Skip()
+				out.Message = nil
+			} else {
+				if out.Message == nil {
+					out.Message = new(Message)
+				}
+				(*out.Message).UnmarshalEasyJSON(in)
+			}
+		case "reviewed_by":
+			if in.IsNull() {
+				in.Skip()
+				out.ReviewedBy = nil
+			} else {
+				if out.ReviewedBy == nil {
+					out.ReviewedBy = new(User)
+				}
+				(*out.ReviewedBy).UnmarshalEasyJSON(in)
+			}
+		case "reviewed_at":
+			if in.IsNull() {
+				in.Skip()
+				out.ReviewedAt = nil
+			} else {
+				if out.ReviewedAt == nil {
+					out.ReviewedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ReviewedAt).UnmarshalJSON(data))
+				}
+			}
+		case "approved_at":
+			if in.IsNull() {
+				in.Skip()
+				out.ApprovedAt = nil
+			} else {
+				if out.ApprovedAt == nil {
+					out.ApprovedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ApprovedAt).UnmarshalJSON(data))
+				}
+			}
+		case "rejected_at":
+			if in.IsNull() {
+				in.Skip()
+				out.RejectedAt = nil
+			} else {
+				if out.RejectedAt == nil {
+					out.RejectedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.RejectedAt).UnmarshalJSON(data))
+				}
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2180(out *jwriter.Writer, in MessageFlag) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"created_by_automod\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.CreatedByAutomod))
+	}
+	{
+		const prefix string = ",\"user\":"
+		out.RawString(prefix)
+		if in.User == nil {
+			out.RawString("null")
+		} else {
+			(*in.User).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"message\":"
+		out.RawString(prefix)
+		if in.Message == nil {
+			out.RawString("null")
+		} else {
+			(*in.Message).MarshalEasyJSON(out)
+		}
+	}
+	if in.ReviewedBy != nil {
+		const prefix string = ",\"reviewed_by\":"
+		out.RawString(prefix)
+		(*in.ReviewedBy).MarshalEasyJSON(out)
+	}
+	if in.ReviewedAt != nil {
+		const prefix string = ",\"reviewed_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.ReviewedAt).MarshalJSON())
+	}
+	if in.ApprovedAt != nil {
+		const prefix string = ",\"approved_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.ApprovedAt).MarshalJSON())
+	}
+	if in.RejectedAt != nil {
+		const prefix string = ",\"rejected_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.RejectedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MessageFlag) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2180(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MessageFlag) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2180(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MessageFlag) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2180(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MessageFlag) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2180(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2181(in *jlexer.Lexer, out *Message) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	for key := range out.ExtraData {
+		delete(out.ExtraData, key)
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "text":
+			out.Text = string(in.String())
+		case "html":
+			out.HTML = string(in.String())
+		case "type":
+			out.Type = MessageType(in.String())
+		case "user":
+			if in.IsNull() {
+				in.Skip()
+				out.User = nil
+			} else {
+				if out.User == nil {
+					out.User = new(User)
+				}
+				(*out.User).UnmarshalEasyJSON(in)
+			}
+		case "attachments":
+			if in.IsNull() {
+				in.Skip()
+				out.Attachments = nil
+			} else {
+				in.Delim('[')
+				if out.Attachments == nil {
+					if !in.IsDelim(']') {
+						out.Attachments = make([]*Attachment, 0, 8)
+					} else {
+						out.Attachments = []*Attachment{}
+					}
+				} else {
+					out.Attachments = (out.Attachments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v365 *Attachment
+					if in.IsNull() {
+						in.Skip()
+						v365 = nil
+					} else {
+						if v365 == nil {
+							v365 = new(Attachment)
+						}
+						(*v365).UnmarshalEasyJSON(in)
+					}
+					out.Attachments = append(out.Attachments, v365)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "latest_reactions":
+			if in.IsNull() {
+				in.Skip()
+				out.LatestReactions = nil
+			} else {
+				in.Delim('[')
+				if out.LatestReactions == nil {
+					if !in.IsDelim(']') {
+						out.LatestReactions = make([]*Reaction, 0, 8)
+					} else {
+						out.LatestReactions = []*Reaction{}
+					}
+				} else {
+					out.LatestReactions = (out.LatestReactions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v366 *Reaction
+					if in.IsNull() {
+						in.Skip()
+						v366 = nil
+					} else {
+						if v366 == nil {
+							v366 = new(Reaction)
+						}
+						(*v366).UnmarshalEasyJSON(in)
+					}
+					out.LatestReactions = append(out.LatestReactions, v366)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "own_reactions":
+			if in.IsNull() {
+				in.Skip()
+				out.OwnReactions = nil
+			} else {
+				in.Delim('[')
+				if out.OwnReactions == nil {
+					if !in.IsDelim(']') {
+						out.OwnReactions = make([]*Reaction, 0, 8)
+					} else {
+						out.OwnReactions = []*Reaction{}
+					}
+				} else {
+					out.OwnReactions = (out.OwnReactions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v367 *Reaction
+					if in.IsNull() {
+						in.Skip()
+						v367 = nil
+					} else {
+						if v367 == nil {
+							v367 = new(Reaction)
+						}
+						(*v367).UnmarshalEasyJSON(in)
+					}
+					out.OwnReactions = append(out.OwnReactions, v367)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "reaction_counts":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.ReactionCounts = make(map[string]int)
+				} else {
+					out.ReactionCounts = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v368 int
+					v368 = int(in.Int())
+					(out.ReactionCounts)[key] = v368
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "reaction_scores":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.ReactionScores = make(map[string]int)
+				} else {
+					out.ReactionScores = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v369 int
+					v369 = int(in.Int())
+					(out.ReactionScores)[key] = v369
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "parent_id":
+			out.ParentID = string(in.String())
+		case "show_in_channel":
+			out.ShowInChannel = bool(in.Bool())
+		case "reply_count":
+			out.ReplyCount = int(in.Int())
+		case "thread_participants":
+			if in.IsNull() {
+				in.Skip()
+				out.ThreadParticipants = nil
+			} else {
+				in.Delim('[')
+				if out.ThreadParticipants == nil {
+					if !in.IsDelim(']') {
+						out.ThreadParticipants = make([]*User, 0, 8)
+					} else {
+						out.ThreadParticipants = []*User{}
+					}
+				} else {
+					out.ThreadParticipants = (out.ThreadParticipants)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v370 *User
+					if in.IsNull() {
+						in.Skip()
+						v370 = nil
+					} else {
+						if v370 == nil {
+							v370 = new(User)
+						}
+						(*v370).UnmarshalEasyJSON(in)
+					}
+					out.ThreadParticipants = append(out.ThreadParticipants, v370)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "quoted_message_id":
+			out.QuotedMessageID = string(in.String())
+		case "quoted_message":
+			if in.IsNull() {
+				in.Skip()
+				out.QuotedMessage = nil
+			} else {
+				if out.QuotedMessage == nil {
+					out.QuotedMessage = new(Message)
+				}
+				(*out.QuotedMessage).UnmarshalEasyJSON(in)
+			}
+		case "silent":
+			out.Silent = bool(in.Bool())
+		case "command":
+			out.Command = string(in.String())
+		case "args":
+			out.Args = string(in.String())
+		case "i18n":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.I18n = make(map[string]string)
+				} else {
+					out.I18n = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v371 string
+					v371 = string(in.String())
+					(out.I18n)[key] = v371
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "mentioned_users":
+			if in.IsNull() {
+				in.Skip()
+				out.MentionedUsers = nil
+			} else {
+				in.Delim('[')
+				if out.MentionedUsers == nil {
+					if !in.IsDelim(']') {
+						out.MentionedUsers = make([]*User, 0, 8)
+					} else {
+						out.MentionedUsers = []*User{}
+					}
+				} else {
+					out.MentionedUsers = (out.MentionedUsers)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v372 *User
+					if in.IsNull() {
+						in.Skip()
+						v372 = nil
+					} else {
+						if v372 == nil {
+							v372 = new(User)
+						}
+						(*v372).UnmarshalEasyJSON(in)
+					}
+					out.MentionedUsers = append(out.MentionedUsers, v372)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "poll_id":
+			out.PollID = string(in.String())
+		case "poll":
+			if in.IsNull() {
+				in.Skip()
+				out.Poll = nil
+			} else {
+				if out.Poll == nil {
+					out.Poll = new(Poll)
+				}
+				(*out.Poll).UnmarshalEasyJSON(in)
+			}
+		case "pinned":
+			out.Pinned = bool(in.Bool())
+		case "pinned_at":
+			if in.IsNull() {
+				in.Skip()
+				out.PinnedAt = nil
+			} else {
+				if out.PinnedAt == nil {
+					out.PinnedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.PinnedAt).UnmarshalJSON(data))
+				}
+			}
+		case "pinned_by":
+			if in.IsNull() {
+				in.Skip()
+				out.PinnedBy = nil
+			} else {
+				if out.PinnedBy == nil {
+					out.PinnedBy = new(User)
+				}
+				(*out.PinnedBy).UnmarshalEasyJSON(in)
+			}
+		case "pin_expires":
+			if in.IsNull() {
+				in.Skip()
+				out.PinExpires = nil
+			} else {
+				if out.PinExpires == nil {
+					out.PinExpires = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.PinExpires).UnmarshalJSON(data))
+				}
+			}
+		case "created_at":
+			if in.IsNull() {
+				in.Skip()
+				out.CreatedAt = nil
+			} else {
+				if out.CreatedAt == nil {
+					out.CreatedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CreatedAt).UnmarshalJSON(data))
+				}
+			}
+		case "updated_at":
+			if in.IsNull() {
+				in.Skip()
+				out.UpdatedAt = nil
+			} else {
+				if out.UpdatedAt == nil {
+					out.UpdatedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.UpdatedAt).UnmarshalJSON(data))
+				}
+			}
+		default:
+			if out.ExtraData == nil {
+				out.ExtraData = make(map[string]interface{})
+			}
+			out.ExtraData[key] = in.Interface()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2181(out *jwriter.Writer, in Message) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"html\":"
+		out.RawString(prefix)
+		out.String(string(in.HTML))
+	}
+	if in.Type != "" {
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"user\":"
+		out.RawString(prefix)
+		if in.User == nil {
+			out.RawString("null")
+		} else {
+			(*in.User).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"attachments\":"
+		out.RawString(prefix)
+		if in.Attachments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v373, v374 := range in.Attachments {
+				if v373 > 0 {
+					out.RawByte(',')
+				}
+				if v374 == nil {
+					out.RawString("null")
+				} else {
+					(*v374).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"latest_reactions\":"
+		out.RawString(prefix)
+		if in.LatestReactions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v375, v376 := range in.LatestReactions {
+				if v375 > 0 {
+					out.RawByte(',')
+				}
+				if v376 == nil {
+					out.RawString("null")
+				} else {
+					(*v376).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"own_reactions\":"
+		out.RawString(prefix)
+		if in.OwnReactions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v377, v378 := range in.OwnReactions {
+				if v377 > 0 {
+					out.RawByte(',')
+				}
+				if v378 == nil {
+					out.RawString("null")
+				} else {
+					(*v378).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"reaction_counts\":"
+		out.RawString(prefix)
+		if in.ReactionCounts == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v379First := true
+			for v379Name, v379Value := range in.ReactionCounts {
+				if v379First {
+					v379First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v379Name))
+				out.RawByte(':')
+				out.Int(int(v379Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"reaction_scores\":"
+		out.RawString(prefix)
+		if in.ReactionScores == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v380First := true
+			for v380Name, v380Value := range in.ReactionScores {
+				if v380First {
+					v380First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v380Name))
+				out.RawByte(':')
+				out.Int(int(v380Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"parent_id\":"
+		out.RawString(prefix)
+		out.String(string(in.ParentID))
+	}
+	{
+		const prefix string = ",\"show_in_channel\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ShowInChannel))
+	}
+	if in.ReplyCount != 0 {
+		const prefix string = ",\"reply_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReplyCount))
+	}
+	if len(in.ThreadParticipants) != 0 {
+		const prefix string = ",\"thread_participants\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v381, v382 := range in.ThreadParticipants {
+				if v381 > 0 {
+					out.RawByte(',')
+				}
+				if v382 == nil {
+					out.RawString("null")
+				} else {
+					(*v382).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.QuotedMessageID != "" {
+		const prefix string = ",\"quoted_message_id\":"
+		out.RawString(prefix)
+		out.String(string(in.QuotedMessageID))
+	}
+	if in.QuotedMessage != nil {
+		const prefix string = ",\"quoted_message\":"
+		out.RawString(prefix)
+		(*in.QuotedMessage).MarshalEasyJSON(out)
+	}
+	if in.Silent {
+		const prefix string = ",\"silent\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Silent))
+	}
+	if in.Command != "" {
+		const prefix string = ",\"command\":"
+		out.RawString(prefix)
+		out.String(string(in.Command))
+	}
+	if in.Args != "" {
+		const prefix string = ",\"args\":"
+		out.RawString(prefix)
+		out.String(string(in.Args))
+	}
+	if len(in.I18n) != 0 {
+		const prefix string = ",\"i18n\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v383First := true
+			for v383Name, v383Value := range in.I18n {
+				if v383First {
+					v383First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v383Name))
+				out.RawByte(':')
+				out.String(string(v383Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"mentioned_users\":"
+		out.RawString(prefix)
+		if in.MentionedUsers == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v384, v385 := range in.MentionedUsers {
+				if v384 > 0 {
+					out.RawByte(',')
+				}
+				if v385 == nil {
+					out.RawString("null")
+				} else {
+					(*v385).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.PollID != "" {
+		const prefix string = ",\"poll_id\":"
+		out.RawString(prefix)
+		out.String(string(in.PollID))
+	}
+	if in.Poll != nil {
+		const prefix string = ",\"poll\":"
+		out.RawString(prefix)
+		(*in.Poll).MarshalEasyJSON(out)
+	}
+	if in.Pinned {
+		const prefix string = ",\"pinned\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Pinned))
+	}
+	if in.PinnedAt != nil {
+		const prefix string = ",\"pinned_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.PinnedAt).MarshalJSON())
+	}
+	if in.PinnedBy != nil {
+		const prefix string = ",\"pinned_by\":"
+		out.RawString(prefix)
+		(*in.PinnedBy).MarshalEasyJSON(out)
+	}
+	if in.PinExpires != nil {
+		const prefix string = ",\"pin_expires\":"
+		out.RawString(prefix)
+		out.Raw((*in.PinExpires).MarshalJSON())
+	}
+	if in.CreatedAt != nil {
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.CreatedAt).MarshalJSON())
+	}
+	if in.UpdatedAt != nil {
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.UpdatedAt).MarshalJSON())
+	}
+	for k, v := range in.ExtraData {
+		switch k {
+		case "id", "text", "html", "type", "user", "attachments", "latest_reactions", "own_reactions", "reaction_counts", "reaction_scores", "parent_id", "show_in_channel", "reply_count", "thread_participants", "quoted_message_id", "quoted_message", "silent", "command", "args", "i18n", "mentioned_users", "poll_id", "poll", "pinned", "pinned_at", "pinned_by", "pin_expires", "created_at", "updated_at":
+			continue // don't allow field overwrites
+		}
+		out.RawByte(',')
+		out.String(string(k))
+		out.RawByte(':')
+		if m, ok := v.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := v.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(v))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Message) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2181(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Message) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2181(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Message) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2181(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Message) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2181(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2182(in *jlexer.Lexer, out *MemberIterator) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2182(out *jwriter.Writer, in MemberIterator) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MemberIterator) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2182(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MemberIterator) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2182(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MemberIterator) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2182(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MemberIterator) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2182(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2183(in *jlexer.Lexer, out *MarkReadOptions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "MessageID":
+			out.MessageID = string(in.String())
+		case "ThreadID":
+			out.ThreadID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2183(out *jwriter.Writer, in MarkReadOptions) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"MessageID\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.MessageID))
+	}
+	{
+		const prefix string = ",\"ThreadID\":"
+		out.RawString(prefix)
+		out.String(string(in.ThreadID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MarkReadOptions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2183(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MarkReadOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2183(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MarkReadOptions) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2183(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MarkReadOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2183(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2184(in *jlexer.Lexer, out *LabelThresholds) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "flag":
+			out.Flag = float32(in.Float32())
+		case "block":
+			out.Block = float32(in.Float32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2184(out *jwriter.Writer, in LabelThresholds) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"flag\":"
+		out.RawString(prefix[1:])
+		out.Float32(float32(in.Flag))
+	}
+	{
+		const prefix string = ",\"block\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.Block))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LabelThresholds) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2184(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LabelThresholds) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2184(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LabelThresholds) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2184(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LabelThresholds) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2184(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2185(in *jlexer.Lexer, out *ImportURL) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "upload_url":
+			out.UploadURL = string(in.String())
+		case "path":
+			out.Path = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2185(out *jwriter.Writer, in ImportURL) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"upload_url\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.UploadURL))
+	}
+	{
+		const prefix string = ",\"path\":"
+		out.RawString(prefix)
+		out.String(string(in.Path))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ImportURL) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2185(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ImportURL) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2185(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ImportURL) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2185(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ImportURL) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2185(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2186(in *jlexer.Lexer, out *ImportTaskHistory) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "prev_state":
+			out.PrevState = string(in.String())
+		case "next_state":
+			out.NextState = string(in.String())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2186(out *jwriter.Writer, in ImportTaskHistory) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"prev_state\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.PrevState))
+	}
+	{
+		const prefix string = ",\"next_state\":"
+		out.RawString(prefix)
+		out.String(string(in.NextState))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ImportTaskHistory) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2186(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ImportTaskHistory) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2186(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ImportTaskHistory) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2186(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ImportTaskHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2186(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2187(in *jlexer.Lexer, out *ImportTask) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "path":
+			out.Path = string(in.String())
+		case "mode":
+			out.Mode = ImportMode(in.String())
+		case "state":
+			out.State = string(in.String())
+		case "size":
+			out.Size = int64(in.Int64())
+		case "history":
+			if in.IsNull() {
+				in.Skip()
+				out.History = nil
+			} else {
+				in.Delim('[')
+				if out.History == nil {
+					if !in.IsDelim(']') {
+						out.History = make([]*ImportTaskHistory, 0, 8)
+					} else {
+						out.History = []*ImportTaskHistory{}
+					}
+				} else {
+					out.History = (out.History)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v386 *ImportTaskHistory
+					if in.IsNull() {
+						in.Skip()
+						v386 = nil
+					} else {
+						if v386 == nil {
+							v386 = new(ImportTaskHistory)
+						}
+						(*v386).UnmarshalEasyJSON(in)
+					}
+					out.History = append(out.History, v386)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2187(out *jwriter.Writer, in ImportTask) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"path\":"
+		out.RawString(prefix)
+		out.String(string(in.Path))
+	}
+	{
+		const prefix string = ",\"mode\":"
+		out.RawString(prefix)
+		out.String(string(in.Mode))
+	}
+	{
+		const prefix string = ",\"state\":"
+		out.RawString(prefix)
+		out.String(string(in.State))
+	}
+	if in.Size != 0 {
+		const prefix string = ",\"size\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Size))
+	}
+	{
+		const prefix string = ",\"history\":"
+		out.RawString(prefix)
+		if in.History == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v387, v388 := range in.History {
+				if v387 > 0 {
+					out.RawByte(',')
+				}
+				if v388 == nil {
+					out.RawString("null")
+				} else {
+					(*v388).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ImportTask) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2187(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ImportTask) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2187(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ImportTask) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2187(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ImportTask) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2187(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2188(in *jlexer.Lexer, out *ImageSize) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "width":
+			out.Width = int(in.Int())
+		case "height":
+			out.Height = int(in.Int())
+		case "resize":
+			out.Resize = ImageResize(in.String())
+		case "crop":
+			out.Crop = ImageCrop(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2188(out *jwriter.Writer, in ImageSize) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Width != 0 {
+		const prefix string = ",\"width\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Int(int(in.Width))
+	}
+	if in.Height != 0 {
+		const prefix string = ",\"height\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.Height))
+	}
+	if in.Resize != "" {
+		const prefix string = ",\"resize\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Resize))
+	}
+	if in.Crop != "" {
+		const prefix string = ",\"crop\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Crop))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ImageSize) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2188(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ImageSize) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2188(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ImageSize) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2188(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ImageSize) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2188(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2189(in *jlexer.Lexer, out *HuaweiPushConfig) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "huawei_app_id":
+			out.HuaweiAppID = string(in.String())
+		case "huawei_app_secret":
+			out.HuaweiAppSecret = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2189(out *jwriter.Writer, in HuaweiPushConfig) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.HuaweiAppID != "" {
+		const prefix string = ",\"huawei_app_id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.HuaweiAppID))
+	}
+	if in.HuaweiAppSecret != "" {
+		const prefix string = ",\"huawei_app_secret\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.HuaweiAppSecret))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HuaweiPushConfig) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2189(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HuaweiPushConfig) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2189(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HuaweiPushConfig) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2189(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HuaweiPushConfig) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2189(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2190(in *jlexer.Lexer, out *HuaweiConfig) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "enabled":
+			out.Enabled = bool(in.Bool())
+		case "id":
+			out.ID = string(in.String())
+		case "secret":
+			out.Secret = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2190(out *jwriter.Writer, in HuaweiConfig) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"enabled\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Enabled))
+	}
+	if in.ID != "" {
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.String(string(in.ID))
+	}
+	if in.Secret != "" {
+		const prefix string = ",\"secret\":"
+		out.RawString(prefix)
+		out.String(string(in.Secret))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HuaweiConfig) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2190(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HuaweiConfig) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2190(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HuaweiConfig) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2190(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HuaweiConfig) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2190(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2191(in *jlexer.Lexer, out *GetRateLimitsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "server_side":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.ServerSide = make(RateLimitsMap)
+				} else {
+					out.ServerSide = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v389 RateLimitInfo
+					(v389).UnmarshalEasyJSON(in)
+					(out.ServerSide)[key] = v389
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "android":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Android = make(RateLimitsMap)
+				} else {
+					out.Android = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v390 RateLimitInfo
+					(v390).UnmarshalEasyJSON(in)
+					(out.Android)[key] = v390
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "ios":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.IOS = make(RateLimitsMap)
+				} else {
+					out.IOS = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v391 RateLimitInfo
+					(v391).UnmarshalEasyJSON(in)
+					(out.IOS)[key] = v391
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "web":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Web = make(RateLimitsMap)
+				} else {
+					out.Web = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v392 RateLimitInfo
+					(v392).UnmarshalEasyJSON(in)
+					(out.Web)[key] = v392
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2191(out *jwriter.Writer, in GetRateLimitsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if len(in.ServerSide) != 0 {
+		const prefix string = ",\"server_side\":"
+		first = false
+		out.RawString(prefix[1:])
+		{
+			out.RawByte('{')
+			v393First := true
+			for v393Name, v393Value := range in.ServerSide {
+				if v393First {
+					v393First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v393Name))
+				out.RawByte(':')
+				(v393Value).MarshalEasyJSON(out)
+			}
+			out.RawByte('}')
+		}
+	}
+	if len(in.Android) != 0 {
+		const prefix string = ",\"android\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('{')
+			v394First := true
+			for v394Name, v394Value := range in.Android {
+				if v394First {
+					v394First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v394Name))
+				out.RawByte(':')
+				(v394Value).MarshalEasyJSON(out)
+			}
+			out.RawByte('}')
+		}
+	}
+	if len(in.IOS) != 0 {
+		const prefix string = ",\"ios\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('{')
+			v395First := true
+			for v395Name, v395Value := range in.IOS {
+				if v395First {
+					v395First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v395Name))
+				out.RawByte(':')
+				(v395Value).MarshalEasyJSON(out)
+			}
+			out.RawByte('}')
+		}
+	}
+	if len(in.Web) != 0 {
+		const prefix string = ",\"web\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('{')
+			v396First := true
+			for v396Name, v396Value := range in.Web {
+				if v396First {
+					v396First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v396Name))
+				out.RawByte(':')
+				(v396Value).MarshalEasyJSON(out)
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GetRateLimitsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2191(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v GetRateLimitsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2191(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GetRateLimitsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2191(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *GetRateLimitsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2191(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2192(in *jlexer.Lexer, out *GetRateLimitsOptions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ServerSide":
+			out.ServerSide = bool(in.Bool())
+		case "Android":
+			out.Android = bool(in.Bool())
+		case "IOS":
+			out.IOS = bool(in.Bool())
+		case "Web":
+			out.Web = bool(in.Bool())
+		case "Endpoints":
+			if in.IsNull() {
+				in.Skip()
+				out.Endpoints = nil
+			} else {
+				in.Delim('[')
+				if out.Endpoints == nil {
+					if !in.IsDelim(']') {
+						out.Endpoints = make([]string, 0, 4)
+					} else {
+						out.Endpoints = []string{}
+					}
+				} else {
+					out.Endpoints = (out.Endpoints)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v397 string
+					v397 = string(in.String())
+					out.Endpoints = append(out.Endpoints, v397)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2192(out *jwriter.Writer, in GetRateLimitsOptions) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ServerSide\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.ServerSide))
+	}
+	{
+		const prefix string = ",\"Android\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Android))
+	}
+	{
+		const prefix string = ",\"IOS\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IOS))
+	}
+	{
+		const prefix string = ",\"Web\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Web))
+	}
+	{
+		const prefix string = ",\"Endpoints\":"
+		out.RawString(prefix)
+		if in.Endpoints == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v398, v399 := range in.Endpoints {
+				if v398 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v399))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GetRateLimitsOptions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2192(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v GetRateLimitsOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2192(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GetRateLimitsOptions) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2192(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *GetRateLimitsOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2192(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2193(in *jlexer.Lexer, out *FlagReport) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "message":
+			if in.IsNull() {
+				in.Skip()
+				out.Message = nil
+			} else {
+				if out.Message == nil {
+					out.Message = new(Message)
+				}
+				(*out.Message).UnmarshalEasyJSON(in)
+			}
+		case "message_user_id":
+			out.MessageUserID = string(in.String())
+		case "flags_count":
+			out.FlagsCount = int(in.Int())
+		case "review_result":
+			out.ReviewResult = string(in.String())
+		case "review_details":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.ReviewDetails = make(map[string]interface{})
+				} else {
+					out.ReviewDetails = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v400 interface{}
+					if m, ok := v400.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v400.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v400 = in.Interface()
+					}
+					(out.ReviewDetails)[key] = v400
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "reviewed_by":
+			if in.IsNull() {
+				in.Skip()
+				out.ReviewedBy = nil
+			} else {
+				if out.ReviewedBy == nil {
+					out.ReviewedBy = new(User)
+				}
+				(*out.ReviewedBy).UnmarshalEasyJSON(in)
+			}
+		case "reviewed_at":
+			if in.IsNull() {
+				in.Skip()
+				out.ReviewedAt = nil
+			} else {
+				if out.ReviewedAt == nil {
+					out.ReviewedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ReviewedAt).UnmarshalJSON(data))
+				}
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2193(out *jwriter.Writer, in FlagReport) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"message\":"
+		out.RawString(prefix)
+		if in.Message == nil {
+			out.RawString("null")
+		} else {
+			(*in.Message).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"message_user_id\":"
+		out.RawString(prefix)
+		out.String(string(in.MessageUserID))
+	}
+	{
+		const prefix string = ",\"flags_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.FlagsCount))
+	}
+	if in.ReviewResult != "" {
+		const prefix string = ",\"review_result\":"
+		out.RawString(prefix)
+		out.String(string(in.ReviewResult))
+	}
+	if len(in.ReviewDetails) != 0 {
+		const prefix string = ",\"review_details\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v401First := true
+			for v401Name, v401Value := range in.ReviewDetails {
+				if v401First {
+					v401First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v401Name))
+				out.RawByte(':')
+				if m, ok := v401Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v401Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v401Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	if in.ReviewedBy != nil {
+		const prefix string = ",\"reviewed_by\":"
+		out.RawString(prefix)
+		(*in.ReviewedBy).MarshalEasyJSON(out)
+	}
+	if in.ReviewedAt != nil {
+		const prefix string = ",\"reviewed_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.ReviewedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FlagReport) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2193(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FlagReport) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2193(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FlagReport) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2193(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FlagReport) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2193(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2194(in *jlexer.Lexer, out *FlagOptions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "UserID":
+			out.UserID = string(in.String())
+		case "Reason":
+			out.Reason = string(in.String())
+		case "Custom":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Custom = make(map[string]interface{})
+				} else {
+					out.Custom = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v402 interface{}
+					if m, ok := v402.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v402.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v402 = in.Interface()
+					}
+					(out.Custom)[key] = v402
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2194(out *jwriter.Writer, in FlagOptions) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"UserID\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.UserID))
+	}
+	{
+		const prefix string = ",\"Reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	{
+		const prefix string = ",\"Custom\":"
+		out.RawString(prefix)
+		if in.Custom == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v403First := true
+			for v403Name, v403Value := range in.Custom {
+				if v403First {
+					v403First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v403Name))
+				out.RawByte(':')
+				if m, ok := v403Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v403Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v403Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FlagOptions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2194(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FlagOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2194(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FlagOptions) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2194(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FlagOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2194(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2195(in *jlexer.Lexer, out *FirebasePushConfig) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "firebase_credentials":
+			out.FirebaseCredentials = string(in.String())
+		case "firebase_notification_template":
+			out.FirebaseNotificationTemplate = string(in.String())
+		case "firebase_apn_template":
+			out.FirebaseAPNTemplate = string(in.String())
+		case "firebase_data_template":
+			out.FirebaseDataTemplate = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2195(out *jwriter.Writer, in FirebasePushConfig) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.FirebaseCredentials != "" {
+		const prefix string = ",\"firebase_credentials\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.FirebaseCredentials))
+	}
+	if in.FirebaseNotificationTemplate != "" {
+		const prefix string = ",\"firebase_notification_template\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.FirebaseNotificationTemplate))
+	}
+	if in.FirebaseAPNTemplate != "" {
+		const prefix string = ",\"firebase_apn_template\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.FirebaseAPNTemplate))
+	}
+	if in.FirebaseDataTemplate != "" {
+		const prefix string = ",\"firebase_data_template\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.FirebaseDataTemplate))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FirebasePushConfig) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2195(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FirebasePushConfig) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2195(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FirebasePushConfig) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2195(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FirebasePushConfig) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2195(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2196(in *jlexer.Lexer, out *FirebaseConfig) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "enabled":
+			out.Enabled = bool(in.Bool())
+		case "notification_template":
+			out.NotificationTemplate = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2196(out *jwriter.Writer, in FirebaseConfig) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"enabled\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Enabled))
+	}
+	{
+		const prefix string = ",\"notification_template\":"
+		out.RawString(prefix)
+		out.String(string(in.NotificationTemplate))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FirebaseConfig) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2196(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FirebaseConfig) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2196(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FirebaseConfig) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2196(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FirebaseConfig) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2196(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2197(in *jlexer.Lexer, out *ExportedMessage) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "message":
+			if in.IsNull() {
+				in.Skip()
+				out.Message = nil
+			} else {
+				if out.Message == nil {
+					out.Message = new(Message)
+				}
+				(*out.Message).UnmarshalEasyJSON(in)
+			}
+		case "reactions":
+			if in.IsNull() {
+				in.Skip()
+				out.Reactions = nil
+			} else {
+				in.Delim('[')
+				if out.Reactions == nil {
+					if !in.IsDelim(']') {
+						out.Reactions = make([]*Reaction, 0, 8)
+					} else {
+						out.Reactions = []*Reaction{}
+					}
+				} else {
+					out.Reactions = (out.Reactions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v404 *Reaction
+					if in.IsNull() {
+						in.Skip()
+						v404 = nil
+					} else {
+						if v404 == nil {
+							v404 = new(Reaction)
+						}
+						(*v404).UnmarshalEasyJSON(in)
+					}
+					out.Reactions = append(out.Reactions, v404)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2197(out *jwriter.Writer, in ExportedMessage) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"message\":"
+		out.RawString(prefix[1:])
+		if in.Message == nil {
+			out.RawString("null")
+		} else {
+			(*in.Message).MarshalEasyJSON(out)
+		}
+	}
+	if len(in.Reactions) != 0 {
+		const prefix string = ",\"reactions\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v405, v406 := range in.Reactions {
+				if v405 > 0 {
+					out.RawByte(',')
+				}
+				if v406 == nil {
+					out.RawString("null")
+				} else {
+					(*v406).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ExportedMessage) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2197(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ExportedMessage) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2197(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ExportedMessage) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2197(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ExportedMessage) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2197(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2198(in *jlexer.Lexer, out *ExportableChannel) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "id":
+			out.ID = string(in.String())
+		case "messages_since":
+			if in.IsNull() {
+				in.Skip()
+				out.MessagesSince = nil
+			} else {
+				if out.MessagesSince == nil {
+					out.MessagesSince = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.MessagesSince).UnmarshalJSON(data))
+				}
+			}
+		case "messages_until":
+			if in.IsNull() {
+				in.Skip()
+				out.MessagesUntil = nil
+			} else {
+				if out.MessagesUntil == nil {
+					out.MessagesUntil = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.MessagesUntil).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2198(out *jwriter.Writer, in ExportableChannel) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.String(string(in.ID))
+	}
+	if in.MessagesSince != nil {
+		const prefix string = ",\"messages_since\":"
+		out.RawString(prefix)
+		out.Raw((*in.MessagesSince).MarshalJSON())
+	}
+	if in.MessagesUntil != nil {
+		const prefix string = ",\"messages_until\":"
+		out.RawString(prefix)
+		out.Raw((*in.MessagesUntil).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ExportableChannel) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2198(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ExportableChannel) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2198(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ExportableChannel) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2198(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ExportableChannel) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2198(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2199(in *jlexer.Lexer, out *ExportChannelsStatus) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "task_id":
+			out.TaskID = string(in.String())
+		case "status":
+			out.Status = string(in.String())
+		case "result":
+			if in.IsNull() {
+				in.Skip()
+				out.Result = nil
+			} else {
+				if out.Result == nil {
+					out.Result = new(ExportChannelsResult)
+				}
+				(*out.Result).UnmarshalEasyJSON(in)
+			}
+		case "error":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Error = make(map[string]interface{})
+				} else {
+					out.Error = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v407 interface{}
+					if m, ok := v407.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v407.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v407 = in.Interface()
+					}
+					(out.Error)[key] = v407
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2199(out *jwriter.Writer, in ExportChannelsStatus) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"task_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.TaskID))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	if in.Result != nil {
+		const prefix string = ",\"result\":"
+		out.RawString(prefix)
+		(*in.Result).MarshalEasyJSON(out)
+	}
+	if len(in.Error) != 0 {
+		const prefix string = ",\"error\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v408First := true
+			for v408Name, v408Value := range in.Error {
+				if v408First {
+					v408First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v408Name))
+				out.RawByte(':')
+				if m, ok := v408Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v408Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v408Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ExportChannelsStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2199(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ExportChannelsStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2199(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ExportChannelsStatus) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2199(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ExportChannelsStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2199(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2200(in *jlexer.Lexer, out *ExportChannelsResult) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "url":
+			out.URL = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2200(out *jwriter.Writer, in ExportChannelsResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.URL))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ExportChannelsResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2200(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ExportChannelsResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2200(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ExportChannelsResult) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2200(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ExportChannelsResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2200(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2201(in *jlexer.Lexer, out *ExportChannelOptions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "clear_deleted_message_text":
+			out.ClearDeletedMessageText = bool(in.Bool())
+		case "include_truncated_messages":
+			out.IncludeTruncatedMessages = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2201(out *jwriter.Writer, in ExportChannelOptions) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.ClearDeletedMessageText {
+		const prefix string = ",\"clear_deleted_message_text\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.ClearDeletedMessageText))
+	}
+	if in.IncludeTruncatedMessages {
+		const prefix string = ",\"include_truncated_messages\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.IncludeTruncatedMessages))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ExportChannelOptions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2201(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ExportChannelOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2201(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ExportChannelOptions) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2201(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ExportChannelOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2201(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2202(in *jlexer.Lexer, out *Event) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	for key := range out.ExtraData {
+		delete(out.ExtraData, key)
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "cid":
+			out.CID = string(in.String())
+		case "type":
+			out.Type = EventType(in.String())
+		case "message":
+			if in.IsNull() {
+				in.Skip()
+				out.Message = nil
+			} else {
+				if out.Message == nil {
+					out.Message = new(Message)
+				}
+				(*out.Message).UnmarshalEasyJSON(in)
+			}
+		case "reaction":
+			if in.IsNull() {
+				in.Skip()
+				out.Reaction = nil
+			} else {
+				if out.Reaction == nil {
+					out.Reaction = new(Reaction)
+				}
+				(*out.Reaction).UnmarshalEasyJSON(in)
+			}
+		case "channel":
+			if in.IsNull() {
+				in.Skip()
+				out.Channel = nil
+			} else {
+				if out.Channel == nil {
+					out.Channel = new(Channel)
+				}
+				(*out.Channel).UnmarshalEasyJSON(in)
+			}
+		case "member":
+			if in.IsNull() {
+				in.Skip()
+				out.Member = nil
+			} else {
+				if out.Member == nil {
+					out.Member = new(ChannelMember)
+				}
+				(*out.Member).UnmarshalEasyJSON(in)
+			}
+		case "user":
+			if in.IsNull() {
+				in.Skip()
+				out.User = nil
+			} else {
+				if out.User == nil {
+					out.User = new(User)
+				}
+				(*out.User).UnmarshalEasyJSON(in)
+			}
+		case "user_id":
+			out.UserID = string(in.String())
+		case "me":
+			if in.IsNull() {
+				in.Skip()
+				out.OwnUser = nil
+			} else {
+				if out.OwnUser == nil {
+					out.OwnUser = new(User)
+				}
+				(*out.OwnUser).UnmarshalEasyJSON(in)
+			}
+		case "watcher_count":
+			out.WatcherCount = int(in.Int())
+		case "parent_id":
+			out.ParentID = string(in.String())
+		case "connection_id":
+			out.ConnectionID = string(in.String())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			if out.ExtraData == nil {
+				out.ExtraData = make(map[string]interface{})
+			}
+			out.ExtraData[key] = in.Interface()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2202(out *jwriter.Writer, in Event) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.CID != "" {
+		const prefix string = ",\"cid\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.CID))
+	}
+	{
+		const prefix string = ",\"type\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Type))
+	}
+	if in.Message != nil {
+		const prefix string = ",\"message\":"
+		out.RawString(prefix)
+		(*in.Message).MarshalEasyJSON(out)
+	}
+	if in.Reaction != nil {
+		const prefix string = ",\"reaction\":"
+		out.RawString(prefix)
+		(*in.Reaction).MarshalEasyJSON(out)
+	}
+	if in.Channel != nil {
+		const prefix string = ",\"channel\":"
+		out.RawString(prefix)
+		(*in.Channel).MarshalEasyJSON(out)
+	}
+	if in.Member != nil {
+		const prefix string = ",\"member\":"
+		out.RawString(prefix)
+		(*in.Member).MarshalEasyJSON(out)
+	}
+	if in.User != nil {
+		const prefix string = ",\"user\":"
+		out.RawString(prefix)
+		(*in.User).MarshalEasyJSON(out)
+	}
+	if in.UserID != "" {
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.String(string(in.UserID))
+	}
+	if in.OwnUser != nil {
+		const prefix string = ",\"me\":"
+		out.RawString(prefix)
+		(*in.OwnUser).MarshalEasyJSON(out)
+	}
+	if in.WatcherCount != 0 {
+		const prefix string = ",\"watcher_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.WatcherCount))
+	}
+	if in.ParentID != "" {
+		const prefix string = ",\"parent_id\":"
+		out.RawString(prefix)
+		out.String(string(in.ParentID))
+	}
+	if in.ConnectionID != "" {
+		const prefix string = ",\"connection_id\":"
+		out.RawString(prefix)
+		out.String(string(in.ConnectionID))
+	}
+	if true {
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	for k, v := range in.ExtraData {
+		switch k {
+		case "cid", "type", "message", "reaction", "channel", "member", "user", "user_id", "me", "watcher_count", "parent_id", "connection_id", "created_at":
+			continue // don't allow field overwrites
+		}
+		out.RawByte(',')
+		out.String(string(k))
+		out.RawByte(':')
+		if m, ok := v.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := v.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(v))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Event) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2202(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Event) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2202(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Event) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2202(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Event) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2202(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2203(in *jlexer.Lexer, out *Device) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "user_id":
+			out.UserID = string(in.String())
+		case "push_provider":
+			out.PushProvider = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2203(out *jwriter.Writer, in Device) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.String(string(in.UserID))
+	}
+	{
+		const prefix string = ",\"push_provider\":"
+		out.RawString(prefix)
+		out.String(string(in.PushProvider))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Device) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2203(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Device) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2203(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Device) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2203(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Device) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2203(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2204(in *jlexer.Lexer, out *DeleteUserOptions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user":
+			out.User = DeleteType(in.String())
+		case "messages":
+			out.Messages = DeleteType(in.String())
+		case "conversations":
+			out.Conversations = DeleteType(in.String())
+		case "new_channel_owner_id":
+			out.NewChannelOwnerID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2204(out *jwriter.Writer, in DeleteUserOptions) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.User))
+	}
+	if in.Messages != "" {
+		const prefix string = ",\"messages\":"
+		out.RawString(prefix)
+		out.String(string(in.Messages))
+	}
+	if in.Conversations != "" {
+		const prefix string = ",\"conversations\":"
+		out.RawString(prefix)
+		out.String(string(in.Conversations))
+	}
+	if in.NewChannelOwnerID != "" {
+		const prefix string = ",\"new_channel_owner_id\":"
+		out.RawString(prefix)
+		out.String(string(in.NewChannelOwnerID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DeleteUserOptions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2204(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DeleteUserOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2204(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DeleteUserOptions) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2204(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DeleteUserOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2204(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2205(in *jlexer.Lexer, out *DeleteSingleUserOptions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "MarkMessagesDeleted":
+			out.MarkMessagesDeleted = bool(in.Bool())
+		case "HardDelete":
+			out.HardDelete = bool(in.Bool())
+		case "DeleteConversationChannels":
+			out.DeleteConversationChannels = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2205(out *jwriter.Writer, in DeleteSingleUserOptions) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"MarkMessagesDeleted\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.MarkMessagesDeleted))
+	}
+	{
+		const prefix string = ",\"HardDelete\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.HardDelete))
+	}
+	{
+		const prefix string = ",\"DeleteConversationChannels\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.DeleteConversationChannels))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DeleteSingleUserOptions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2205(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DeleteSingleUserOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2205(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DeleteSingleUserOptions) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2205(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DeleteSingleUserOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2205(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2206(in *jlexer.Lexer, out *DeactivateUserOptions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "mark_messages_deleted":
+			out.MarkMessagesDeleted = bool(in.Bool())
+		case "created_by_id":
+			out.CreatedByID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2206(out *jwriter.Writer, in DeactivateUserOptions) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.MarkMessagesDeleted {
+		const prefix string = ",\"mark_messages_deleted\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.MarkMessagesDeleted))
+	}
+	if in.CreatedByID != "" {
+		const prefix string = ",\"created_by_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.CreatedByID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DeactivateUserOptions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2206(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DeactivateUserOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2206(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DeactivateUserOptions) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2206(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DeactivateUserOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2206(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2207(in *jlexer.Lexer, out *CreateChannelOptions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Members":
+			if in.IsNull() {
+				in.Skip()
+				out.Members = nil
+			} else {
+				in.Delim('[')
+				if out.Members == nil {
+					if !in.IsDelim(']') {
+						out.Members = make([]string, 0, 4)
+					} else {
+						out.Members = []string{}
+					}
+				} else {
+					out.Members = (out.Members)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v409 string
+					v409 = string(in.String())
+					out.Members = append(out.Members, v409)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Team":
+			out.Team = string(in.String())
+		case "ExtraData":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.ExtraData = make(map[string]interface{})
+				} else {
+					out.ExtraData = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v410 interface{}
+					if m, ok := v410.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v410.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v410 = in.Interface()
+					}
+					(out.ExtraData)[key] = v410
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2207(out *jwriter.Writer, in CreateChannelOptions) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Members\":"
+		out.RawString(prefix[1:])
+		if in.Members == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v411, v412 := range in.Members {
+				if v411 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v412))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Team\":"
+		out.RawString(prefix)
+		out.String(string(in.Team))
+	}
+	{
+		const prefix string = ",\"ExtraData\":"
+		out.RawString(prefix)
+		if in.ExtraData == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v413First := true
+			for v413Name, v413Value := range in.ExtraData {
+				if v413First {
+					v413First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v413Name))
+				out.RawByte(':')
+				if m, ok := v413Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v413Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v413Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CreateChannelOptions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2207(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CreateChannelOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2207(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CreateChannelOptions) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2207(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CreateChannelOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2207(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2208(in *jlexer.Lexer, out *Command) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "args":
+			out.Args = string(in.String())
+		case "set":
+			out.Set = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2208(out *jwriter.Writer, in Command) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"args\":"
+		out.RawString(prefix)
+		out.String(string(in.Args))
+	}
+	{
+		const prefix string = ",\"set\":"
+		out.RawString(prefix)
+		out.String(string(in.Set))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Command) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2208(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Command) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2208(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Command) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2208(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Command) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2208(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2209(in *jlexer.Lexer, out *Client) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "BaseURL":
+			out.BaseURL = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2209(out *jwriter.Writer, in Client) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"BaseURL\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.BaseURL))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Client) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2209(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Client) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2209(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Client) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2209(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Client) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2209(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2210(in *jlexer.Lexer, out *CheckSQSRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "sqs_url":
+			out.SQSURL = string(in.String())
+		case "sqs_key":
+			out.SQSKey = string(in.String())
+		case "sqs_secret":
+			out.SQSSecret = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2210(out *jwriter.Writer, in CheckSQSRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.SQSURL != "" {
+		const prefix string = ",\"sqs_url\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.SQSURL))
+	}
+	if in.SQSKey != "" {
+		const prefix string = ",\"sqs_key\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.SQSKey))
+	}
+	if in.SQSSecret != "" {
+		const prefix string = ",\"sqs_secret\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.SQSSecret))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CheckSQSRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2210(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CheckSQSRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2210(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CheckSQSRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2210(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CheckSQSRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2210(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2211(in *jlexer.Lexer, out *CheckSNSRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "sns_topic_arn":
+			out.SNSTopicARN = string(in.String())
+		case "sns_key":
+			out.SNSKey = string(in.String())
+		case "sns_secret":
+			out.SNSSecret = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2211(out *jwriter.Writer, in CheckSNSRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.SNSTopicARN != "" {
+		const prefix string = ",\"sns_topic_arn\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.SNSTopicARN))
+	}
+	if in.SNSKey != "" {
+		const prefix string = ",\"sns_key\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.SNSKey))
+	}
+	if in.SNSSecret != "" {
+		const prefix string = ",\"sns_secret\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.SNSSecret))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CheckSNSRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2211(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CheckSNSRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2211(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CheckSNSRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2211(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CheckSNSRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2211(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2212(in *jlexer.Lexer, out *CheckResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = string(in.String())
+		case "error":
+			out.Error = string(in.String())
+		case "data":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Data = make(map[string]interface{})
+				} else {
+					out.Data = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v414 interface{}
+					if m, ok := v414.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v414.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v414 = in.Interface()
+					}
+					(out.Data)[key] = v414
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2212(out *jwriter.Writer, in CheckResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Status))
+	}
+	if in.Error != "" {
+		const prefix string = ",\"error\":"
+		out.RawString(prefix)
+		out.String(string(in.Error))
+	}
+	if len(in.Data) != 0 {
+		const prefix string = ",\"data\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v415First := true
+			for v415Name, v415Value := range in.Data {
+				if v415First {
+					v415First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v415Name))
+				out.RawByte(':')
+				if m, ok := v415Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v415Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v415Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CheckResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2212(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CheckResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2212(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CheckResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2212(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CheckResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2212(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2213(in *jlexer.Lexer, out *ChannelTypeModeration) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "automod":
+			out.Automod = modType(in.String())
+		case "automod_behavior":
+			out.AutomodBehavior = modBehaviour(in.String())
+		case "automod_thresholds":
+			if in.IsNull() {
+				in.Skip()
+				out.AutomodThresholds = nil
+			} else {
+				if out.AutomodThresholds == nil {
+					out.AutomodThresholds = new(Thresholds)
+				}
+				(*out.AutomodThresholds).UnmarshalEasyJSON(in)
+			}
+		case "blocklist":
+			out.Blocklist = string(in.String())
+		case "blocklist_behavior":
+			out.BlocklistBehavior = modBehaviour(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2213(out *jwriter.Writer, in ChannelTypeModeration) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Automod != "" {
+		const prefix string = ",\"automod\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Automod))
+	}
+	if in.AutomodBehavior != "" {
+		const prefix string = ",\"automod_behavior\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.AutomodBehavior))
+	}
+	if in.AutomodThresholds != nil {
+		const prefix string = ",\"automod_thresholds\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.AutomodThresholds).MarshalEasyJSON(out)
+	}
+	if in.Blocklist != "" {
+		const prefix string = ",\"blocklist\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Blocklist))
+	}
+	if in.BlocklistBehavior != "" {
+		const prefix string = ",\"blocklist_behavior\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.BlocklistBehavior))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChannelTypeModeration) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2213(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChannelTypeModeration) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2213(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChannelTypeModeration) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2213(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChannelTypeModeration) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2213(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2214(in *jlexer.Lexer, out *ChannelType) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "commands":
+			if in.IsNull() {
+				in.Skip()
+				out.Commands = nil
+			} else {
+				in.Delim('[')
+				if out.Commands == nil {
+					if !in.IsDelim(']') {
+						out.Commands = make([]*Command, 0, 8)
+					} else {
+						out.Commands = []*Command{}
+					}
+				} else {
+					out.Commands = (out.Commands)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v416 *Command
+					if in.IsNull() {
+						in.Skip()
+						v416 = nil
+					} else {
+						if v416 == nil {
+							v416 = new(Command)
+						}
+						(*v416).UnmarshalEasyJSON(in)
+					}
+					out.Commands = append(out.Commands, v416)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "permissions":
+			if in.IsNull() {
+				in.Skip()
+				out.Permissions = nil
+			} else {
+				in.Delim('[')
+				if out.Permissions == nil {
+					if !in.IsDelim(']') {
+						out.Permissions = make([]*Permission, 0, 8)
+					} else {
+						out.Permissions = []*Permission{}
+					}
+				} else {
+					out.Permissions = (out.Permissions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v417 *Permission
+					if in.IsNull() {
+						in.Skip()
+						v417 = nil
+					} else {
+						if v417 == nil {
+							v417 = new(Permission)
+						}
+						(*v417).UnmarshalEasyJSON(in)
+					}
+					out.Permissions = append(out.Permissions, v417)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		case "name":
+			out.Name = string(in.String())
+		case "typing_events":
+			out.TypingEvents = bool(in.Bool())
+		case "read_events":
+			out.ReadEvents = bool(in.Bool())
+		case "connect_events":
+			out.ConnectEvents = bool(in.Bool())
+		case "search":
+			out.Search = bool(in.Bool())
+		case "reactions":
+			out.Reactions = bool(in.Bool())
+		case "replies":
+			out.Replies = bool(in.Bool())
+		case "mutes":
+			out.Mutes = bool(in.Bool())
+		case "url_enrichment":
+			out.URLEnrichment = bool(in.Bool())
+		case "message_retention":
+			out.MessageRetention = string(in.String())
+		case "max_message_length":
+			out.MaxMessageLength = int(in.Int())
+		case "automod":
+			out.Automod = modType(in.String())
+		case "automod_behavior":
+			out.ModBehavior = modBehaviour(in.String())
+		case "automod_thresholds":
+			if in.IsNull() {
+				in.Skip()
+				out.AutomodThresholds = nil
+			} else {
+				if out.AutomodThresholds == nil {
+					out.AutomodThresholds = new(Thresholds)
+				}
+				(*out.AutomodThresholds).UnmarshalEasyJSON(in)
+			}
+		case "blocklist":
+			out.Blocklist = string(in.String())
+		case "blocklist_behavior":
+			out.BlocklistBehavior = modBehaviour(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2214(out *jwriter.Writer, in ChannelType) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"commands\":"
+		out.RawString(prefix[1:])
+		if in.Commands == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v418, v419 := range in.Commands {
+				if v418 > 0 {
+					out.RawByte(',')
+				}
+				if v419 == nil {
+					out.RawString("null")
+				} else {
+					(*v419).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"permissions\":"
+		out.RawString(prefix)
+		if in.Permissions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v420, v421 := range in.Permissions {
+				if v420 > 0 {
+					out.RawByte(',')
+				}
+				if v421 == nil {
+					out.RawString("null")
+				} else {
+					(*v421).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"typing_events\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.TypingEvents))
+	}
+	{
+		const prefix string = ",\"read_events\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ReadEvents))
+	}
+	{
+		const prefix string = ",\"connect_events\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ConnectEvents))
+	}
+	{
+		const prefix string = ",\"search\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Search))
+	}
+	{
+		const prefix string = ",\"reactions\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Reactions))
+	}
+	{
+		const prefix string = ",\"replies\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Replies))
+	}
+	{
+		const prefix string = ",\"mutes\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Mutes))
+	}
+	{
+		const prefix string = ",\"url_enrichment\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.URLEnrichment))
+	}
+	{
+		const prefix string = ",\"message_retention\":"
+		out.RawString(prefix)
+		out.String(string(in.MessageRetention))
+	}
+	{
+		const prefix string = ",\"max_message_length\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxMessageLength))
+	}
+	{
+		const prefix string = ",\"automod\":"
+		out.RawString(prefix)
+		out.String(string(in.Automod))
+	}
+	{
+		const prefix string = ",\"automod_behavior\":"
+		out.RawString(prefix)
+		out.String(string(in.ModBehavior))
+	}
+	if in.AutomodThresholds != nil {
+		const prefix string = ",\"automod_thresholds\":"
+		out.RawString(prefix)
+		(*in.AutomodThresholds).MarshalEasyJSON(out)
+	}
+	if in.Blocklist != "" {
+		const prefix string = ",\"blocklist\":"
+		out.RawString(prefix)
+		out.String(string(in.Blocklist))
+	}
+	if in.BlocklistBehavior != "" {
+		const prefix string = ",\"blocklist_behavior\":"
+		out.RawString(prefix)
+		out.String(string(in.BlocklistBehavior))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChannelType) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2214(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChannelType) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2214(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChannelType) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2214(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChannelType) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2214(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2215(in *jlexer.Lexer, out *ChannelRead) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user":
+			if in.IsNull() {
+				in.Skip()
+				out.User = nil
+			} else {
+				if out.User == nil {
+					out.User = new(User)
+				}
+				(*out.User).UnmarshalEasyJSON(in)
+			}
+		case "last_read":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.LastRead).UnmarshalJSON(data))
+			}
+		case "last_read_message_id":
+			out.LastReadMessageID = string(in.String())
+		case "unread_messages":
+			out.UnreadMessages = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2215(out *jwriter.Writer, in ChannelRead) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user\":"
+		out.RawString(prefix[1:])
+		if in.User == nil {
+			out.RawString("null")
+		} else {
+			(*in.User).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"last_read\":"
+		out.RawString(prefix)
+		out.Raw((in.LastRead).MarshalJSON())
+	}
+	if in.LastReadMessageID != "" {
+		const prefix string = ",\"last_read_message_id\":"
+		out.RawString(prefix)
+		out.String(string(in.LastReadMessageID))
+	}
+	{
+		const prefix string = ",\"unread_messages\":"
+		out.RawString(prefix)
+		out.Int(int(in.UnreadMessages))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChannelRead) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2215(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChannelRead) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2215(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChannelRead) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2215(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChannelRead) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2215(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2216(in *jlexer.Lexer, out *ChannelQueryRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "state":
+			out.State = bool(in.Bool())
+		case "presence":
+			out.Presence = bool(in.Bool())
+		case "messages":
+			if in.IsNull() {
+				in.Skip()
+				out.Messages = nil
+			} else {
+				if out.Messages == nil {
+					out.Messages = new(MessagePaginationParams)
+				}
+				(*out.Messages).UnmarshalEasyJSON(in)
+			}
+		case "members":
+			if in.IsNull() {
+				in.Skip()
+				out.Members = nil
+			} else {
+				if out.Members == nil {
+					out.Members = new(PaginationParams)
+				}
+				(*out.Members).UnmarshalEasyJSON(in)
+			}
+		case "watchers":
+			if in.IsNull() {
+				in.Skip()
+				out.Watchers = nil
+			} else {
+				if out.Watchers == nil {
+					out.Watchers = new(PaginationParams)
+				}
+				(*out.Watchers).UnmarshalEasyJSON(in)
+			}
+		case "data":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Data = make(map[string]interface{})
+				} else {
+					out.Data = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v422 interface{}
+					if m, ok := v422.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v422.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v422 = in.Interface()
+					}
+					(out.Data)[key] = v422
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2216(out *jwriter.Writer, in ChannelQueryRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"state\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.State))
+	}
+	{
+		const prefix string = ",\"presence\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Presence))
+	}
+	if in.Messages != nil {
+		const prefix string = ",\"messages\":"
+		out.RawString(prefix)
+		(*in.Messages).MarshalEasyJSON(out)
+	}
+	if in.Members != nil {
+		const prefix string = ",\"members\":"
+		out.RawString(prefix)
+		(*in.Members).MarshalEasyJSON(out)
+	}
+	if in.Watchers != nil {
+		const prefix string = ",\"watchers\":"
+		out.RawString(prefix)
+		(*in.Watchers).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"data\":"
+		out.RawString(prefix)
+		if in.Data == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v423First := true
+			for v423Name, v423Value := range in.Data {
+				if v423First {
+					v423First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v423Name))
+				out.RawByte(':')
+				if m, ok := v423Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v423Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v423Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChannelQueryRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2216(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChannelQueryRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2216(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChannelQueryRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2216(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChannelQueryRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2216(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2217(in *jlexer.Lexer, out *ChannelMute) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user":
+			(out.User).UnmarshalEasyJSON(in)
+		case "channel":
+			(out.Channel).UnmarshalEasyJSON(in)
+		case "expires":
+			if in.IsNull() {
+				in.Skip()
+				out.Expires = nil
+			} else {
+				if out.Expires == nil {
+					out.Expires = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.Expires).UnmarshalJSON(data))
+				}
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2217(out *jwriter.Writer, in ChannelMute) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user\":"
+		out.RawString(prefix[1:])
+		(in.User).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"channel\":"
+		out.RawString(prefix)
+		(in.Channel).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"expires\":"
+		out.RawString(prefix)
+		if in.Expires == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.Expires).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChannelMute) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2217(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChannelMute) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2217(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChannelMute) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2217(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChannelMute) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2217(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2218(in *jlexer.Lexer, out *ChannelMember) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	for key := range out.ExtraData {
+		delete(out.ExtraData, key)
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = string(in.String())
+		case "user":
+			if in.IsNull() {
+				in.Skip()
+				out.User = nil
+			} else {
+				if out.User == nil {
+					out.User = new(User)
+				}
+				(*out.User).UnmarshalEasyJSON(in)
+			}
+		case "is_moderator":
+			out.IsModerator = bool(in.Bool())
+		case "invited":
+			out.Invited = bool(in.Bool())
+		case "invite_accepted_at":
+			if in.IsNull() {
+				in.Skip()
+				out.InviteAcceptedAt = nil
+			} else {
+				if out.InviteAcceptedAt == nil {
+					out.InviteAcceptedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.InviteAcceptedAt).UnmarshalJSON(data))
+				}
+			}
+		case "invite_rejected_at":
+			if in.IsNull() {
+				in.Skip()
+				out.InviteRejectedAt = nil
+			} else {
+				if out.InviteRejectedAt == nil {
+					out.InviteRejectedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.InviteRejectedAt).UnmarshalJSON(data))
+				}
+			}
+		case "role":
+			out.Role = string(in.String())
+		case "channel_role":
+			out.ChannelRole = string(in.String())
+		case "pinned_at":
+			if in.IsNull() {
+				in.Skip()
+				out.PinnedAt = nil
+			} else {
+				if out.PinnedAt == nil {
+					out.PinnedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.PinnedAt).UnmarshalJSON(data))
+				}
+			}
+		case "archived_at":
+			if in.IsNull() {
+				in.Skip()
+				out.ArchivedAt = nil
+			} else {
+				if out.ArchivedAt == nil {
+					out.ArchivedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ArchivedAt).UnmarshalJSON(data))
+				}
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			if out.ExtraData == nil {
+				out.ExtraData = make(map[string]interface{})
+			}
+			out.ExtraData[key] = in.Interface()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2218(out *jwriter.Writer, in ChannelMember) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.UserID != "" {
+		const prefix string = ",\"user_id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.UserID))
+	}
+	if in.User != nil {
+		const prefix string = ",\"user\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.User).MarshalEasyJSON(out)
+	}
+	if in.IsModerator {
+		const prefix string = ",\"is_moderator\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.IsModerator))
+	}
+	if in.Invited {
+		const prefix string = ",\"invited\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.Invited))
+	}
+	if in.InviteAcceptedAt != nil {
+		const prefix string = ",\"invite_accepted_at\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.InviteAcceptedAt).MarshalJSON())
+	}
+	if in.InviteRejectedAt != nil {
+		const prefix string = ",\"invite_rejected_at\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.InviteRejectedAt).MarshalJSON())
+	}
+	if in.Role != "" {
+		const prefix string = ",\"role\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Role))
+	}
+	if in.ChannelRole != "" {
+		const prefix string = ",\"channel_role\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.ChannelRole))
+	}
+	if in.PinnedAt != nil {
+		const prefix string = ",\"pinned_at\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.PinnedAt).MarshalJSON())
+	}
+	if in.ArchivedAt != nil {
+		const prefix string = ",\"archived_at\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.ArchivedAt).MarshalJSON())
+	}
+	if true {
+		const prefix string = ",\"created_at\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	if true {
+		const prefix string = ",\"updated_at\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	for k, v := range in.ExtraData {
+		switch k {
+		case "user_id", "user", "is_moderator", "invited", "invite_accepted_at", "invite_rejected_at", "role", "channel_role", "pinned_at", "archived_at", "created_at", "updated_at":
+			continue // don't allow field overwrites
+		}
+		if first {
+			first = false
+		} else {
+			out.RawByte(',')
+		}
+		out.String(string(k))
+		out.RawByte(':')
+		if m, ok := v.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := v.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(v))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChannelMember) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2218(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChannelMember) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2218(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChannelMember) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2218(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChannelMember) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2218(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2219(in *jlexer.Lexer, out *ChannelIterator) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2219(out *jwriter.Writer, in ChannelIterator) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChannelIterator) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2219(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChannelIterator) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2219(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChannelIterator) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2219(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChannelIterator) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2219(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2220(in *jlexer.Lexer, out *ChannelExportOptions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "PageSize":
+			out.PageSize = int(in.Int())
+		case "Since":
 			if in.IsNull() {
 				in.Skip()
-				out.UpdatedAt = nil
+				out.Since = nil
 			} else {
-				if out.UpdatedAt == nil {
-					out.UpdatedAt = new(time.Time)
+				if out.Since == nil {
+					out.Since = new(time.Time)
 				}
 				if data := in.Raw(); in.Ok() {
-					in.AddError((*out.UpdatedAt).UnmarshalJSON(data))
-				}
-			}
-		case "ExtraData":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				in.Delim('{')
-				if !in.IsDelim('}') {
-					out.ExtraData = make(map[string]interface{})
-				} else {
-					out.ExtraData = nil
-				}
-				for !in.IsDelim('}') {
-					key := string(in.String())
-					in.WantColon()
-					var v96 interface{}
-					if m, ok := v96.(easyjson.Unmarshaler); ok {
-						m.UnmarshalEasyJSON(in)
-					} else if m, ok := v96.(json.Unmarshaler); ok {
-						_ = m.UnmarshalJSON(in.Raw())
-					} else {
-						v96 = in.Interface()
-					}
-					(out.ExtraData)[key] = v96
-					in.WantComma()
+					in.AddError((*out.Since).UnmarshalJSON(data))
 				}
-				in.Delim('}')
 			}
+		case "IncludeReactions":
+			out.IncludeReactions = bool(in.Bool())
+		case "IncludeReplies":
+			out.IncludeReplies = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -4927,219 +27611,61 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo39(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo39(out *jwriter.Writer, in Message) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2220(out *jwriter.Writer, in ChannelExportOptions) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"PageSize\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.ID))
-	}
-	{
-		const prefix string = ",\"text\":"
-		out.RawString(prefix)
-		out.String(string(in.Text))
-	}
-	{
-		const prefix string = ",\"html\":"
-		out.RawString(prefix)
-		out.String(string(in.HTML))
-	}
-	if in.Type != "" {
-		const prefix string = ",\"type\":"
-		out.RawString(prefix)
-		out.String(string(in.Type))
-	}
-	{
-		const prefix string = ",\"user\":"
-		out.RawString(prefix)
-		if in.User == nil {
-			out.RawString("null")
-		} else {
-			(*in.User).MarshalEasyJSON(out)
-		}
-	}
-	{
-		const prefix string = ",\"attachments\":"
-		out.RawString(prefix)
-		if in.Attachments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v97, v98 := range in.Attachments {
-				if v97 > 0 {
-					out.RawByte(',')
-				}
-				if v98 == nil {
-					out.RawString("null")
-				} else {
-					(*v98).MarshalEasyJSON(out)
-				}
-			}
-			out.RawByte(']')
-		}
-	}
-	{
-		const prefix string = ",\"latest_reactions\":"
-		out.RawString(prefix)
-		if in.LatestReactions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v99, v100 := range in.LatestReactions {
-				if v99 > 0 {
-					out.RawByte(',')
-				}
-				if v100 == nil {
-					out.RawString("null")
-				} else {
-					(*v100).MarshalEasyJSON(out)
-				}
-			}
-			out.RawByte(']')
-		}
+		out.Int(int(in.PageSize))
 	}
 	{
-		const prefix string = ",\"own_reactions\":"
+		const prefix string = ",\"Since\":"
 		out.RawString(prefix)
-		if in.OwnReactions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Since == nil {
 			out.RawString("null")
 		} else {
-			out.RawByte('[')
-			for v101, v102 := range in.OwnReactions {
-				if v101 > 0 {
-					out.RawByte(',')
-				}
-				if v102 == nil {
-					out.RawString("null")
-				} else {
-					(*v102).MarshalEasyJSON(out)
-				}
-			}
-			out.RawByte(']')
-		}
-	}
-	{
-		const prefix string = ",\"reaction_counts\":"
-		out.RawString(prefix)
-		if in.ReactionCounts == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
-			out.RawString(`null`)
-		} else {
-			out.RawByte('{')
-			v103First := true
-			for v103Name, v103Value := range in.ReactionCounts {
-				if v103First {
-					v103First = false
-				} else {
-					out.RawByte(',')
-				}
-				out.String(string(v103Name))
-				out.RawByte(':')
-				out.Int(int(v103Value))
-			}
-			out.RawByte('}')
+			out.Raw((*in.Since).MarshalJSON())
 		}
 	}
 	{
-		const prefix string = ",\"parent_id\":"
-		out.RawString(prefix)
-		out.String(string(in.ParentID))
-	}
-	{
-		const prefix string = ",\"show_in_channel\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.ShowInChannel))
-	}
-	if in.ReplyCount != 0 {
-		const prefix string = ",\"reply_count\":"
-		out.RawString(prefix)
-		out.Int(int(in.ReplyCount))
-	}
-	{
-		const prefix string = ",\"mentioned_users\":"
-		out.RawString(prefix)
-		if in.MentionedUsers == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v104, v105 := range in.MentionedUsers {
-				if v104 > 0 {
-					out.RawByte(',')
-				}
-				if v105 == nil {
-					out.RawString("null")
-				} else {
-					(*v105).MarshalEasyJSON(out)
-				}
-			}
-			out.RawByte(']')
-		}
-	}
-	if in.CreatedAt != nil {
-		const prefix string = ",\"created_at\":"
-		out.RawString(prefix)
-		out.Raw((*in.CreatedAt).MarshalJSON())
-	}
-	if in.UpdatedAt != nil {
-		const prefix string = ",\"updated_at\":"
+		const prefix string = ",\"IncludeReactions\":"
 		out.RawString(prefix)
-		out.Raw((*in.UpdatedAt).MarshalJSON())
+		out.Bool(bool(in.IncludeReactions))
 	}
 	{
-		const prefix string = ",\"ExtraData\":"
+		const prefix string = ",\"IncludeReplies\":"
 		out.RawString(prefix)
-		if in.ExtraData == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
-			out.RawString(`null`)
-		} else {
-			out.RawByte('{')
-			v106First := true
-			for v106Name, v106Value := range in.ExtraData {
-				if v106First {
-					v106First = false
-				} else {
-					out.RawByte(',')
-				}
-				out.String(string(v106Name))
-				out.RawByte(':')
-				if m, ok := v106Value.(easyjson.Marshaler); ok {
-					m.MarshalEasyJSON(out)
-				} else if m, ok := v106Value.(json.Marshaler); ok {
-					out.Raw(m.MarshalJSON())
-				} else {
-					out.Raw(json.Marshal(v106Value))
-				}
-			}
-			out.RawByte('}')
-		}
+		out.Bool(bool(in.IncludeReplies))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Message) MarshalJSON() ([]byte, error) {
+func (v ChannelExportOptions) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo39(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2220(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Message) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo39(w, v)
+func (v ChannelExportOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2220(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Message) UnmarshalJSON(data []byte) error {
+func (v *ChannelExportOptions) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo39(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2220(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Message) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo39(l, v)
+func (v *ChannelExportOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2220(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo40(in *jlexer.Lexer, out *FirebaseConfig) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2221(in *jlexer.Lexer, out *ChannelConfig) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5158,61 +27684,167 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo40(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "enabled":
-			out.Enabled = bool(in.Bool())
-		case "notification_template":
-			out.NotificationTemplate = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "typing_events":
+			out.TypingEvents = bool(in.Bool())
+		case "read_events":
+			out.ReadEvents = bool(in.Bool())
+		case "connect_events":
+			out.ConnectEvents = bool(in.Bool())
+		case "search":
+			out.Search = bool(in.Bool())
+		case "reactions":
+			out.Reactions = bool(in.Bool())
+		case "replies":
+			out.Replies = bool(in.Bool())
+		case "mutes":
+			out.Mutes = bool(in.Bool())
+		case "url_enrichment":
+			out.URLEnrichment = bool(in.Bool())
+		case "message_retention":
+			out.MessageRetention = string(in.String())
+		case "max_message_length":
+			out.MaxMessageLength = int(in.Int())
+		case "automod":
+			out.Automod = modType(in.String())
+		case "automod_behavior":
+			out.ModBehavior = modBehaviour(in.String())
+		case "automod_thresholds":
+			if in.IsNull() {
+				in.Skip()
+				out.AutomodThresholds = nil
+			} else {
+				if out.AutomodThresholds == nil {
+					out.AutomodThresholds = new(Thresholds)
+				}
+				(*out.AutomodThresholds).UnmarshalEasyJSON(in)
+			}
+		case "blocklist":
+			out.Blocklist = string(in.String())
+		case "blocklist_behavior":
+			out.BlocklistBehavior = modBehaviour(in.String())
 		default:
 			in.SkipRecursive()
 		}
 		in.WantComma()
 	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2221(out *jwriter.Writer, in ChannelConfig) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"typing_events\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.TypingEvents))
+	}
+	{
+		const prefix string = ",\"read_events\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ReadEvents))
+	}
+	{
+		const prefix string = ",\"connect_events\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ConnectEvents))
+	}
+	{
+		const prefix string = ",\"search\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Search))
+	}
+	{
+		const prefix string = ",\"reactions\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Reactions))
+	}
+	{
+		const prefix string = ",\"replies\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Replies))
+	}
+	{
+		const prefix string = ",\"mutes\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Mutes))
 	}
-}
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo40(out *jwriter.Writer, in FirebaseConfig) {
-	out.RawByte('{')
-	first := true
-	_ = first
 	{
-		const prefix string = ",\"enabled\":"
-		out.RawString(prefix[1:])
-		out.Bool(bool(in.Enabled))
+		const prefix string = ",\"url_enrichment\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.URLEnrichment))
 	}
 	{
-		const prefix string = ",\"notification_template\":"
+		const prefix string = ",\"message_retention\":"
 		out.RawString(prefix)
-		out.String(string(in.NotificationTemplate))
+		out.String(string(in.MessageRetention))
+	}
+	{
+		const prefix string = ",\"max_message_length\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxMessageLength))
+	}
+	{
+		const prefix string = ",\"automod\":"
+		out.RawString(prefix)
+		out.String(string(in.Automod))
+	}
+	{
+		const prefix string = ",\"automod_behavior\":"
+		out.RawString(prefix)
+		out.String(string(in.ModBehavior))
+	}
+	if in.AutomodThresholds != nil {
+		const prefix string = ",\"automod_thresholds\":"
+		out.RawString(prefix)
+		(*in.AutomodThresholds).MarshalEasyJSON(out)
+	}
+	if in.Blocklist != "" {
+		const prefix string = ",\"blocklist\":"
+		out.RawString(prefix)
+		out.String(string(in.Blocklist))
+	}
+	if in.BlocklistBehavior != "" {
+		const prefix string = ",\"blocklist_behavior\":"
+		out.RawString(prefix)
+		out.String(string(in.BlocklistBehavior))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v FirebaseConfig) MarshalJSON() ([]byte, error) {
+func (v ChannelConfig) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo40(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2221(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v FirebaseConfig) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo40(w, v)
+func (v ChannelConfig) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2221(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *FirebaseConfig) UnmarshalJSON(data []byte) error {
+func (v *ChannelConfig) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo40(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2221(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *FirebaseConfig) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo40(l, v)
+func (v *ChannelConfig) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2221(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo41(in *jlexer.Lexer, out *Event) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2222(in *jlexer.Lexer, out *Channel) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5221,6 +27853,9 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo41(in *jlexer.Lexer, ou
 		in.Skip()
 		return
 	}
+	for key := range out.ExtraData {
+		delete(out.ExtraData, key)
+	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
 		key := in.UnsafeString()
@@ -5231,80 +27866,189 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo41(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "type":
+			out.Type = string(in.String())
 		case "cid":
 			out.CID = string(in.String())
-		case "type":
-			out.Type = EventType(in.String())
-		case "message":
+		case "config":
+			(out.Config).UnmarshalEasyJSON(in)
+		case "created_by":
 			if in.IsNull() {
 				in.Skip()
-				out.Message = nil
+				out.CreatedBy = nil
 			} else {
-				if out.Message == nil {
-					out.Message = new(Message)
+				if out.CreatedBy == nil {
+					out.CreatedBy = new(User)
 				}
-				(*out.Message).UnmarshalEasyJSON(in)
+				(*out.CreatedBy).UnmarshalEasyJSON(in)
 			}
-		case "reaction":
+		case "frozen":
+			out.Frozen = bool(in.Bool())
+		case "team":
+			out.Team = string(in.String())
+		case "auto_translation_enabled":
+			out.AutoTranslationEnabled = bool(in.Bool())
+		case "auto_translation_language":
+			out.AutoTranslationLanguage = string(in.String())
+		case "member_count":
+			out.MemberCount = int(in.Int())
+		case "members":
 			if in.IsNull() {
 				in.Skip()
-				out.Reaction = nil
+				out.Members = nil
 			} else {
-				if out.Reaction == nil {
-					out.Reaction = new(Reaction)
+				in.Delim('[')
+				if out.Members == nil {
+					if !in.IsDelim(']') {
+						out.Members = make([]*ChannelMember, 0, 8)
+					} else {
+						out.Members = []*ChannelMember{}
+					}
+				} else {
+					out.Members = (out.Members)[:0]
 				}
-				(*out.Reaction).UnmarshalEasyJSON(in)
-			}
-		case "channel":
-			if in.IsNull() {
-				in.Skip()
-				out.Channel = nil
-			} else {
-				if out.Channel == nil {
-					out.Channel = new(Channel)
+				for !in.IsDelim(']') {
+					var v424 *ChannelMember
+					if in.IsNull() {
+						in.Skip()
+						v424 = nil
+					} else {
+						if v424 == nil {
+							v424 = new(ChannelMember)
+						}
+						(*v424).UnmarshalEasyJSON(in)
+					}
+					out.Members = append(out.Members, v424)
+					in.WantComma()
 				}
-				(*out.Channel).UnmarshalEasyJSON(in)
+				in.Delim(']')
 			}
-		case "member":
+		case "watcher_count":
+			out.WatcherCount = int(in.Int())
+		case "watchers":
 			if in.IsNull() {
 				in.Skip()
-				out.Member = nil
+				out.Watchers = nil
 			} else {
-				if out.Member == nil {
-					out.Member = new(ChannelMember)
+				in.Delim('[')
+				if out.Watchers == nil {
+					if !in.IsDelim(']') {
+						out.Watchers = make([]*User, 0, 8)
+					} else {
+						out.Watchers = []*User{}
+					}
+				} else {
+					out.Watchers = (out.Watchers)[:0]
 				}
-				(*out.Member).UnmarshalEasyJSON(in)
+				for !in.IsDelim(']') {
+					var v425 *User
+					if in.IsNull() {
+						in.Skip()
+						v425 = nil
+					} else {
+						if v425 == nil {
+							v425 = new(User)
+						}
+						(*v425).UnmarshalEasyJSON(in)
+					}
+					out.Watchers = append(out.Watchers, v425)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-		case "user":
+		case "messages":
 			if in.IsNull() {
 				in.Skip()
-				out.User = nil
+				out.Messages = nil
 			} else {
-				if out.User == nil {
-					out.User = new(User)
+				in.Delim('[')
+				if out.Messages == nil {
+					if !in.IsDelim(']') {
+						out.Messages = make([]*Message, 0, 8)
+					} else {
+						out.Messages = []*Message{}
+					}
+				} else {
+					out.Messages = (out.Messages)[:0]
 				}
-				(*out.User).UnmarshalEasyJSON(in)
+				for !in.IsDelim(']') {
+					var v426 *Message
+					if in.IsNull() {
+						in.Skip()
+						v426 = nil
+					} else {
+						if v426 == nil {
+							v426 = new(Message)
+						}
+						(*v426).UnmarshalEasyJSON(in)
+					}
+					out.Messages = append(out.Messages, v426)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-		case "user_id":
-			out.UserID = string(in.String())
-		case "me":
+		case "read":
 			if in.IsNull() {
 				in.Skip()
-				out.OwnUser = nil
+				out.Read = nil
 			} else {
-				if out.OwnUser == nil {
-					out.OwnUser = new(User)
+				in.Delim('[')
+				if out.Read == nil {
+					if !in.IsDelim(']') {
+						out.Read = make([]*ChannelRead, 0, 8)
+					} else {
+						out.Read = []*ChannelRead{}
+					}
+				} else {
+					out.Read = (out.Read)[:0]
 				}
-				(*out.OwnUser).UnmarshalEasyJSON(in)
+				for !in.IsDelim(']') {
+					var v427 *ChannelRead
+					if in.IsNull() {
+						in.Skip()
+						v427 = nil
+					} else {
+						if v427 == nil {
+							v427 = new(ChannelRead)
+						}
+						(*v427).UnmarshalEasyJSON(in)
+					}
+					out.Read = append(out.Read, v427)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-		case "watcher_count":
-			out.WatcherCount = int(in.Int())
 		case "created_at":
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.CreatedAt).UnmarshalJSON(data))
 			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		case "last_message_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.LastMessageAt).UnmarshalJSON(data))
+			}
+		case "deleted_at":
+			if in.IsNull() {
+				in.Skip()
+				out.DeletedAt = nil
+			} else {
+				if out.DeletedAt == nil {
+					out.DeletedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.DeletedAt).UnmarshalJSON(data))
+				}
+			}
 		default:
-			in.SkipRecursive()
+			if out.ExtraData == nil {
+				out.ExtraData = make(map[string]interface{})
+			}
+			out.ExtraData[key] = in.Interface()
 		}
 		in.WantComma()
 	}
@@ -5313,98 +28057,210 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo41(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo41(out *jwriter.Writer, in Event) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2222(out *jwriter.Writer, in Channel) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if in.CID != "" {
-		const prefix string = ",\"cid\":"
-		first = false
+	{
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.CID))
+		out.String(string(in.ID))
 	}
 	{
 		const prefix string = ",\"type\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"cid\":"
+		out.RawString(prefix)
+		out.String(string(in.CID))
+	}
+	{
+		const prefix string = ",\"config\":"
+		out.RawString(prefix)
+		(in.Config).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"created_by\":"
+		out.RawString(prefix)
+		if in.CreatedBy == nil {
+			out.RawString("null")
+		} else {
+			(*in.CreatedBy).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"frozen\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Frozen))
+	}
+	if in.Team != "" {
+		const prefix string = ",\"team\":"
+		out.RawString(prefix)
+		out.String(string(in.Team))
+	}
+	if in.AutoTranslationEnabled {
+		const prefix string = ",\"auto_translation_enabled\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.AutoTranslationEnabled))
+	}
+	if in.AutoTranslationLanguage != "" {
+		const prefix string = ",\"auto_translation_language\":"
+		out.RawString(prefix)
+		out.String(string(in.AutoTranslationLanguage))
+	}
+	{
+		const prefix string = ",\"member_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.MemberCount))
+	}
+	{
+		const prefix string = ",\"members\":"
+		out.RawString(prefix)
+		if in.Members == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
 		} else {
-			out.RawString(prefix)
+			out.RawByte('[')
+			for v428, v429 := range in.Members {
+				if v428 > 0 {
+					out.RawByte(',')
+				}
+				if v429 == nil {
+					out.RawString("null")
+				} else {
+					(*v429).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
 		}
-		out.String(string(in.Type))
 	}
-	if in.Message != nil {
-		const prefix string = ",\"message\":"
+	if in.WatcherCount != 0 {
+		const prefix string = ",\"watcher_count\":"
 		out.RawString(prefix)
-		(*in.Message).MarshalEasyJSON(out)
+		out.Int(int(in.WatcherCount))
 	}
-	if in.Reaction != nil {
-		const prefix string = ",\"reaction\":"
+	if len(in.Watchers) != 0 {
+		const prefix string = ",\"watchers\":"
 		out.RawString(prefix)
-		(*in.Reaction).MarshalEasyJSON(out)
+		{
+			out.RawByte('[')
+			for v430, v431 := range in.Watchers {
+				if v430 > 0 {
+					out.RawByte(',')
+				}
+				if v431 == nil {
+					out.RawString("null")
+				} else {
+					(*v431).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
 	}
-	if in.Channel != nil {
-		const prefix string = ",\"channel\":"
+	{
+		const prefix string = ",\"messages\":"
 		out.RawString(prefix)
-		(*in.Channel).MarshalEasyJSON(out)
+		if in.Messages == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v432, v433 := range in.Messages {
+				if v432 > 0 {
+					out.RawByte(',')
+				}
+				if v433 == nil {
+					out.RawString("null")
+				} else {
+					(*v433).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
 	}
-	if in.Member != nil {
-		const prefix string = ",\"member\":"
+	{
+		const prefix string = ",\"read\":"
 		out.RawString(prefix)
-		(*in.Member).MarshalEasyJSON(out)
+		if in.Read == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v434, v435 := range in.Read {
+				if v434 > 0 {
+					out.RawByte(',')
+				}
+				if v435 == nil {
+					out.RawString("null")
+				} else {
+					(*v435).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
 	}
-	if in.User != nil {
-		const prefix string = ",\"user\":"
+	{
+		const prefix string = ",\"created_at\":"
 		out.RawString(prefix)
-		(*in.User).MarshalEasyJSON(out)
+		out.Raw((in.CreatedAt).MarshalJSON())
 	}
-	if in.UserID != "" {
-		const prefix string = ",\"user_id\":"
+	{
+		const prefix string = ",\"updated_at\":"
 		out.RawString(prefix)
-		out.String(string(in.UserID))
+		out.Raw((in.UpdatedAt).MarshalJSON())
 	}
-	if in.OwnUser != nil {
-		const prefix string = ",\"me\":"
+	{
+		const prefix string = ",\"last_message_at\":"
 		out.RawString(prefix)
-		(*in.OwnUser).MarshalEasyJSON(out)
+		out.Raw((in.LastMessageAt).MarshalJSON())
 	}
-	if in.WatcherCount != 0 {
-		const prefix string = ",\"watcher_count\":"
+	if in.DeletedAt != nil {
+		const prefix string = ",\"deleted_at\":"
 		out.RawString(prefix)
-		out.Int(int(in.WatcherCount))
+		out.Raw((*in.DeletedAt).MarshalJSON())
 	}
-	if true {
-		const prefix string = ",\"created_at\":"
-		out.RawString(prefix)
-		out.Raw((in.CreatedAt).MarshalJSON())
+	for k, v := range in.ExtraData {
+		switch k {
+		case "id", "type", "cid", "config", "created_by", "frozen", "team", "auto_translation_enabled", "auto_translation_language", "member_count", "members", "watcher_count", "watchers", "messages", "read", "created_at", "updated_at", "last_message_at", "deleted_at":
+			continue // don't allow field overwrites
+		}
+		out.RawByte(',')
+		out.String(string(k))
+		out.RawByte(':')
+		if m, ok := v.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := v.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(v))
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Event) MarshalJSON() ([]byte, error) {
+func (v Channel) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo41(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2222(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Event) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo41(w, v)
+func (v Channel) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2222(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Event) UnmarshalJSON(data []byte) error {
+func (v *Channel) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo41(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2222(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Event) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo41(l, v)
+func (v *Channel) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2222(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo42(in *jlexer.Lexer, out *Device) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2223(in *jlexer.Lexer, out *CampaignStats) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5423,12 +28279,18 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo42(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = string(in.String())
-		case "user_id":
-			out.UserID = string(in.String())
-		case "push_provider":
-			out.PushProvider = string(in.String())
+		case "stats_progress":
+			out.Progress = float64(in.Float64())
+		case "stats_users_targeted":
+			out.UsersTargeted = int(in.Int())
+		case "stats_users_sent":
+			out.UsersSent = int(in.Int())
+		case "stats_users_read":
+			out.UsersRead = int(in.Int())
+		case "stats_messages_sent":
+			out.MessagesSent = int(in.Int())
+		case "stats_channels_created":
+			out.ChannelsCreated = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -5439,52 +28301,67 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo42(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo42(out *jwriter.Writer, in Device) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2223(out *jwriter.Writer, in CampaignStats) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"stats_progress\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.ID))
+		out.Float64(float64(in.Progress))
 	}
 	{
-		const prefix string = ",\"user_id\":"
+		const prefix string = ",\"stats_users_targeted\":"
 		out.RawString(prefix)
-		out.String(string(in.UserID))
+		out.Int(int(in.UsersTargeted))
 	}
 	{
-		const prefix string = ",\"push_provider\":"
+		const prefix string = ",\"stats_users_sent\":"
 		out.RawString(prefix)
-		out.String(string(in.PushProvider))
+		out.Int(int(in.UsersSent))
+	}
+	{
+		const prefix string = ",\"stats_users_read\":"
+		out.RawString(prefix)
+		out.Int(int(in.UsersRead))
+	}
+	{
+		const prefix string = ",\"stats_messages_sent\":"
+		out.RawString(prefix)
+		out.Int(int(in.MessagesSent))
+	}
+	{
+		const prefix string = ",\"stats_channels_created\":"
+		out.RawString(prefix)
+		out.Int(int(in.ChannelsCreated))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Device) MarshalJSON() ([]byte, error) {
+func (v CampaignStats) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo42(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2223(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Device) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo42(w, v)
+func (v CampaignStats) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2223(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Device) UnmarshalJSON(data []byte) error {
+func (v *CampaignStats) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo42(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2223(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Device) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo42(l, v)
+func (v *CampaignStats) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2223(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo43(in *jlexer.Lexer, out *Command) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2224(in *jlexer.Lexer, out *CampaignMessageTemplate) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5493,6 +28370,9 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo43(in *jlexer.Lexer, ou
 		in.Skip()
 		return
 	}
+	for key := range out.ExtraData {
+		delete(out.ExtraData, key)
+	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
 		key := in.UnsafeString()
@@ -5503,16 +28383,44 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo43(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "name":
-			out.Name = string(in.String())
-		case "description":
-			out.Description = string(in.String())
-		case "args":
-			out.Args = string(in.String())
-		case "set":
-			out.Set = string(in.String())
+		case "text":
+			out.Text = string(in.String())
+		case "attachments":
+			if in.IsNull() {
+				in.Skip()
+				out.Attachments = nil
+			} else {
+				in.Delim('[')
+				if out.Attachments == nil {
+					if !in.IsDelim(']') {
+						out.Attachments = make([]*Attachment, 0, 8)
+					} else {
+						out.Attachments = []*Attachment{}
+					}
+				} else {
+					out.Attachments = (out.Attachments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v436 *Attachment
+					if in.IsNull() {
+						in.Skip()
+						v436 = nil
+					} else {
+						if v436 == nil {
+							v436 = new(Attachment)
+						}
+						(*v436).UnmarshalEasyJSON(in)
+					}
+					out.Attachments = append(out.Attachments, v436)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
-			in.SkipRecursive()
+			if out.ExtraData == nil {
+				out.ExtraData = make(map[string]interface{})
+			}
+			out.ExtraData[key] = in.Interface()
 		}
 		in.WantComma()
 	}
@@ -5521,57 +28429,76 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo43(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo43(out *jwriter.Writer, in Command) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2224(out *jwriter.Writer, in CampaignMessageTemplate) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"text\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"description\":"
-		out.RawString(prefix)
-		out.String(string(in.Description))
+		out.String(string(in.Text))
 	}
-	{
-		const prefix string = ",\"args\":"
+	if len(in.Attachments) != 0 {
+		const prefix string = ",\"attachments\":"
 		out.RawString(prefix)
-		out.String(string(in.Args))
+		{
+			out.RawByte('[')
+			for v437, v438 := range in.Attachments {
+				if v437 > 0 {
+					out.RawByte(',')
+				}
+				if v438 == nil {
+					out.RawString("null")
+				} else {
+					(*v438).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
 	}
-	{
-		const prefix string = ",\"set\":"
-		out.RawString(prefix)
-		out.String(string(in.Set))
+	for k, v := range in.ExtraData {
+		switch k {
+		case "text", "attachments":
+			continue // don't allow field overwrites
+		}
+		out.RawByte(',')
+		out.String(string(k))
+		out.RawByte(':')
+		if m, ok := v.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := v.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(v))
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Command) MarshalJSON() ([]byte, error) {
+func (v CampaignMessageTemplate) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo43(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2224(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Command) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo43(w, v)
+func (v CampaignMessageTemplate) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2224(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Command) UnmarshalJSON(data []byte) error {
+func (v *CampaignMessageTemplate) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo43(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2224(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Command) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo43(l, v)
+func (v *CampaignMessageTemplate) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2224(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo44(in *jlexer.Lexer, out *Client) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2225(in *jlexer.Lexer, out *CampaignChannelTemplate) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5580,6 +28507,9 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo44(in *jlexer.Lexer, ou
 		in.Skip()
 		return
 	}
+	for key := range out.ExtraData {
+		delete(out.ExtraData, key)
+	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
 		key := in.UnsafeString()
@@ -5590,10 +28520,38 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo44(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "BaseURL":
-			out.BaseURL = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		case "id":
+			out.ID = string(in.String())
+		case "members":
+			if in.IsNull() {
+				in.Skip()
+				out.Members = nil
+			} else {
+				in.Delim('[')
+				if out.Members == nil {
+					if !in.IsDelim(']') {
+						out.Members = make([]string, 0, 4)
+					} else {
+						out.Members = []string{}
+					}
+				} else {
+					out.Members = (out.Members)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v439 string
+					v439 = string(in.String())
+					out.Members = append(out.Members, v439)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
-			in.SkipRecursive()
+			if out.ExtraData == nil {
+				out.ExtraData = make(map[string]interface{})
+			}
+			out.ExtraData[key] = in.Interface()
 		}
 		in.WantComma()
 	}
@@ -5602,42 +28560,77 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo44(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo44(out *jwriter.Writer, in Client) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2225(out *jwriter.Writer, in CampaignChannelTemplate) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"BaseURL\":"
+		const prefix string = ",\"type\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.BaseURL))
+		out.String(string(in.Type))
+	}
+	if in.ID != "" {
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.String(string(in.ID))
+	}
+	if len(in.Members) != 0 {
+		const prefix string = ",\"members\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v440, v441 := range in.Members {
+				if v440 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v441))
+			}
+			out.RawByte(']')
+		}
+	}
+	for k, v := range in.ExtraData {
+		switch k {
+		case "type", "id", "members":
+			continue // don't allow field overwrites
+		}
+		out.RawByte(',')
+		out.String(string(k))
+		out.RawByte(':')
+		if m, ok := v.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := v.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(v))
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Client) MarshalJSON() ([]byte, error) {
+func (v CampaignChannelTemplate) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo44(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2225(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Client) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo44(w, v)
+func (v CampaignChannelTemplate) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2225(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Client) UnmarshalJSON(data []byte) error {
+func (v *CampaignChannelTemplate) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo44(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2225(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Client) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo44(l, v)
+func (v *CampaignChannelTemplate) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2225(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo45(in *jlexer.Lexer, out *ChannelType) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2226(in *jlexer.Lexer, out *Campaign) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5656,68 +28649,122 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo45(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "commands":
+		case "id":
+			out.ID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "sender_id":
+			out.SenderID = string(in.String())
+		case "segment_ids":
 			if in.IsNull() {
 				in.Skip()
-				out.Commands = nil
+				out.SegmentIDs = nil
 			} else {
 				in.Delim('[')
-				if out.Commands == nil {
+				if out.SegmentIDs == nil {
 					if !in.IsDelim(']') {
-						out.Commands = make([]*Command, 0, 8)
+						out.SegmentIDs = make([]string, 0, 4)
 					} else {
-						out.Commands = []*Command{}
+						out.SegmentIDs = []string{}
 					}
 				} else {
-					out.Commands = (out.Commands)[:0]
+					out.SegmentIDs = (out.SegmentIDs)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v107 *Command
-					if in.IsNull() {
-						in.Skip()
-						v107 = nil
-					} else {
-						if v107 == nil {
-							v107 = new(Command)
-						}
-						(*v107).UnmarshalEasyJSON(in)
-					}
-					out.Commands = append(out.Commands, v107)
+					var v442 string
+					v442 = string(in.String())
+					out.SegmentIDs = append(out.SegmentIDs, v442)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "permissions":
+		case "user_ids":
 			if in.IsNull() {
 				in.Skip()
-				out.Permissions = nil
+				out.UserIDs = nil
 			} else {
 				in.Delim('[')
-				if out.Permissions == nil {
+				if out.UserIDs == nil {
 					if !in.IsDelim(']') {
-						out.Permissions = make([]*Permission, 0, 8)
+						out.UserIDs = make([]string, 0, 4)
 					} else {
-						out.Permissions = []*Permission{}
+						out.UserIDs = []string{}
 					}
 				} else {
-					out.Permissions = (out.Permissions)[:0]
+					out.UserIDs = (out.UserIDs)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v108 *Permission
-					if in.IsNull() {
-						in.Skip()
-						v108 = nil
-					} else {
-						if v108 == nil {
-							v108 = new(Permission)
-						}
-						(*v108).UnmarshalEasyJSON(in)
-					}
-					out.Permissions = append(out.Permissions, v108)
+					var v443 string
+					v443 = string(in.String())
+					out.UserIDs = append(out.UserIDs, v443)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
+		case "message_template":
+			if in.IsNull() {
+				in.Skip()
+				out.MessageTemplate = nil
+			} else {
+				if out.MessageTemplate == nil {
+					out.MessageTemplate = new(CampaignMessageTemplate)
+				}
+				(*out.MessageTemplate).UnmarshalEasyJSON(in)
+			}
+		case "channel_template":
+			if in.IsNull() {
+				in.Skip()
+				out.ChannelTemplate = nil
+			} else {
+				if out.ChannelTemplate == nil {
+					out.ChannelTemplate = new(CampaignChannelTemplate)
+				}
+				(*out.ChannelTemplate).UnmarshalEasyJSON(in)
+			}
+		case "create_channels":
+			out.CreateChannels = bool(in.Bool())
+		case "skip_push":
+			out.SkipPush = bool(in.Bool())
+		case "skip_webhook":
+			out.SkipWebhook = bool(in.Bool())
+		case "status":
+			out.Status = CampaignStatus(in.String())
+		case "stats":
+			if in.IsNull() {
+				in.Skip()
+				out.Stats = nil
+			} else {
+				if out.Stats == nil {
+					out.Stats = new(CampaignStats)
+				}
+				(*out.Stats).UnmarshalEasyJSON(in)
+			}
+		case "scheduled_for":
+			if in.IsNull() {
+				in.Skip()
+				out.ScheduledFor = nil
+			} else {
+				if out.ScheduledFor == nil {
+					out.ScheduledFor = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ScheduledFor).UnmarshalJSON(data))
+				}
+			}
+		case "stop_at":
+			if in.IsNull() {
+				in.Skip()
+				out.StopAt = nil
+			} else {
+				if out.StopAt == nil {
+					out.StopAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.StopAt).UnmarshalJSON(data))
+				}
+			}
 		case "created_at":
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.CreatedAt).UnmarshalJSON(data))
@@ -5726,30 +28773,6 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo45(in *jlexer.Lexer, ou
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
 			}
-		case "name":
-			out.Name = string(in.String())
-		case "typing_events":
-			out.TypingEvents = bool(in.Bool())
-		case "read_events":
-			out.ReadEvents = bool(in.Bool())
-		case "connect_events":
-			out.ConnectEvents = bool(in.Bool())
-		case "search":
-			out.Search = bool(in.Bool())
-		case "reactions":
-			out.Reactions = bool(in.Bool())
-		case "replies":
-			out.Replies = bool(in.Bool())
-		case "mutes":
-			out.Mutes = bool(in.Bool())
-		case "message_retention":
-			out.MessageRetention = string(in.String())
-		case "max_message_length":
-			out.MaxMessageLength = int(in.Int())
-		case "automod":
-			out.Automod = modType(in.String())
-		case "automod_behavior":
-			out.ModBehavior = modBehaviour(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -5760,147 +28783,287 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo45(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo45(out *jwriter.Writer, in ChannelType) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2226(out *jwriter.Writer, in Campaign) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"commands\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		if in.Commands == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"sender_id\":"
+		out.RawString(prefix)
+		out.String(string(in.SenderID))
+	}
+	{
+		const prefix string = ",\"segment_ids\":"
+		out.RawString(prefix)
+		if in.SegmentIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v109, v110 := range in.Commands {
-				if v109 > 0 {
+			for v444, v445 := range in.SegmentIDs {
+				if v444 > 0 {
 					out.RawByte(',')
 				}
-				if v110 == nil {
-					out.RawString("null")
-				} else {
-					(*v110).MarshalEasyJSON(out)
-				}
+				out.String(string(v445))
 			}
 			out.RawByte(']')
 		}
 	}
 	{
-		const prefix string = ",\"permissions\":"
+		const prefix string = ",\"user_ids\":"
 		out.RawString(prefix)
-		if in.Permissions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.UserIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v111, v112 := range in.Permissions {
-				if v111 > 0 {
+			for v446, v447 := range in.UserIDs {
+				if v446 > 0 {
 					out.RawByte(',')
 				}
-				if v112 == nil {
-					out.RawString("null")
-				} else {
-					(*v112).MarshalEasyJSON(out)
-				}
+				out.String(string(v447))
 			}
 			out.RawByte(']')
 		}
 	}
 	{
-		const prefix string = ",\"created_at\":"
+		const prefix string = ",\"message_template\":"
 		out.RawString(prefix)
-		out.Raw((in.CreatedAt).MarshalJSON())
+		if in.MessageTemplate == nil {
+			out.RawString("null")
+		} else {
+			(*in.MessageTemplate).MarshalEasyJSON(out)
+		}
 	}
 	{
-		const prefix string = ",\"updated_at\":"
+		const prefix string = ",\"channel_template\":"
 		out.RawString(prefix)
-		out.Raw((in.UpdatedAt).MarshalJSON())
+		if in.ChannelTemplate == nil {
+			out.RawString("null")
+		} else {
+			(*in.ChannelTemplate).MarshalEasyJSON(out)
+		}
 	}
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"create_channels\":"
 		out.RawString(prefix)
-		out.String(string(in.Name))
+		out.Bool(bool(in.CreateChannels))
 	}
 	{
-		const prefix string = ",\"typing_events\":"
+		const prefix string = ",\"skip_push\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.TypingEvents))
+		out.Bool(bool(in.SkipPush))
 	}
 	{
-		const prefix string = ",\"read_events\":"
+		const prefix string = ",\"skip_webhook\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.ReadEvents))
+		out.Bool(bool(in.SkipWebhook))
 	}
 	{
-		const prefix string = ",\"connect_events\":"
+		const prefix string = ",\"status\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.ConnectEvents))
+		out.String(string(in.Status))
 	}
 	{
-		const prefix string = ",\"search\":"
+		const prefix string = ",\"stats\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Search))
+		if in.Stats == nil {
+			out.RawString("null")
+		} else {
+			(*in.Stats).MarshalEasyJSON(out)
+		}
 	}
 	{
-		const prefix string = ",\"reactions\":"
+		const prefix string = ",\"scheduled_for\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Reactions))
+		if in.ScheduledFor == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.ScheduledFor).MarshalJSON())
+		}
 	}
 	{
-		const prefix string = ",\"replies\":"
+		const prefix string = ",\"stop_at\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Replies))
+		if in.StopAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.StopAt).MarshalJSON())
+		}
 	}
 	{
-		const prefix string = ",\"mutes\":"
+		const prefix string = ",\"created_at\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Mutes))
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Campaign) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2226(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Campaign) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2226(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Campaign) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2226(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Campaign) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2226(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2227(in *jlexer.Lexer, out *Blocklist) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "words":
+			if in.IsNull() {
+				in.Skip()
+				out.Words = nil
+			} else {
+				in.Delim('[')
+				if out.Words == nil {
+					if !in.IsDelim(']') {
+						out.Words = make([]string, 0, 4)
+					} else {
+						out.Words = []string{}
+					}
+				} else {
+					out.Words = (out.Words)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v448 string
+					v448 = string(in.String())
+					out.Words = append(out.Words, v448)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2227(out *jwriter.Writer, in Blocklist) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"message_retention\":"
-		out.RawString(prefix)
-		out.String(string(in.MessageRetention))
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"max_message_length\":"
+		const prefix string = ",\"words\":"
 		out.RawString(prefix)
-		out.Int(int(in.MaxMessageLength))
+		if in.Words == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v449, v450 := range in.Words {
+				if v449 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v450))
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"automod\":"
+		const prefix string = ",\"created_at\":"
 		out.RawString(prefix)
-		out.String(string(in.Automod))
+		out.Raw((in.CreatedAt).MarshalJSON())
 	}
 	{
-		const prefix string = ",\"automod_behavior\":"
+		const prefix string = ",\"updated_at\":"
 		out.RawString(prefix)
-		out.String(string(in.ModBehavior))
+		out.Raw((in.UpdatedAt).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ChannelType) MarshalJSON() ([]byte, error) {
+func (v Blocklist) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo45(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2227(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ChannelType) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo45(w, v)
+func (v Blocklist) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2227(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ChannelType) UnmarshalJSON(data []byte) error {
+func (v *Blocklist) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo45(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2227(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ChannelType) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo45(l, v)
+func (v *Blocklist) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2227(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo46(in *jlexer.Lexer, out *ChannelRead) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2228(in *jlexer.Lexer, out *BatchSender) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5919,19 +29082,17 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo46(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "user":
+		case "Workers":
+			out.Workers = int(in.Int())
+		case "MaxRetries":
 			if in.IsNull() {
 				in.Skip()
-				out.User = nil
+				out.MaxRetries = nil
 			} else {
-				if out.User == nil {
-					out.User = new(User)
+				if out.MaxRetries == nil {
+					out.MaxRetries = new(int)
 				}
-				(*out.User).UnmarshalEasyJSON(in)
-			}
-		case "last_read":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.LastRead).UnmarshalJSON(data))
+				*out.MaxRetries = int(in.Int())
 			}
 		default:
 			in.SkipRecursive()
@@ -5943,51 +29104,51 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo46(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo46(out *jwriter.Writer, in ChannelRead) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2228(out *jwriter.Writer, in BatchSender) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"user\":"
+		const prefix string = ",\"Workers\":"
 		out.RawString(prefix[1:])
-		if in.User == nil {
-			out.RawString("null")
-		} else {
-			(*in.User).MarshalEasyJSON(out)
-		}
+		out.Int(int(in.Workers))
 	}
 	{
-		const prefix string = ",\"last_read\":"
+		const prefix string = ",\"MaxRetries\":"
 		out.RawString(prefix)
-		out.Raw((in.LastRead).MarshalJSON())
+		if in.MaxRetries == nil {
+			out.RawString("null")
+		} else {
+			out.Int(int(*in.MaxRetries))
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ChannelRead) MarshalJSON() ([]byte, error) {
+func (v BatchSender) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo46(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2228(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ChannelRead) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo46(w, v)
+func (v BatchSender) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2228(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ChannelRead) UnmarshalJSON(data []byte) error {
+func (v *BatchSender) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo46(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2228(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ChannelRead) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo46(l, v)
+func (v *BatchSender) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2228(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo47(in *jlexer.Lexer, out *ChannelMember) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2229(in *jlexer.Lexer, out *BatchResult) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6006,56 +29167,106 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo47(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "user_id":
-			out.UserID = string(in.String())
-		case "user":
+		case "Message":
 			if in.IsNull() {
 				in.Skip()
-				out.User = nil
+				out.Message = nil
 			} else {
-				if out.User == nil {
-					out.User = new(User)
+				if out.Message == nil {
+					out.Message = new(Message)
 				}
-				(*out.User).UnmarshalEasyJSON(in)
+				(*out.Message).UnmarshalEasyJSON(in)
 			}
-		case "is_moderator":
-			out.IsModerator = bool(in.Bool())
-		case "invited":
-			out.Invited = bool(in.Bool())
-		case "invite_accepted_at":
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2229(out *jwriter.Writer, in BatchResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Message\":"
+		out.RawString(prefix[1:])
+		if in.Message == nil {
+			out.RawString("null")
+		} else {
+			(*in.Message).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v BatchResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2229(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BatchResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2229(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *BatchResult) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2229(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BatchResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2229(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2230(in *jlexer.Lexer, out *BatchMessage) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Channel":
 			if in.IsNull() {
 				in.Skip()
-				out.InviteAcceptedAt = nil
+				out.Channel = nil
 			} else {
-				if out.InviteAcceptedAt == nil {
-					out.InviteAcceptedAt = new(time.Time)
-				}
-				if data := in.Raw(); in.Ok() {
-					in.AddError((*out.InviteAcceptedAt).UnmarshalJSON(data))
+				if out.Channel == nil {
+					out.Channel = new(Channel)
 				}
+				(*out.Channel).UnmarshalEasyJSON(in)
 			}
-		case "invite_rejected_at":
+		case "Message":
 			if in.IsNull() {
 				in.Skip()
-				out.InviteRejectedAt = nil
+				out.Message = nil
 			} else {
-				if out.InviteRejectedAt == nil {
-					out.InviteRejectedAt = new(time.Time)
-				}
-				if data := in.Raw(); in.Ok() {
-					in.AddError((*out.InviteRejectedAt).UnmarshalJSON(data))
+				if out.Message == nil {
+					out.Message = new(Message)
 				}
+				(*out.Message).UnmarshalEasyJSON(in)
 			}
-		case "role":
-			out.Role = string(in.String())
-		case "created_at":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.CreatedAt).UnmarshalJSON(data))
-			}
-		case "updated_at":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
-			}
+		case "UserID":
+			out.UserID = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -6066,123 +29277,60 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo47(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo47(out *jwriter.Writer, in ChannelMember) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2230(out *jwriter.Writer, in BatchMessage) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if in.UserID != "" {
-		const prefix string = ",\"user_id\":"
-		first = false
+	{
+		const prefix string = ",\"Channel\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.UserID))
-	}
-	if in.User != nil {
-		const prefix string = ",\"user\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		(*in.User).MarshalEasyJSON(out)
-	}
-	if in.IsModerator {
-		const prefix string = ",\"is_moderator\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.Bool(bool(in.IsModerator))
-	}
-	if in.Invited {
-		const prefix string = ",\"invited\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.Bool(bool(in.Invited))
-	}
-	if in.InviteAcceptedAt != nil {
-		const prefix string = ",\"invite_accepted_at\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.Raw((*in.InviteAcceptedAt).MarshalJSON())
-	}
-	if in.InviteRejectedAt != nil {
-		const prefix string = ",\"invite_rejected_at\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.Raw((*in.InviteRejectedAt).MarshalJSON())
-	}
-	if in.Role != "" {
-		const prefix string = ",\"role\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
+		if in.Channel == nil {
+			out.RawString("null")
 		} else {
-			out.RawString(prefix)
+			(*in.Channel).MarshalEasyJSON(out)
 		}
-		out.String(string(in.Role))
 	}
-	if true {
-		const prefix string = ",\"created_at\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
+	{
+		const prefix string = ",\"Message\":"
+		out.RawString(prefix)
+		if in.Message == nil {
+			out.RawString("null")
 		} else {
-			out.RawString(prefix)
+			(*in.Message).MarshalEasyJSON(out)
 		}
-		out.Raw((in.CreatedAt).MarshalJSON())
 	}
-	if true {
-		const prefix string = ",\"updated_at\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.Raw((in.UpdatedAt).MarshalJSON())
+	{
+		const prefix string = ",\"UserID\":"
+		out.RawString(prefix)
+		out.String(string(in.UserID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ChannelMember) MarshalJSON() ([]byte, error) {
+func (v BatchMessage) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo47(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2230(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ChannelMember) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo47(w, v)
+func (v BatchMessage) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2230(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ChannelMember) UnmarshalJSON(data []byte) error {
+func (v *BatchMessage) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo47(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2230(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ChannelMember) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo47(l, v)
+func (v *BatchMessage) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2230(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo48(in *jlexer.Lexer, out *ChannelConfig) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2231(in *jlexer.Lexer, out *BanOptions) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6201,30 +29349,28 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo48(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "name":
-			out.Name = string(in.String())
-		case "typing_events":
-			out.TypingEvents = bool(in.Bool())
-		case "read_events":
-			out.ReadEvents = bool(in.Bool())
-		case "connect_events":
-			out.ConnectEvents = bool(in.Bool())
-		case "search":
-			out.Search = bool(in.Bool())
-		case "reactions":
-			out.Reactions = bool(in.Bool())
-		case "replies":
-			out.Replies = bool(in.Bool())
-		case "mutes":
-			out.Mutes = bool(in.Bool())
-		case "message_retention":
-			out.MessageRetention = string(in.String())
-		case "max_message_length":
-			out.MaxMessageLength = int(in.Int())
-		case "automod":
-			out.Automod = modType(in.String())
-		case "automod_behavior":
-			out.ModBehavior = modBehaviour(in.String())
+		case "Timeout":
+			out.Timeout = time.Duration(in.Int64())
+		case "Expiration":
+			if in.IsNull() {
+				in.Skip()
+				out.Expiration = nil
+			} else {
+				if out.Expiration == nil {
+					out.Expiration = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.Expiration).UnmarshalJSON(data))
+				}
+			}
+		case "Reason":
+			out.Reason = string(in.String())
+		case "IPBan":
+			out.IPBan = bool(in.Bool())
+		case "Shadow":
+			out.Shadow = bool(in.Bool())
+		case "BannedByID":
+			out.BannedByID = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -6235,97 +29381,71 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo48(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo48(out *jwriter.Writer, in ChannelConfig) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2231(out *jwriter.Writer, in BanOptions) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"Timeout\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"typing_events\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.TypingEvents))
-	}
-	{
-		const prefix string = ",\"read_events\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.ReadEvents))
-	}
-	{
-		const prefix string = ",\"connect_events\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.ConnectEvents))
-	}
-	{
-		const prefix string = ",\"search\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Search))
-	}
-	{
-		const prefix string = ",\"reactions\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Reactions))
-	}
-	{
-		const prefix string = ",\"replies\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Replies))
+		out.Int64(int64(in.Timeout))
 	}
 	{
-		const prefix string = ",\"mutes\":"
+		const prefix string = ",\"Expiration\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Mutes))
+		if in.Expiration == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.Expiration).MarshalJSON())
+		}
 	}
 	{
-		const prefix string = ",\"message_retention\":"
+		const prefix string = ",\"Reason\":"
 		out.RawString(prefix)
-		out.String(string(in.MessageRetention))
+		out.String(string(in.Reason))
 	}
 	{
-		const prefix string = ",\"max_message_length\":"
+		const prefix string = ",\"IPBan\":"
 		out.RawString(prefix)
-		out.Int(int(in.MaxMessageLength))
+		out.Bool(bool(in.IPBan))
 	}
 	{
-		const prefix string = ",\"automod\":"
+		const prefix string = ",\"Shadow\":"
 		out.RawString(prefix)
-		out.String(string(in.Automod))
+		out.Bool(bool(in.Shadow))
 	}
 	{
-		const prefix string = ",\"automod_behavior\":"
+		const prefix string = ",\"BannedByID\":"
 		out.RawString(prefix)
-		out.String(string(in.ModBehavior))
+		out.String(string(in.BannedByID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ChannelConfig) MarshalJSON() ([]byte, error) {
+func (v BanOptions) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo48(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2231(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ChannelConfig) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo48(w, v)
+func (v BanOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2231(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ChannelConfig) UnmarshalJSON(data []byte) error {
+func (v *BanOptions) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo48(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2231(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ChannelConfig) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo48(l, v)
+func (v *BanOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2231(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo49(in *jlexer.Lexer, out *Channel) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2232(in *jlexer.Lexer, out *Ban) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6344,133 +29464,56 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo49(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = string(in.String())
-		case "type":
-			out.Type = string(in.String())
-		case "cid":
-			out.CID = string(in.String())
-		case "config":
-			(out.Config).UnmarshalEasyJSON(in)
-		case "created_by":
+		case "user":
 			if in.IsNull() {
 				in.Skip()
-				out.CreatedBy = nil
+				out.User = nil
 			} else {
-				if out.CreatedBy == nil {
-					out.CreatedBy = new(User)
+				if out.User == nil {
+					out.User = new(User)
 				}
-				(*out.CreatedBy).UnmarshalEasyJSON(in)
+				(*out.User).UnmarshalEasyJSON(in)
 			}
-		case "frozen":
-			out.Frozen = bool(in.Bool())
-		case "member_count":
-			out.MemberCount = int(in.Int())
-		case "members":
+		case "banned_by":
 			if in.IsNull() {
 				in.Skip()
-				out.Members = nil
+				out.BannedBy = nil
 			} else {
-				in.Delim('[')
-				if out.Members == nil {
-					if !in.IsDelim(']') {
-						out.Members = make([]*ChannelMember, 0, 8)
-					} else {
-						out.Members = []*ChannelMember{}
-					}
-				} else {
-					out.Members = (out.Members)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v113 *ChannelMember
-					if in.IsNull() {
-						in.Skip()
-						v113 = nil
-					} else {
-						if v113 == nil {
-							v113 = new(ChannelMember)
-						}
-						(*v113).UnmarshalEasyJSON(in)
-					}
-					out.Members = append(out.Members, v113)
-					in.WantComma()
+				if out.BannedBy == nil {
+					out.BannedBy = new(User)
 				}
-				in.Delim(']')
+				(*out.BannedBy).UnmarshalEasyJSON(in)
 			}
-		case "messages":
+		case "channel":
 			if in.IsNull() {
 				in.Skip()
-				out.Messages = nil
+				out.Channel = nil
 			} else {
-				in.Delim('[')
-				if out.Messages == nil {
-					if !in.IsDelim(']') {
-						out.Messages = make([]*Message, 0, 8)
-					} else {
-						out.Messages = []*Message{}
-					}
-				} else {
-					out.Messages = (out.Messages)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v114 *Message
-					if in.IsNull() {
-						in.Skip()
-						v114 = nil
-					} else {
-						if v114 == nil {
-							v114 = new(Message)
-						}
-						(*v114).UnmarshalEasyJSON(in)
-					}
-					out.Messages = append(out.Messages, v114)
-					in.WantComma()
+				if out.Channel == nil {
+					out.Channel = new(Channel)
 				}
-				in.Delim(']')
+				(*out.Channel).UnmarshalEasyJSON(in)
 			}
-		case "read":
+		case "reason":
+			out.Reason = string(in.String())
+		case "expires":
 			if in.IsNull() {
 				in.Skip()
-				out.Read = nil
+				out.Expires = nil
 			} else {
-				in.Delim('[')
-				if out.Read == nil {
-					if !in.IsDelim(']') {
-						out.Read = make([]*ChannelRead, 0, 8)
-					} else {
-						out.Read = []*ChannelRead{}
-					}
-				} else {
-					out.Read = (out.Read)[:0]
+				if out.Expires == nil {
+					out.Expires = new(time.Time)
 				}
-				for !in.IsDelim(']') {
-					var v115 *ChannelRead
-					if in.IsNull() {
-						in.Skip()
-						v115 = nil
-					} else {
-						if v115 == nil {
-							v115 = new(ChannelRead)
-						}
-						(*v115).UnmarshalEasyJSON(in)
-					}
-					out.Read = append(out.Read, v115)
-					in.WantComma()
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.Expires).UnmarshalJSON(data))
 				}
-				in.Delim(']')
 			}
+		case "shadow":
+			out.Shadow = bool(in.Bool())
 		case "created_at":
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.CreatedAt).UnmarshalJSON(data))
 			}
-		case "updated_at":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
-			}
-		case "last_message_at":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.LastMessageAt).UnmarshalJSON(data))
-			}
 		default:
 			in.SkipRecursive()
 		}
@@ -6481,151 +29524,254 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo49(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo49(out *jwriter.Writer, in Channel) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2232(out *jwriter.Writer, in Ban) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user\":"
+		out.RawString(prefix[1:])
+		if in.User == nil {
+			out.RawString("null")
+		} else {
+			(*in.User).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"banned_by\":"
+		out.RawString(prefix)
+		if in.BannedBy == nil {
+			out.RawString("null")
+		} else {
+			(*in.BannedBy).MarshalEasyJSON(out)
+		}
+	}
+	if in.Channel != nil {
+		const prefix string = ",\"channel\":"
+		out.RawString(prefix)
+		(*in.Channel).MarshalEasyJSON(out)
+	}
+	if in.Reason != "" {
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	if in.Expires != nil {
+		const prefix string = ",\"expires\":"
+		out.RawString(prefix)
+		out.Raw((*in.Expires).MarshalJSON())
+	}
+	if in.Shadow {
+		const prefix string = ",\"shadow\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Shadow))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Ban) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2232(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Ban) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2232(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Ban) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2232(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Ban) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2232(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2233(in *jlexer.Lexer, out *AttachmentField) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "title":
+			out.Title = string(in.String())
+		case "value":
+			out.Value = string(in.String())
+		case "short":
+			out.Short = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2233(out *jwriter.Writer, in AttachmentField) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"title\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.ID))
-	}
-	{
-		const prefix string = ",\"type\":"
-		out.RawString(prefix)
-		out.String(string(in.Type))
+		out.String(string(in.Title))
 	}
 	{
-		const prefix string = ",\"cid\":"
+		const prefix string = ",\"value\":"
 		out.RawString(prefix)
-		out.String(string(in.CID))
+		out.String(string(in.Value))
 	}
 	{
-		const prefix string = ",\"config\":"
+		const prefix string = ",\"short\":"
 		out.RawString(prefix)
-		(in.Config).MarshalEasyJSON(out)
+		out.Bool(bool(in.Short))
 	}
-	{
-		const prefix string = ",\"created_by\":"
-		out.RawString(prefix)
-		if in.CreatedBy == nil {
-			out.RawString("null")
-		} else {
-			(*in.CreatedBy).MarshalEasyJSON(out)
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AttachmentField) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2233(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AttachmentField) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2233(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AttachmentField) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2233(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AttachmentField) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2233(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2234(in *jlexer.Lexer, out *AttachmentAction) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
 		}
+		in.Skip()
+		return
 	}
-	{
-		const prefix string = ",\"frozen\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Frozen))
-	}
-	{
-		const prefix string = ",\"member_count\":"
-		out.RawString(prefix)
-		out.Int(int(in.MemberCount))
-	}
-	{
-		const prefix string = ",\"members\":"
-		out.RawString(prefix)
-		if in.Members == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v116, v117 := range in.Members {
-				if v116 > 0 {
-					out.RawByte(',')
-				}
-				if v117 == nil {
-					out.RawString("null")
-				} else {
-					(*v117).MarshalEasyJSON(out)
-				}
-			}
-			out.RawByte(']')
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "text":
+			out.Text = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		case "style":
+			out.Style = string(in.String())
+		case "value":
+			out.Value = string(in.String())
+		default:
+			in.SkipRecursive()
 		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2234(out *jwriter.Writer, in AttachmentAction) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"messages\":"
-		out.RawString(prefix)
-		if in.Messages == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v118, v119 := range in.Messages {
-				if v118 > 0 {
-					out.RawByte(',')
-				}
-				if v119 == nil {
-					out.RawString("null")
-				} else {
-					(*v119).MarshalEasyJSON(out)
-				}
-			}
-			out.RawByte(']')
-		}
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"read\":"
+		const prefix string = ",\"text\":"
 		out.RawString(prefix)
-		if in.Read == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v120, v121 := range in.Read {
-				if v120 > 0 {
-					out.RawByte(',')
-				}
-				if v121 == nil {
-					out.RawString("null")
-				} else {
-					(*v121).MarshalEasyJSON(out)
-				}
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Text))
 	}
 	{
-		const prefix string = ",\"created_at\":"
+		const prefix string = ",\"type\":"
 		out.RawString(prefix)
-		out.Raw((in.CreatedAt).MarshalJSON())
+		out.String(string(in.Type))
 	}
-	{
-		const prefix string = ",\"updated_at\":"
+	if in.Style != "" {
+		const prefix string = ",\"style\":"
 		out.RawString(prefix)
-		out.Raw((in.UpdatedAt).MarshalJSON())
+		out.String(string(in.Style))
 	}
-	{
-		const prefix string = ",\"last_message_at\":"
+	if in.Value != "" {
+		const prefix string = ",\"value\":"
 		out.RawString(prefix)
-		out.Raw((in.LastMessageAt).MarshalJSON())
+		out.String(string(in.Value))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Channel) MarshalJSON() ([]byte, error) {
+func (v AttachmentAction) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo49(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2234(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Channel) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo49(w, v)
+func (v AttachmentAction) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2234(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Channel) UnmarshalJSON(data []byte) error {
+func (v *AttachmentAction) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo49(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2234(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Channel) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo49(l, v)
+func (v *AttachmentAction) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2234(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo50(in *jlexer.Lexer, out *Attachment) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2235(in *jlexer.Lexer, out *Attachment) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6651,12 +29797,26 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo50(in *jlexer.Lexer, ou
 			out.Type = string(in.String())
 		case "author_name":
 			out.AuthorName = string(in.String())
+		case "author_link":
+			out.AuthorLink = string(in.String())
+		case "author_icon":
+			out.AuthorIcon = string(in.String())
 		case "title":
 			out.Title = string(in.String())
 		case "title_link":
 			out.TitleLink = string(in.String())
+		case "pretext":
+			out.Pretext = string(in.String())
 		case "text":
 			out.Text = string(in.String())
+		case "fallback":
+			out.Fallback = string(in.String())
+		case "color":
+			out.Color = string(in.String())
+		case "footer":
+			out.Footer = string(in.String())
+		case "footer_icon":
+			out.FooterIcon = string(in.String())
 		case "image_url":
 			out.ImageURL = string(in.String())
 		case "thumb_url":
@@ -6665,6 +29825,68 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo50(in *jlexer.Lexer, ou
 			out.AssetURL = string(in.String())
 		case "og_scrape_url":
 			out.OGScrapeURL = string(in.String())
+		case "actions":
+			if in.IsNull() {
+				in.Skip()
+				out.Actions = nil
+			} else {
+				in.Delim('[')
+				if out.Actions == nil {
+					if !in.IsDelim(']') {
+						out.Actions = make([]*AttachmentAction, 0, 8)
+					} else {
+						out.Actions = []*AttachmentAction{}
+					}
+				} else {
+					out.Actions = (out.Actions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v451 *AttachmentAction
+					if in.IsNull() {
+						in.Skip()
+						v451 = nil
+					} else {
+						if v451 == nil {
+							v451 = new(AttachmentAction)
+						}
+						(*v451).UnmarshalEasyJSON(in)
+					}
+					out.Actions = append(out.Actions, v451)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "fields":
+			if in.IsNull() {
+				in.Skip()
+				out.Fields = nil
+			} else {
+				in.Delim('[')
+				if out.Fields == nil {
+					if !in.IsDelim(']') {
+						out.Fields = make([]*AttachmentField, 0, 8)
+					} else {
+						out.Fields = []*AttachmentField{}
+					}
+				} else {
+					out.Fields = (out.Fields)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v452 *AttachmentField
+					if in.IsNull() {
+						in.Skip()
+						v452 = nil
+					} else {
+						if v452 == nil {
+							v452 = new(AttachmentField)
+						}
+						(*v452).UnmarshalEasyJSON(in)
+					}
+					out.Fields = append(out.Fields, v452)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			if out.ExtraData == nil {
 				out.ExtraData = make(map[string]interface{})
@@ -6678,7 +29900,7 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo50(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo50(out *jwriter.Writer, in Attachment) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2235(out *jwriter.Writer, in Attachment) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6698,6 +29920,26 @@ func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo50(out *jwriter.Writer,
 		}
 		out.String(string(in.AuthorName))
 	}
+	if in.AuthorLink != "" {
+		const prefix string = ",\"author_link\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.AuthorLink))
+	}
+	if in.AuthorIcon != "" {
+		const prefix string = ",\"author_icon\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.AuthorIcon))
+	}
 	if in.Title != "" {
 		const prefix string = ",\"title\":"
 		if first {
@@ -6718,6 +29960,16 @@ func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo50(out *jwriter.Writer,
 		}
 		out.String(string(in.TitleLink))
 	}
+	if in.Pretext != "" {
+		const prefix string = ",\"pretext\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Pretext))
+	}
 	if in.Text != "" {
 		const prefix string = ",\"text\":"
 		if first {
@@ -6728,6 +29980,46 @@ func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo50(out *jwriter.Writer,
 		}
 		out.String(string(in.Text))
 	}
+	if in.Fallback != "" {
+		const prefix string = ",\"fallback\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Fallback))
+	}
+	if in.Color != "" {
+		const prefix string = ",\"color\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Color))
+	}
+	if in.Footer != "" {
+		const prefix string = ",\"footer\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Footer))
+	}
+	if in.FooterIcon != "" {
+		const prefix string = ",\"footer_icon\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.FooterIcon))
+	}
 	if in.ImageURL != "" {
 		const prefix string = ",\"image_url\":"
 		if first {
@@ -6756,21 +30048,67 @@ func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo50(out *jwriter.Writer,
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.AssetURL))
+		out.String(string(in.AssetURL))
+	}
+	if in.OGScrapeURL != "" {
+		const prefix string = ",\"og_scrape_url\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.OGScrapeURL))
+	}
+	if len(in.Actions) != 0 {
+		const prefix string = ",\"actions\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v453, v454 := range in.Actions {
+				if v453 > 0 {
+					out.RawByte(',')
+				}
+				if v454 == nil {
+					out.RawString("null")
+				} else {
+					(*v454).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
 	}
-	if in.OGScrapeURL != "" {
-		const prefix string = ",\"og_scrape_url\":"
+	if len(in.Fields) != 0 {
+		const prefix string = ",\"fields\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.OGScrapeURL))
+		{
+			out.RawByte('[')
+			for v455, v456 := range in.Fields {
+				if v455 > 0 {
+					out.RawByte(',')
+				}
+				if v456 == nil {
+					out.RawString("null")
+				} else {
+					(*v456).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
 	}
 	for k, v := range in.ExtraData {
 		switch k {
-		case "type", "author_name", "title", "title_link", "text", "image_url", "thumb_url", "asset_url", "og_scrape_url":
+		case "type", "author_name", "author_link", "author_icon", "title", "title_link", "pretext", "text", "fallback", "color", "footer", "footer_icon", "image_url", "thumb_url", "asset_url", "og_scrape_url", "actions", "fields":
 			continue // don't allow field overwrites
 		}
 		if first {
@@ -6794,27 +30132,27 @@ func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo50(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v Attachment) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo50(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2235(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Attachment) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo50(w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2235(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Attachment) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo50(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2235(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Attachment) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo50(l, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2235(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo51(in *jlexer.Lexer, out *AppSettings) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2236(in *jlexer.Lexer, out *AppSettings) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6873,6 +30211,16 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo51(in *jlexer.Lexer, ou
 				}
 				(*out.FirebaseConfig).UnmarshalEasyJSON(in)
 			}
+		case "huawei_config":
+			if in.IsNull() {
+				in.Skip()
+				out.HuaweiConfig = nil
+			} else {
+				if out.HuaweiConfig == nil {
+					out.HuaweiConfig = new(HuaweiConfig)
+				}
+				(*out.HuaweiConfig).UnmarshalEasyJSON(in)
+			}
 		case "webhook_url":
 			if in.IsNull() {
 				in.Skip()
@@ -6883,6 +30231,86 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo51(in *jlexer.Lexer, ou
 				}
 				*out.WebhookURL = string(in.String())
 			}
+		case "multi_tenant_enabled":
+			if in.IsNull() {
+				in.Skip()
+				out.MultiTenantEnabled = nil
+			} else {
+				if out.MultiTenantEnabled == nil {
+					out.MultiTenantEnabled = new(bool)
+				}
+				*out.MultiTenantEnabled = bool(in.Bool())
+			}
+		case "permission_version":
+			if in.IsNull() {
+				in.Skip()
+				out.PermissionVersion = nil
+			} else {
+				if out.PermissionVersion == nil {
+					out.PermissionVersion = new(string)
+				}
+				*out.PermissionVersion = string(in.String())
+			}
+		case "sqs_url":
+			if in.IsNull() {
+				in.Skip()
+				out.SQSURL = nil
+			} else {
+				if out.SQSURL == nil {
+					out.SQSURL = new(string)
+				}
+				*out.SQSURL = string(in.String())
+			}
+		case "sqs_key":
+			if in.IsNull() {
+				in.Skip()
+				out.SQSKey = nil
+			} else {
+				if out.SQSKey == nil {
+					out.SQSKey = new(string)
+				}
+				*out.SQSKey = string(in.String())
+			}
+		case "sqs_secret":
+			if in.IsNull() {
+				in.Skip()
+				out.SQSSecret = nil
+			} else {
+				if out.SQSSecret == nil {
+					out.SQSSecret = new(string)
+				}
+				*out.SQSSecret = string(in.String())
+			}
+		case "sns_topic_arn":
+			if in.IsNull() {
+				in.Skip()
+				out.SNSTopicARN = nil
+			} else {
+				if out.SNSTopicARN == nil {
+					out.SNSTopicARN = new(string)
+				}
+				*out.SNSTopicARN = string(in.String())
+			}
+		case "sns_key":
+			if in.IsNull() {
+				in.Skip()
+				out.SNSKey = nil
+			} else {
+				if out.SNSKey == nil {
+					out.SNSKey = new(string)
+				}
+				*out.SNSKey = string(in.String())
+			}
+		case "sns_secret":
+			if in.IsNull() {
+				in.Skip()
+				out.SNSSecret = nil
+			} else {
+				if out.SNSSecret == nil {
+					out.SNSSecret = new(string)
+				}
+				*out.SNSSecret = string(in.String())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -6893,7 +30321,7 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo51(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo51(out *jwriter.Writer, in AppSettings) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2236(out *jwriter.Writer, in AppSettings) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6933,6 +30361,16 @@ func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo51(out *jwriter.Writer,
 		}
 		(*in.FirebaseConfig).MarshalEasyJSON(out)
 	}
+	if in.HuaweiConfig != nil {
+		const prefix string = ",\"huawei_config\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.HuaweiConfig).MarshalEasyJSON(out)
+	}
 	if in.WebhookURL != nil {
 		const prefix string = ",\"webhook_url\":"
 		if first {
@@ -6943,33 +30381,113 @@ func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo51(out *jwriter.Writer,
 		}
 		out.String(string(*in.WebhookURL))
 	}
+	if in.MultiTenantEnabled != nil {
+		const prefix string = ",\"multi_tenant_enabled\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(*in.MultiTenantEnabled))
+	}
+	if in.PermissionVersion != nil {
+		const prefix string = ",\"permission_version\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(*in.PermissionVersion))
+	}
+	if in.SQSURL != nil {
+		const prefix string = ",\"sqs_url\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(*in.SQSURL))
+	}
+	if in.SQSKey != nil {
+		const prefix string = ",\"sqs_key\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(*in.SQSKey))
+	}
+	if in.SQSSecret != nil {
+		const prefix string = ",\"sqs_secret\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(*in.SQSSecret))
+	}
+	if in.SNSTopicARN != nil {
+		const prefix string = ",\"sns_topic_arn\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(*in.SNSTopicARN))
+	}
+	if in.SNSKey != nil {
+		const prefix string = ",\"sns_key\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(*in.SNSKey))
+	}
+	if in.SNSSecret != nil {
+		const prefix string = ",\"sns_secret\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(*in.SNSSecret))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v AppSettings) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo51(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2236(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AppSettings) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo51(w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2236(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AppSettings) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo51(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2236(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AppSettings) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo51(l, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2236(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo52(in *jlexer.Lexer, out *AppConfig) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2237(in *jlexer.Lexer, out *AppConfig) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -7011,17 +30529,17 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo52(in *jlexer.Lexer, ou
 				for !in.IsDelim('}') {
 					key := string(in.String())
 					in.WantColon()
-					var v122 *ChannelConfig
+					var v457 *ChannelConfig
 					if in.IsNull() {
 						in.Skip()
-						v122 = nil
+						v457 = nil
 					} else {
-						if v122 == nil {
-							v122 = new(ChannelConfig)
+						if v457 == nil {
+							v457 = new(ChannelConfig)
 						}
-						(*v122).UnmarshalEasyJSON(in)
+						(*v457).UnmarshalEasyJSON(in)
 					}
-					(out.ConfigNameMap)[key] = v122
+					(out.ConfigNameMap)[key] = v457
 					in.WantComma()
 				}
 				in.Delim('}')
@@ -7039,30 +30557,30 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo52(in *jlexer.Lexer, ou
 				for !in.IsDelim('}') {
 					key := string(in.String())
 					in.WantColon()
-					var v123 []Policy
+					var v458 []Policy
 					if in.IsNull() {
 						in.Skip()
-						v123 = nil
+						v458 = nil
 					} else {
 						in.Delim('[')
-						if v123 == nil {
+						if v458 == nil {
 							if !in.IsDelim(']') {
-								v123 = make([]Policy, 0, 0)
+								v458 = make([]Policy, 0, 0)
 							} else {
-								v123 = []Policy{}
+								v458 = []Policy{}
 							}
 						} else {
-							v123 = (v123)[:0]
+							v458 = (v458)[:0]
 						}
 						for !in.IsDelim(']') {
-							var v124 Policy
-							(v124).UnmarshalEasyJSON(in)
-							v123 = append(v123, v124)
+							var v459 Policy
+							(v459).UnmarshalEasyJSON(in)
+							v458 = append(v458, v459)
 							in.WantComma()
 						}
 						in.Delim(']')
 					}
-					(out.Policies)[key] = v123
+					(out.Policies)[key] = v458
 					in.WantComma()
 				}
 				in.Delim('}')
@@ -7073,6 +30591,22 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo52(in *jlexer.Lexer, ou
 			out.DisableAuth = bool(in.Bool())
 		case "disable_permissions_checks":
 			out.DisablePermissions = bool(in.Bool())
+		case "multi_tenant_enabled":
+			out.MultiTenantEnabled = bool(in.Bool())
+		case "permission_version":
+			out.PermissionVersion = string(in.String())
+		case "sqs_url":
+			out.SQSURL = string(in.String())
+		case "sqs_key":
+			out.SQSKey = string(in.String())
+		case "sqs_secret":
+			out.SQSSecret = string(in.String())
+		case "sns_topic_arn":
+			out.SNSTopicARN = string(in.String())
+		case "sns_key":
+			out.SNSKey = string(in.String())
+		case "sns_secret":
+			out.SNSSecret = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -7083,7 +30617,7 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo52(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo52(out *jwriter.Writer, in AppConfig) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2237(out *jwriter.Writer, in AppConfig) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -7119,19 +30653,19 @@ func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo52(out *jwriter.Writer,
 			out.RawString(`null`)
 		} else {
 			out.RawByte('{')
-			v125First := true
-			for v125Name, v125Value := range in.ConfigNameMap {
-				if v125First {
-					v125First = false
+			v460First := true
+			for v460Name, v460Value := range in.ConfigNameMap {
+				if v460First {
+					v460First = false
 				} else {
 					out.RawByte(',')
 				}
-				out.String(string(v125Name))
+				out.String(string(v460Name))
 				out.RawByte(':')
-				if v125Value == nil {
+				if v460Value == nil {
 					out.RawString("null")
 				} else {
-					(*v125Value).MarshalEasyJSON(out)
+					(*v460Value).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte('}')
@@ -7144,24 +30678,24 @@ func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo52(out *jwriter.Writer,
 			out.RawString(`null`)
 		} else {
 			out.RawByte('{')
-			v126First := true
-			for v126Name, v126Value := range in.Policies {
-				if v126First {
-					v126First = false
+			v461First := true
+			for v461Name, v461Value := range in.Policies {
+				if v461First {
+					v461First = false
 				} else {
 					out.RawByte(',')
 				}
-				out.String(string(v126Name))
+				out.String(string(v461Name))
 				out.RawByte(':')
-				if v126Value == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+				if v461Value == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 					out.RawString("null")
 				} else {
 					out.RawByte('[')
-					for v127, v128 := range v126Value {
-						if v127 > 0 {
+					for v462, v463 := range v461Value {
+						if v462 > 0 {
 							out.RawByte(',')
 						}
-						(v128).MarshalEasyJSON(out)
+						(v463).MarshalEasyJSON(out)
 					}
 					out.RawByte(']')
 				}
@@ -7184,33 +30718,224 @@ func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo52(out *jwriter.Writer,
 		out.RawString(prefix)
 		out.Bool(bool(in.DisablePermissions))
 	}
+	{
+		const prefix string = ",\"multi_tenant_enabled\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.MultiTenantEnabled))
+	}
+	{
+		const prefix string = ",\"permission_version\":"
+		out.RawString(prefix)
+		out.String(string(in.PermissionVersion))
+	}
+	{
+		const prefix string = ",\"sqs_url\":"
+		out.RawString(prefix)
+		out.String(string(in.SQSURL))
+	}
+	{
+		const prefix string = ",\"sqs_key\":"
+		out.RawString(prefix)
+		out.String(string(in.SQSKey))
+	}
+	{
+		const prefix string = ",\"sqs_secret\":"
+		out.RawString(prefix)
+		out.String(string(in.SQSSecret))
+	}
+	{
+		const prefix string = ",\"sns_topic_arn\":"
+		out.RawString(prefix)
+		out.String(string(in.SNSTopicARN))
+	}
+	{
+		const prefix string = ",\"sns_key\":"
+		out.RawString(prefix)
+		out.String(string(in.SNSKey))
+	}
+	{
+		const prefix string = ",\"sns_secret\":"
+		out.RawString(prefix)
+		out.String(string(in.SNSSecret))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v AppConfig) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo52(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2237(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AppConfig) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo52(w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2237(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AppConfig) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo52(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2237(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AppConfig) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo52(l, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2237(l, v)
+}
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2238(in *jlexer.Lexer, out *APNPushConfig) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "apn_auth_type":
+			out.APNAuthType = string(in.String())
+		case "apn_auth_key":
+			out.APNAuthKey = string(in.String())
+		case "apn_key_id":
+			out.APNKeyID = string(in.String())
+		case "apn_team_id":
+			out.APNTeamID = string(in.String())
+		case "apn_topic":
+			out.APNTopic = string(in.String())
+		case "apn_p12_cert":
+			out.APNP12Cert = string(in.String())
+		case "apn_development":
+			out.APNDevelopment = bool(in.Bool())
+		case "apn_notification_template":
+			out.APNNotificationTemplate = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2238(out *jwriter.Writer, in APNPushConfig) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.APNAuthType != "" {
+		const prefix string = ",\"apn_auth_type\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.APNAuthType))
+	}
+	if in.APNAuthKey != "" {
+		const prefix string = ",\"apn_auth_key\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.APNAuthKey))
+	}
+	if in.APNKeyID != "" {
+		const prefix string = ",\"apn_key_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.APNKeyID))
+	}
+	if in.APNTeamID != "" {
+		const prefix string = ",\"apn_team_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.APNTeamID))
+	}
+	if in.APNTopic != "" {
+		const prefix string = ",\"apn_topic\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.APNTopic))
+	}
+	if in.APNP12Cert != "" {
+		const prefix string = ",\"apn_p12_cert\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.APNP12Cert))
+	}
+	if in.APNDevelopment {
+		const prefix string = ",\"apn_development\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.APNDevelopment))
+	}
+	if in.APNNotificationTemplate != "" {
+		const prefix string = ",\"apn_notification_template\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.APNNotificationTemplate))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v APNPushConfig) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2238(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v APNPushConfig) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2238(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *APNPushConfig) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2238(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *APNPushConfig) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2238(l, v)
 }
-func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo53(in *jlexer.Lexer, out *APNConfig) {
+func easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2239(in *jlexer.Lexer, out *APNConfig) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -7262,7 +30987,7 @@ func easyjson458e82b7DecodeGithubComGetStreamStreamChatGo53(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo53(out *jwriter.Writer, in APNConfig) {
+func easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2239(out *jwriter.Writer, in APNConfig) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -7317,23 +31042,23 @@ func easyjson458e82b7EncodeGithubComGetStreamStreamChatGo53(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v APNConfig) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo53(&w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2239(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v APNConfig) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson458e82b7EncodeGithubComGetStreamStreamChatGo53(w, v)
+	easyjson458e82b7EncodeGithubComGetStreamStreamChatGoV2239(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *APNConfig) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo53(&r, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2239(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *APNConfig) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson458e82b7DecodeGithubComGetStreamStreamChatGo53(l, v)
+	easyjson458e82b7DecodeGithubComGetStreamStreamChatGoV2239(l, v)
 }
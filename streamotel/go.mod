@@ -0,0 +1,30 @@
+module github.com/GetStream/stream-chat-go/v2/streamotel
+
+go 1.23
+
+require (
+	github.com/GetStream/stream-chat-go/v2 v2.1.0
+	github.com/stretchr/testify v1.10.0
+	go.opentelemetry.io/otel v1.34.0
+	go.opentelemetry.io/otel/metric v1.34.0
+	go.opentelemetry.io/otel/sdk v1.34.0
+	go.opentelemetry.io/otel/sdk/metric v1.34.0
+	go.opentelemetry.io/otel/trace v1.34.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/getstream/easyjson v0.0.0-20190923162548-5adc7b1f33fa // indirect
+	github.com/go-logr/logr v1.4.2 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	github.com/google/uuid v1.6.0 // indirect
+	github.com/gorilla/websocket v1.4.1 // indirect
+	github.com/pascaldekloe/jwt v1.5.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	go.opentelemetry.io/auto/sdk v1.1.0 // indirect
+	golang.org/x/sys v0.29.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+// only the HTTP client of the client is used, so any release works; development builds use the local client
+replace github.com/GetStream/stream-chat-go/v2 => ../
@@ -0,0 +1,140 @@
+// Package streamotel instruments the REST calls of a stream chat client with OpenTelemetry.
+// It's a separate module so the client doesn't depend on OpenTelemetry:
+//
+//	streamotel.Instrument(client, tracerProvider, meterProvider)
+//
+// Calls are instrumented by the HTTP transport of the client, so any client version is supported.
+package streamotel
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	stream "github.com/GetStream/stream-chat-go/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/GetStream/stream-chat-go/v2/streamotel"
+
+type instruments struct {
+	requests    metric.Int64Counter
+	duration    metric.Float64Histogram
+	rateLimited metric.Int64Counter
+}
+
+func newInstruments(meter metric.Meter) (*instruments, error) {
+	var (
+		inst instruments
+		err  error
+	)
+
+	inst.requests, err = meter.Int64Counter("stream_chat.client.requests",
+		metric.WithDescription("Number of API calls"))
+	if err != nil {
+		return nil, err
+	}
+
+	inst.duration, err = meter.Float64Histogram("stream_chat.client.request.duration",
+		metric.WithDescription("Duration of API calls"), metric.WithUnit("s"))
+	if err != nil {
+		return nil, err
+	}
+
+	inst.rateLimited, err = meter.Int64Counter("stream_chat.client.rate_limited",
+		metric.WithDescription("Number of API calls rejected by rate limits"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &inst, nil
+}
+
+// Instrument wraps the HTTP transport of the client with NewTransport
+func Instrument(c *stream.Client, tp trace.TracerProvider, mp metric.MeterProvider) {
+	httpClient := *c.HTTP
+	httpClient.Transport = NewTransport(httpClient.Transport, tp, mp)
+	c.HTTP = &httpClient
+}
+
+// NewTransport returns a transport creating a client span per API call with tp and recording
+// call counts, durations and rate limit hits with mp; nil providers use the global ones and
+// nil base uses http.DefaultTransport. Every attempt of retried uploads is a call of its own.
+// Spans are attributed with the endpoint path, metrics only with method and status to bound cardinality.
+func NewTransport(base http.RoundTripper, tp trace.TracerProvider, mp metric.MeterProvider) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if tp == nil {
+		tp = otel.GetTracerProvider()
+	}
+	if mp == nil {
+		mp = otel.GetMeterProvider()
+	}
+
+	inst, err := newInstruments(mp.Meter(instrumentationName))
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	return &transport{base: base, tracer: tp.Tracer(instrumentationName), inst: inst}
+}
+
+type transport struct {
+	base   http.RoundTripper
+	tracer trace.Tracer
+	inst   *instruments
+}
+
+func (t *transport) RoundTrip(r *http.Request) (*http.Response, error) {
+	ctx, span := t.tracer.Start(r.Context(), "stream_chat "+r.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.request.method", r.Method),
+			attribute.String("url.path", r.URL.Path),
+			attribute.String("server.address", r.URL.Host),
+		),
+	)
+	defer span.End()
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(r.WithContext(ctx))
+	elapsed := time.Since(start)
+
+	attrs := []attribute.KeyValue{attribute.String("http.request.method", r.Method)}
+
+	switch {
+	case err != nil:
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		attrs = append(attrs, attribute.String("error.type", "network"))
+	default:
+		span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+		if remaining := resp.Header.Get("X-RateLimit-Remaining"); remaining != "" {
+			if n, err := strconv.Atoi(remaining); err == nil {
+				span.SetAttributes(attribute.Int("stream_chat.rate_limit.remaining", n))
+			}
+		}
+		if resp.StatusCode >= http.StatusBadRequest {
+			span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+		}
+		attrs = append(attrs, attribute.Int("http.response.status_code", resp.StatusCode))
+	}
+
+	if t.inst != nil {
+		ctx := r.Context()
+		set := metric.WithAttributes(attrs...)
+
+		t.inst.requests.Add(ctx, 1, set)
+		t.inst.duration.Record(ctx, elapsed.Seconds(), set)
+		if err == nil && resp.StatusCode == http.StatusTooManyRequests {
+			t.inst.rateLimited.Add(ctx, 1, set)
+		}
+	}
+
+	return resp, err
+}
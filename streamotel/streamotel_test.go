@@ -0,0 +1,66 @@
+package streamotel
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	stream "github.com/GetStream/stream-chat-go/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestWithTelemetry(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = w.Write([]byte(`{"code":9,"message":"too many requests"}`))
+	}))
+	defer srv.Close()
+
+	spans := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))
+
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	c, err := stream.NewClient("key", []byte("secret"))
+	require.NoError(t, err)
+	c.BaseURL = srv.URL
+	Instrument(c, tp, mp)
+
+	_, err = c.GetCommand("location")
+	require.Error(t, err, "rate limited")
+
+	ended := spans.Ended()
+	require.Len(t, ended, 1, "spans")
+	span := ended[0]
+	assert.Equal(t, "stream_chat GET", span.Name())
+	assert.Equal(t, codes.Error, span.Status().Code)
+	assert.Contains(t, span.Attributes(), attribute.String("url.path", "/commands/location"))
+	assert.Contains(t, span.Attributes(), attribute.Int("http.response.status_code", http.StatusTooManyRequests))
+	assert.Contains(t, span.Attributes(), attribute.Int("stream_chat.rate_limit.remaining", 0))
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+
+	counts := map[string]int64{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if sum, ok := m.Data.(metricdata.Sum[int64]); ok {
+				for _, dp := range sum.DataPoints {
+					counts[m.Name] += dp.Value
+				}
+			}
+		}
+	}
+	assert.Equal(t, int64(1), counts["stream_chat.client.requests"])
+	assert.Equal(t, int64(1), counts["stream_chat.client.rate_limited"])
+}
@@ -0,0 +1,90 @@
+package stream_chat // nolint: golint
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+)
+
+type TaskStatus string
+
+const (
+	TaskStatusWaiting   TaskStatus = "waiting"
+	TaskStatusPending   TaskStatus = "pending"
+	TaskStatusRunning   TaskStatus = "running"
+	TaskStatusCompleted TaskStatus = "completed"
+	TaskStatusFailed    TaskStatus = "failed"
+
+	taskPollInterval    = 500 * time.Millisecond
+	taskPollMaxInterval = 5 * time.Second
+)
+
+// Task is an async operation, ie users deletion or export
+type Task struct {
+	TaskID string     `json:"task_id"`
+	Status TaskStatus `json:"status"` // one of TaskStatus* constants
+
+	Result map[string]interface{} `json:"result,omitempty"`
+	Error  map[string]interface{} `json:"error,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Done returns true if the task is completed or failed
+func (t *Task) Done() bool {
+	return t.Status == TaskStatusCompleted || t.Status == TaskStatusFailed
+}
+
+type taskResponse struct {
+	TaskID string `json:"task_id"`
+}
+
+// GetTask returns the task with given ID
+func (c *Client) GetTask(taskID string) (*Task, error) {
+	if taskID == "" {
+		return nil, errors.New("task ID is empty")
+	}
+
+	p := path.Join("tasks", url.PathEscape(taskID))
+
+	var task Task
+
+	err := c.makeRequest(http.MethodGet, p, nil, nil, &task)
+	if err != nil {
+		return nil, err
+	}
+
+	return &task, nil
+}
+
+// WaitForTask polls the task with given ID until it's done or timeout is reached.
+// Poll interval starts from 500ms and doubles up to 5s.
+// Returns the task, which may be failed; check Task.Status
+func (c *Client) WaitForTask(taskID string, timeout time.Duration) (*Task, error) {
+	deadline := time.Now().Add(timeout)
+	interval := taskPollInterval
+
+	for {
+		task, err := c.GetTask(taskID)
+		if err != nil {
+			return nil, err
+		}
+		if task.Done() {
+			return task, nil
+		}
+
+		if time.Now().Add(interval).After(deadline) {
+			return task, fmt.Errorf("task %s is not done in %s: status %s", taskID, timeout, task.Status)
+		}
+
+		time.Sleep(interval)
+
+		if interval *= 2; interval > taskPollMaxInterval {
+			interval = taskPollMaxInterval
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package stream_chat // nolint: golint
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetTask(t *testing.T) {
+	c := initClient(t)
+
+	taskID, err := c.ExportUsers([]string{randomUser().ID})
+	mustNoError(t, err, "export users")
+
+	task, err := c.GetTask(taskID)
+	mustNoError(t, err, "get task")
+	assert.Equal(t, taskID, task.TaskID)
+
+	task, err = c.WaitForTask(taskID, time.Minute)
+	mustNoError(t, err, "wait for task")
+	assert.Equal(t, TaskStatusCompleted, task.Status, "task completed")
+}
+
+func TestClient_WaitForTask(t *testing.T) {
+	var calls int
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+
+		status := TaskStatusRunning
+		if calls > 1 {
+			status = TaskStatusCompleted
+		}
+		fmt.Fprintf(w, `{"task_id":"task","status":%q}`, status)
+	}))
+	defer srv.Close()
+
+	c, err := NewClient("key", []byte("secret"))
+	mustNoError(t, err, "new client")
+	c.BaseURL = srv.URL
+
+	task, err := c.WaitForTask("task", time.Minute)
+	mustNoError(t, err, "wait for task")
+
+	assert.Equal(t, TaskStatusCompleted, task.Status, "task completed")
+	assert.Equal(t, 2, calls, "task polled")
+
+	calls = -10
+	_, err = c.WaitForTask("task", time.Second)
+	mustError(t, err, "task timeout")
+}
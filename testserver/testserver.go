@@ -0,0 +1,285 @@
+// Package testserver provides an in-memory emulation of the chat API for tests without credentials.
+// It supports upserting users, creating and querying channels and sending messages:
+//
+//	srv := testserver.New()
+//	defer srv.Close()
+//
+//	client, err := srv.NewClient()
+//	...
+//	ch, err := client.CreateChannel("messaging", "general", "bob", nil)
+//
+// Other endpoints respond with 501 Not Implemented.
+package testserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	stream_chat "github.com/GetStream/stream-chat-go/v2"
+)
+
+const (
+	APIKey    = "testserver-key"
+	APISecret = "testserver-secret"
+)
+
+type object = map[string]interface{}
+
+type channel struct {
+	data     object
+	members  []string
+	messages []object
+}
+
+// Server is an in-memory chat API server
+type Server struct {
+	srv *httptest.Server
+
+	mu       sync.Mutex
+	users    map[string]object
+	channels map[string]*channel // by cid
+	seq      int
+}
+
+// New starts a new server, it should be closed when done
+func New() *Server {
+	s := &Server{
+		users:    map[string]object{},
+		channels: map[string]*channel{},
+	}
+	s.srv = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// URL returns the base URL of the server
+func (s *Server) URL() string {
+	return s.srv.URL
+}
+
+// Close shuts down the server
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// NewClient returns a client of the server
+func (s *Server) NewClient() (*stream_chat.Client, error) {
+	c, err := stream_chat.NewClient(APIKey, []byte(APISecret))
+	if err != nil {
+		return nil, err
+	}
+
+	c.BaseURL = s.srv.URL
+
+	return c, nil
+}
+
+// Messages returns the messages sent to the channel with given cid, ie "messaging:general"
+func (s *Server) Messages(cid string) []map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ch, ok := s.channels[cid]
+	if !ok {
+		return nil
+	}
+
+	return append([]object(nil), ch.messages...)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Query().Get("api_key") != APIKey || r.Header.Get("Authorization") == "" {
+		writeError(w, http.StatusUnauthorized, stream_chat.ErrorCodeAuthFailed, "api key or token is invalid")
+		return
+	}
+
+	var body object
+	if r.Body != nil && r.Method != http.MethodGet {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeError(w, http.StatusBadRequest, stream_chat.ErrorCodeInputError, "invalid JSON body: "+err.Error())
+			return
+		}
+	}
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case r.Method == http.MethodPost && len(parts) == 1 && parts[0] == "users":
+		s.upsertUsers(w, body)
+	case r.Method == http.MethodPost && len(parts) == 3 && parts[0] == "channels" && parts[2] == "query":
+		s.queryChannel(w, parts[1], "", body)
+	case r.Method == http.MethodPost && len(parts) == 4 && parts[0] == "channels" && parts[3] == "query":
+		s.queryChannel(w, parts[1], parts[2], body)
+	case r.Method == http.MethodPost && len(parts) == 4 && parts[0] == "channels" && parts[3] == "message":
+		s.sendMessage(w, parts[1]+":"+parts[2], body)
+	default:
+		writeError(w, http.StatusNotImplemented, 0, r.Method+" "+r.URL.Path+" is not emulated")
+	}
+}
+
+func (s *Server) upsertUsers(w http.ResponseWriter, body object) {
+	users, _ := body["users"].(object)
+
+	resp := make(object, len(users))
+
+	for id, u := range users {
+		user, _ := u.(object)
+		if user == nil {
+			writeError(w, http.StatusBadRequest, stream_chat.ErrorCodeInputError, "user "+id+" is invalid")
+			return
+		}
+
+		now := s.timestamp()
+		if old, ok := s.users[id]; ok {
+			user["created_at"] = old["created_at"]
+		} else {
+			user["created_at"] = now
+		}
+		user["updated_at"] = now
+		user["role"] = valueOr(user["role"], "user")
+
+		s.users[id] = user
+		resp[id] = user
+	}
+
+	writeJSON(w, object{"users": resp})
+}
+
+func (s *Server) queryChannel(w http.ResponseWriter, chanType, id string, body object) {
+	data, _ := body["data"].(object)
+
+	members := stringSlice(data["members"])
+
+	if id == "" {
+		if len(members) == 0 {
+			writeError(w, http.StatusBadRequest, stream_chat.ErrorCodeInputError, "either channel ID or members must be provided")
+			return
+		}
+		// distinct channels are identified by their members
+		id = "!members-" + strings.Join(members, "-")
+	}
+
+	cid := chanType + ":" + id
+
+	ch, ok := s.channels[cid]
+	if !ok {
+		ch = &channel{data: object{}}
+
+		for k, v := range data {
+			if k != "members" {
+				ch.data[k] = v
+			}
+		}
+
+		now := s.timestamp()
+		ch.data["id"] = id
+		ch.data["type"] = chanType
+		ch.data["cid"] = cid
+		ch.data["created_at"] = now
+		ch.data["updated_at"] = now
+		ch.data["created_by"] = s.user(data["created_by"])
+		ch.members = members
+
+		s.channels[cid] = ch
+	}
+
+	ch.data["member_count"] = len(ch.members)
+
+	channelMembers := make([]object, 0, len(ch.members))
+	for _, m := range ch.members {
+		channelMembers = append(channelMembers, object{"user_id": m, "user": s.user(object{"id": m}), "role": "member"})
+	}
+
+	writeJSON(w, object{
+		"channel":  ch.data,
+		"members":  channelMembers,
+		"messages": ch.messages,
+	})
+}
+
+func (s *Server) sendMessage(w http.ResponseWriter, cid string, body object) {
+	ch, ok := s.channels[cid]
+	if !ok {
+		writeError(w, http.StatusNotFound, stream_chat.ErrorCodeDoesNotExist, "channel "+cid+" doesn't exist")
+		return
+	}
+
+	msg, _ := body["message"].(object)
+	if msg == nil {
+		writeError(w, http.StatusBadRequest, stream_chat.ErrorCodeInputError, "message is missing")
+		return
+	}
+
+	if id, _ := msg["id"].(string); id == "" {
+		s.seq++
+		msg["id"] = fmt.Sprintf("message-%d", s.seq)
+	}
+
+	now := s.timestamp()
+	msg["user"] = s.user(msg["user"])
+	msg["type"] = valueOr(msg["type"], "regular")
+	msg["cid"] = cid
+	msg["created_at"] = now
+	msg["updated_at"] = now
+
+	ch.messages = append(ch.messages, msg)
+	ch.data["last_message_at"] = now
+
+	writeJSON(w, object{"message": msg})
+}
+
+// user returns the stored user of the user reference, ie {"id": "bob"}
+func (s *Server) user(ref interface{}) object {
+	r, _ := ref.(object)
+	id, _ := r["id"].(string)
+
+	if u, ok := s.users[id]; ok {
+		return u
+	}
+
+	return object{"id": id}
+}
+
+func (s *Server) timestamp() string {
+	return time.Now().UTC().Format(time.RFC3339Nano)
+}
+
+func valueOr(v interface{}, def string) interface{} {
+	if s, _ := v.(string); s != "" {
+		return s
+	}
+
+	return def
+}
+
+func stringSlice(v interface{}) []string {
+	items, _ := v.([]interface{})
+
+	ss := make([]string, 0, len(items))
+	for _, item := range items {
+		if s, ok := item.(string); ok {
+			ss = append(ss, s)
+		}
+	}
+
+	return ss
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status, code int, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(object{"code": code, "message": msg, "StatusCode": status})
+}
@@ -0,0 +1,57 @@
+package testserver
+
+import (
+	"testing"
+
+	stream_chat "github.com/GetStream/stream-chat-go/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer(t *testing.T) {
+	srv := New()
+	defer srv.Close()
+
+	c, err := srv.NewClient()
+	require.NoError(t, err, "new client")
+
+	users, err := c.UpsertUsers(
+		&stream_chat.User{ID: "bob", Name: "Bob", ExtraData: map[string]interface{}{"age": 42}},
+		&stream_chat.User{ID: "alice"},
+	)
+	require.NoError(t, err, "upsert users")
+	assert.Equal(t, "Bob", users["bob"].Name)
+	assert.Equal(t, "user", users["bob"].Role)
+	assert.Equal(t, float64(42), users["bob"].ExtraData["age"])
+	assert.NotNil(t, users["bob"].CreatedAt, "created at")
+
+	ch, err := c.CreateChannel("messaging", "general", "bob", map[string]interface{}{
+		"members": []string{"bob", "alice"},
+		"name":    "General",
+	})
+	require.NoError(t, err, "create channel")
+	assert.Equal(t, "messaging:general", ch.CID)
+	assert.Equal(t, "General", ch.ExtraData["name"])
+	assert.Equal(t, "Bob", ch.CreatedBy.Name)
+	assert.Equal(t, 2, ch.MemberCount)
+
+	msg, err := ch.SendMessage(&stream_chat.Message{Text: "hi"}, "alice")
+	require.NoError(t, err, "send message")
+	assert.NotEmpty(t, msg.ID)
+	assert.Equal(t, "alice", msg.User.ID)
+	assert.Len(t, srv.Messages("messaging:general"), 1)
+
+	require.NoError(t, ch.Refresh(), "refresh channel")
+	if assert.Len(t, ch.Messages, 1) {
+		assert.Equal(t, "hi", ch.Messages[0].Text)
+	}
+
+	distinct, err := c.CreateChannel("messaging", "", "bob", map[string]interface{}{
+		"members": []string{"bob", "alice"},
+	})
+	require.NoError(t, err, "create distinct channel")
+	assert.NotEmpty(t, distinct.ID)
+
+	_, err = c.GetCommand("location")
+	assert.Error(t, err, "not emulated endpoint")
+}
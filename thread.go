@@ -0,0 +1,131 @@
+package stream_chat // nolint: golint
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"time"
+)
+
+type ThreadParticipant struct {
+	ChannelCID          string     `json:"channel_cid"`
+	UserID              string     `json:"user_id"`
+	User                *User      `json:"user,omitempty"`
+	LastReadAt          *time.Time `json:"last_read_at,omitempty"`
+	LastThreadMessageAt *time.Time `json:"last_thread_message_at,omitempty"`
+	LeftThreadAt        *time.Time `json:"left_thread_at,omitempty"`
+	CreatedAt           time.Time  `json:"created_at"`
+}
+
+// Thread is a thread of replies to the parent message
+type Thread struct {
+	ChannelCID      string   `json:"channel_cid"`
+	Channel         *Channel `json:"channel,omitempty"`
+	ParentMessageID string   `json:"parent_message_id"`
+	ParentMessage   *Message `json:"parent_message,omitempty"`
+	CreatedByUserID string   `json:"created_by_user_id"`
+	CreatedBy       *User    `json:"created_by,omitempty"`
+	Title           string   `json:"title,omitempty"`
+
+	ReplyCount       int                  `json:"reply_count"`
+	ParticipantCount int                  `json:"participant_count"`
+	Participants     []*ThreadParticipant `json:"thread_participants"`
+	LatestReplies    []*Message           `json:"latest_replies"`
+	Read             []*ChannelRead       `json:"read"`
+
+	LastMessageAt *time.Time `json:"last_message_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+	DeletedAt     *time.Time `json:"deleted_at,omitempty"`
+
+	ExtraData map[string]interface{} `json:"-,extra"` //nolint: staticcheck
+}
+
+// ThreadOptions limit the nested data returned with threads, zero values use server defaults
+type ThreadOptions struct {
+	ReplyLimit       int `json:"reply_limit,omitempty"`       // number of latest replies
+	ParticipantLimit int `json:"participant_limit,omitempty"` // number of participants
+	MemberLimit      int `json:"member_limit,omitempty"`      // number of channel members
+}
+
+func (o ThreadOptions) values() url.Values {
+	params := url.Values{}
+
+	if o.ReplyLimit > 0 {
+		params.Set("reply_limit", strconv.Itoa(o.ReplyLimit))
+	}
+	if o.ParticipantLimit > 0 {
+		params.Set("participant_limit", strconv.Itoa(o.ParticipantLimit))
+	}
+	if o.MemberLimit > 0 {
+		params.Set("member_limit", strconv.Itoa(o.MemberLimit))
+	}
+
+	return params
+}
+
+// QueryThreadsRequest filters threads the user participates in,
+// Next/Prev are cursors of a previous QueryThreadsResponse
+type QueryThreadsRequest struct {
+	Filter map[string]interface{} `json:"filter,omitempty"`
+	Sort   []*SortOption          `json:"sort,omitempty"`
+	Limit  int                    `json:"limit,omitempty"`
+	Next   string                 `json:"next,omitempty"`
+	Prev   string                 `json:"prev,omitempty"`
+
+	ThreadOptions
+}
+
+type queryThreadsRequest struct {
+	QueryThreadsRequest
+
+	UserID string `json:"user_id"`
+}
+
+type QueryThreadsResponse struct {
+	Threads []*Thread `json:"threads"`
+	Next    string    `json:"next"`
+	Prev    string    `json:"prev"`
+}
+
+type threadResponse struct {
+	Thread *Thread `json:"thread"`
+}
+
+// QueryThreads returns threads of the user with given ID matching the request, most recently active first
+func (c *Client) QueryThreads(userID string, req QueryThreadsRequest) (*QueryThreadsResponse, error) {
+	if userID == "" {
+		return nil, errors.New("user ID is empty")
+	}
+
+	data := queryThreadsRequest{QueryThreadsRequest: req, UserID: userID}
+
+	var resp QueryThreadsResponse
+
+	err := c.makeRequest(http.MethodPost, "threads", nil, data, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetThread returns the thread of the parent message with given ID
+func (c *Client) GetThread(messageID string, options ThreadOptions) (*Thread, error) {
+	if messageID == "" {
+		return nil, errors.New("message ID is empty")
+	}
+
+	p := path.Join("threads", url.PathEscape(messageID))
+
+	var resp threadResponse
+
+	err := c.makeRequest(http.MethodGet, p, options.values(), nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Thread, nil
+}
@@ -0,0 +1,38 @@
+package stream_chat // nolint: golint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Threads(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+	defer func() {
+		mustNoError(t, ch.Delete(), "delete channel")
+	}()
+
+	author := testUsers[0].ID
+
+	parent, err := ch.SendMessage(&Message{Text: "parent message"}, author)
+	mustNoError(t, err, "send message")
+
+	_, err = ch.SendMessage(&Message{Text: "reply", ParentID: parent.ID}, testUsers[1].ID)
+	mustNoError(t, err, "send reply")
+
+	thread, err := c.GetThread(parent.ID, ThreadOptions{ReplyLimit: 10})
+	mustNoError(t, err, "get thread")
+	assert.Equal(t, parent.ID, thread.ParentMessageID)
+	assert.Equal(t, 1, thread.ReplyCount, "reply count")
+	assert.Len(t, thread.LatestReplies, 1, "latest replies")
+
+	resp, err := c.QueryThreads(author, QueryThreadsRequest{
+		Filter:        map[string]interface{}{"channel_cid": ch.CID},
+		ThreadOptions: ThreadOptions{ParticipantLimit: 5},
+	})
+	mustNoError(t, err, "query threads")
+	if assert.Len(t, resp.Threads, 1) {
+		assert.NotEmpty(t, resp.Threads[0].Participants, "thread participants")
+	}
+}
@@ -0,0 +1,59 @@
+package stream_chat // nolint: golint
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/getstream/easyjson"
+)
+
+// timestampRe matches string values of known timestamp fields, ie "created_at": "2020-01-02 15:04:05.000".
+// Only fields of the client types are matched, custom fields are kept as they are.
+// nolint: gochecknoglobals
+var timestampRe = regexp.MustCompile(`("(?:` +
+	`approved_at|archived_at|ban_expires|created_at|deactivated_at|deleted_at|disabled_at|disabled_until|` +
+	`expires|invite_accepted_at|invite_rejected_at|last_active|last_message_at|last_read|last_read_at|` +
+	`last_thread_message_at|left_thread_at|message_updated_at|pin_expires|pinned_at|rejected_at|remind_at|` +
+	`reviewed_at|scheduled_for|stop_at|truncated_at|updated_at` +
+	`)"\s*:\s*)"(?:(\d{4}-\d{2}-\d{2})[T ](\d{2}:\d{2}:\d{2}(?:\.\d+)?)(Z|[+-]\d{2}:?\d{2})?)?"`)
+
+// unmarshalResponse decodes the response body into result. Bodies with timestamps time.Time can't
+// decode are normalized and decoded again, so the common case isn't slowed down.
+func unmarshalResponse(body []byte, result easyjson.Unmarshaler) error {
+	err := easyjson.Unmarshal(body, result)
+	if _, ok := err.(*time.ParseError); ok {
+		return easyjson.Unmarshal(normalizeTimestamps(body), result)
+	}
+
+	return err
+}
+
+// normalizeTimestamps rewrites timestamps of responses into RFC3339 which time.Time decodes.
+// Legacy timestamps without zone are in UTC, zones without colon are fixed and empty ones are null.
+func normalizeTimestamps(body []byte) []byte {
+	return timestampRe.ReplaceAllFunc(body, func(match []byte) []byte {
+		m := timestampRe.FindSubmatch(match)
+		key, date, clock, zone := m[1], m[2], m[3], m[4]
+
+		// submatches share the memory of the body, so the replacement is a new slice
+		ts := make([]byte, 0, len(match)+2)
+		ts = append(ts, key...)
+
+		switch {
+		case len(date) == 0:
+			return append(ts, "null"...)
+		case len(zone) == 0:
+			zone = []byte("Z")
+		case len(zone) == 5:
+			zone = []byte(string(zone[:3]) + ":" + string(zone[3:]))
+		}
+
+		ts = append(ts, '"')
+		ts = append(ts, date...)
+		ts = append(ts, 'T')
+		ts = append(ts, clock...)
+		ts = append(ts, zone...)
+
+		return append(ts, '"')
+	})
+}
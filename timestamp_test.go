@@ -0,0 +1,71 @@
+package stream_chat // nolint: golint
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeTimestamps(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			name: "rfc3339",
+			in:   `{"created_at":"2020-01-02T15:04:05.123456Z"}`,
+			want: `{"created_at":"2020-01-02T15:04:05.123456Z"}`,
+		},
+		{
+			name: "legacy without zone",
+			in:   `{"created_at": "2020-01-02 15:04:05.123"}`,
+			want: `{"created_at": "2020-01-02T15:04:05.123Z"}`,
+		},
+		{
+			name: "zone without colon",
+			in:   `{"last_read":"2020-01-02T15:04:05+0300"}`,
+			want: `{"last_read":"2020-01-02T15:04:05+03:00"}`,
+		},
+		{
+			name: "empty",
+			in:   `{"deleted_at":"","text":"deleted_at"}`,
+			want: `{"deleted_at":null,"text":"deleted_at"}`,
+		},
+		{
+			name: "other fields",
+			in:   `{"text":"2020-01-02 15:04:05","expires_at":"","quote":"\"created_at\":\"\""}`,
+			want: `{"text":"2020-01-02 15:04:05","expires_at":"","quote":"\"created_at\":\"\""}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, string(normalizeTimestamps([]byte(test.in))))
+		})
+	}
+}
+
+func TestClient_ParseLegacyTimestamps(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"users":[{"id":"bob","created_at":"2020-01-02 15:04:05","deactivated_at":"",` +
+			`"expires_at":"","score":1}]}`))
+	}))
+	defer srv.Close()
+
+	c, err := NewClient("key", []byte("secret"), WithBaseURL(srv.URL))
+	mustNoError(t, err, "new client")
+
+	users, err := c.QueryUsers(&QueryOption{Filter: map[string]interface{}{"id": "bob"}})
+	mustNoError(t, err, "query users")
+
+	if assert.Len(t, users, 1) {
+		assert.Equal(t, time.Date(2020, 1, 2, 15, 4, 5, 0, time.UTC), *users[0].CreatedAt)
+		assert.Nil(t, users[0].DeactivatedAt)
+		assert.Equal(t, map[string]interface{}{"expires_at": "", "score": float64(1)}, users[0].ExtraData,
+			"custom fields are kept")
+	}
+}
@@ -0,0 +1,9 @@
+//go:build !go1.13
+// +build !go1.13
+
+package stream_chat // nolint: golint
+
+import "net/http"
+
+// enableHTTP2 is a no-op, transports with custom dialer use HTTP/1.1 before Go 1.13
+func enableHTTP2(t *http.Transport) {}
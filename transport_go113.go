@@ -0,0 +1,11 @@
+//go:build go1.13
+// +build go1.13
+
+package stream_chat // nolint: golint
+
+import "net/http"
+
+// enableHTTP2 keeps HTTP/2 of the transport with custom dialer and TLS config
+func enableHTTP2(t *http.Transport) {
+	t.ForceAttemptHTTP2 = true
+}
@@ -1,6 +1,7 @@
 package stream_chat //nolint: golint
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -10,10 +11,11 @@ import (
 )
 
 type Mute struct {
-	User      User      `json:"user"`
-	Target    User      `json:"target"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	User      User       `json:"user"`
+	Target    User       `json:"target"`
+	Expires   *time.Time `json:"expires,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
 }
 
 type User struct {
@@ -22,46 +24,95 @@ type User struct {
 	Image string `json:"image,omitempty"`
 	Role  string `json:"role,omitempty"`
 
+	Language string `json:"language,omitempty"` // preferred language for auto translation, ie "fr"
+
+	Teams []string `json:"teams,omitempty"` // teams of the user in multi-tenant apps
+
 	Online    bool `json:"online,omitempty"`
 	Invisible bool `json:"invisible,omitempty"`
 
-	CreatedAt  *time.Time `json:"created_at,omitempty"`
-	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
-	LastActive *time.Time `json:"last_active,omitempty"`
+	Banned       bool       `json:"banned,omitempty"`
+	BanExpires   *time.Time `json:"ban_expires,omitempty"` // app wide ban expiration, nil for permanent bans
+	ShadowBanned bool       `json:"shadow_banned,omitempty"`
 
+	CreatedAt     *time.Time `json:"created_at,omitempty"`
+	UpdatedAt     *time.Time `json:"updated_at,omitempty"`
+	LastActive    *time.Time `json:"last_active,omitempty"`
+	DeactivatedAt *time.Time `json:"deactivated_at,omitempty"`
+	DeletedAt     *time.Time `json:"deleted_at,omitempty"`
+
+	// custom fields of the user.
+	// Numbers are decoded as float64, integers beyond 2^53 lose precision; store them as strings.
 	ExtraData map[string]interface{} `json:"-,extra"` //nolint: staticcheck
 
-	Mutes []*Mute `json:"mutes,omitempty"`
+	Mutes        []*Mute        `json:"mutes,omitempty"`
+	ChannelMutes []*ChannelMute `json:"channel_mutes,omitempty"`
 }
 
 // MuteUser create a mute
 // targetID: the user getting muted
 // userID: the user muting the target
 func (c *Client) MuteUser(targetID, userID string) error {
-	switch {
-	case targetID == "":
-		return errors.New("target ID is empty")
-	case userID == "":
-		return errors.New("user ID is empty")
+	_, err := c.MuteUserWithExpiration(targetID, userID, 0)
+	return err
+}
+
+// MuteUserWithExpiration creates a mute which is removed after the expiration; zero expiration never expires.
+// Expiration is rounded down to minutes, shorter expirations are rejected.
+// Returns the created mute.
+// targetID: the user getting muted
+// userID: the user muting the target
+func (c *Client) MuteUserWithExpiration(targetID, userID string, expiration time.Duration) (*Mute, error) {
+	if targetID == "" {
+		return nil, errors.New("target ID is empty")
 	}
 
-	data := map[string]interface{}{
-		"target_id": targetID,
-		"user_id":   userID,
+	mutes, err := c.muteUsers([]string{targetID}, userID, expiration)
+	if err != nil {
+		return nil, err
+	}
+	if len(mutes) == 0 {
+		return nil, errors.New("unexpected error: mute response is empty")
 	}
 
-	return c.makeRequest(http.MethodPost, "moderation/mute", nil, data, nil)
+	return mutes[0], nil
 }
 
 // MuteUsers creates a mute
 // targetID: the user getting muted
 // userID: the user muting the target
 func (c *Client) MuteUsers(targetIDs []string, userID string) error {
+	_, err := c.MuteUsersWithExpiration(targetIDs, userID, 0)
+	return err
+}
+
+// MuteUsersWithExpiration creates mutes which are removed after the expiration; zero expiration never expires.
+// Expiration is rounded down to minutes, shorter expirations are rejected.
+// Returns the created mutes.
+// targetIDs: the users getting muted
+// userID: the user muting the targets
+func (c *Client) MuteUsersWithExpiration(targetIDs []string, userID string, expiration time.Duration) ([]*Mute, error) {
+	if len(targetIDs) == 0 {
+		return nil, errors.New("target IDs are empty")
+	}
+
+	return c.muteUsers(targetIDs, userID, expiration)
+}
+
+type muteResponse struct {
+	Mute  *Mute   `json:"mute"`
+	Mutes []*Mute `json:"mutes"`
+}
+
+func (c *Client) muteUsers(targetIDs []string, userID string, expiration time.Duration) ([]*Mute, error) {
 	switch {
-	case len(targetIDs) == 0:
-		return errors.New("target IDs are empty")
 	case userID == "":
-		return errors.New("user ID is empty")
+		return nil, errors.New("user ID is empty")
+	case expiration < 0:
+		return nil, errors.New("expiration must be not negative")
+	case expiration > 0 && expiration < time.Minute:
+		// timeout is in minutes and 0 means a mute without expiration
+		return nil, errors.New("expiration must be at least a minute")
 	}
 
 	data := map[string]interface{}{
@@ -69,7 +120,23 @@ func (c *Client) MuteUsers(targetIDs []string, userID string) error {
 		"user_id":    userID,
 	}
 
-	return c.makeRequest(http.MethodPost, "moderation/mute", nil, data, nil)
+	if expiration > 0 {
+		// timeout is set in minutes
+		data["timeout"] = int64(expiration / time.Minute)
+	}
+
+	var resp muteResponse
+
+	err := c.makeRequest(http.MethodPost, "moderation/mute", nil, data, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Mutes) == 0 && resp.Mute != nil {
+		return []*Mute{resp.Mute}, nil
+	}
+
+	return resp.Mutes, nil
 }
 
 // UnmuteUser removes a mute
@@ -110,6 +177,70 @@ func (c *Client) UnmuteUsers(targetIDs []string, userID string) error {
 	return c.makeRequest(http.MethodPost, "moderation/unmute", nil, data, nil)
 }
 
+// UserBlock is a block of a user by another user, blocked users can't message or reach the user
+type UserBlock struct {
+	UserID        string    `json:"user_id"`
+	BlockedUserID string    `json:"blocked_user_id"`
+	BlockedUser   *User     `json:"blocked_user,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type blockUserRequest struct {
+	BlockedUserID string `json:"blocked_user_id"`
+	UserID        string `json:"user_id"`
+}
+
+type blockedUsersResponse struct {
+	Blocks []*UserBlock `json:"blocks"`
+}
+
+// BlockUser adds the target to the personal block list of the user, unlike bans it isn't a moderation action
+// targetID: the user getting blocked
+// userID: the user blocking the target
+func (c *Client) BlockUser(targetID, userID string) error {
+	return c.blockUser("users/block", targetID, userID)
+}
+
+// UnblockUser removes the target from the personal block list of the user
+func (c *Client) UnblockUser(targetID, userID string) error {
+	return c.blockUser("users/unblock", targetID, userID)
+}
+
+func (c *Client) blockUser(p, targetID, userID string) error {
+	switch {
+	case targetID == "":
+		return errors.New("target ID is empty")
+	case userID == "":
+		return errors.New("user ID is empty")
+	case targetID == userID:
+		return errors.New("user can't block itself")
+	}
+
+	req := blockUserRequest{BlockedUserID: targetID, UserID: userID}
+
+	return c.makeRequest(http.MethodPost, p, nil, req, nil)
+}
+
+// GetBlockedUsers returns the personal block list of the user with given ID
+func (c *Client) GetBlockedUsers(userID string) ([]*UserBlock, error) {
+	if userID == "" {
+		return nil, errors.New("user ID is empty")
+	}
+
+	params := url.Values{"user_id": {userID}}
+
+	var resp blockedUsersResponse
+
+	err := c.makeRequest(http.MethodGet, "users/block", params, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Blocks, nil
+}
+
+// FlagUser flags the user with given ID for moderation
+// options: flag options, ie {"user_id": "reporter-id"} to flag on behalf of a user
 func (c *Client) FlagUser(targetID string, options map[string]interface{}) error {
 	switch {
 	case targetID == "":
@@ -123,6 +254,8 @@ func (c *Client) FlagUser(targetID string, options map[string]interface{}) error
 	return c.makeRequest(http.MethodPost, "moderation/flag", nil, options, nil)
 }
 
+// UnFlagUser removes the flag from the user with given ID
+// options: unflag options, ie {"user_id": "reporter-id"} to remove the flag set by a user
 func (c *Client) UnFlagUser(targetID string, options map[string]interface{}) error {
 	switch {
 	case targetID == "":
@@ -136,6 +269,94 @@ func (c *Client) UnFlagUser(targetID string, options map[string]interface{}) err
 	return c.makeRequest(http.MethodPost, "moderation/unflag", nil, options, nil)
 }
 
+// BanOptions are the options of a ban. The API has no device ban, IPBan is the only network
+// or device scoped option.
+type BanOptions struct {
+	Timeout    time.Duration // ban expiration, rounded down to minutes; zero bans forever
+	Expiration *time.Time    // ban expiration time, rounded up to minutes; exclusive with Timeout
+	Reason     string
+	IPBan      bool   // also ban the last known IP address of the user
+	Shadow     bool   // messages of the user are visible only to the user; exclusive with IPBan
+	BannedByID string // moderator the ban is attributed to, ie when a bot bans on behalf of a moderator
+}
+
+func (o BanOptions) validate() error {
+	switch {
+	case o.Timeout < 0:
+		return errors.New("ban timeout must be not negative")
+	case o.Timeout > 0 && o.Timeout < time.Minute:
+		return errors.New("ban timeout must be at least a minute")
+	case o.Timeout > 0 && o.Expiration != nil:
+		return errors.New("ban timeout and expiration are mutually exclusive")
+	case o.Expiration != nil && !o.Expiration.After(time.Now()):
+		return errors.New("ban expiration must be in the future")
+	case o.IPBan && o.Shadow:
+		return errors.New("IP ban and shadow ban are mutually exclusive")
+	}
+
+	return nil
+}
+
+// timeout returns the ban timeout in minutes
+func (o BanOptions) timeout() int64 {
+	if o.Expiration != nil {
+		return int64((time.Until(*o.Expiration) + time.Minute - 1) / time.Minute)
+	}
+
+	return int64(o.Timeout / time.Minute)
+}
+
+type banRequest struct {
+	TargetUserID string `json:"target_user_id"`
+	UserID       string `json:"user_id"`
+	Timeout      int64  `json:"timeout,omitempty"` // minutes
+	Reason       string `json:"reason,omitempty"`
+	IPBan        bool   `json:"ip_ban,omitempty"`
+	Shadow       bool   `json:"shadow,omitempty"`
+	BannedByID   string `json:"banned_by_id,omitempty"`
+
+	// channel of the ban, empty for app wide bans
+	Type string `json:"type,omitempty"`
+	ID   string `json:"id,omitempty"`
+}
+
+func newBanRequest(targetID, userID string, options BanOptions) (*banRequest, error) {
+	switch {
+	case targetID == "":
+		return nil, errors.New("target ID is empty")
+	case userID == "":
+		return nil, errors.New("user ID is empty")
+	case targetID == userID || targetID == options.BannedByID:
+		return nil, errors.New("user can't ban itself")
+	}
+
+	if err := options.validate(); err != nil {
+		return nil, err
+	}
+
+	return &banRequest{
+		TargetUserID: targetID,
+		UserID:       userID,
+		Timeout:      options.timeout(),
+		Reason:       options.Reason,
+		IPBan:        options.IPBan,
+		Shadow:       options.Shadow,
+		BannedByID:   options.BannedByID,
+	}, nil
+}
+
+// BanUserWithOptions bans target user ID app wide, userID is the user creating the ban
+func (c *Client) BanUserWithOptions(targetID, userID string, options BanOptions) error {
+	req, err := newBanRequest(targetID, userID, options)
+	if err != nil {
+		return err
+	}
+
+	return c.makeRequest(http.MethodPost, "moderation/ban", nil, req, nil)
+}
+
+// BanUser bans target user ID app wide, userID is the user creating the ban
+// options: additional ban data, ie {"timeout": 60, "reason": "spam"}; see BanUserWithOptions for typed options
 func (c *Client) BanUser(targetID, userID string, options map[string]interface{}) error {
 	switch {
 	case targetID == "":
@@ -152,6 +373,7 @@ func (c *Client) BanUser(targetID, userID string, options map[string]interface{}
 	return c.makeRequest(http.MethodPost, "moderation/ban", nil, options, nil)
 }
 
+// UnBanUser removes the app wide ban of target user ID
 func (c *Client) UnBanUser(targetID string, options map[string]string) error {
 	switch {
 	case targetID == "":
@@ -170,19 +392,84 @@ func (c *Client) UnBanUser(targetID string, options map[string]string) error {
 	return c.makeRequest(http.MethodDelete, "moderation/ban", params, nil, nil)
 }
 
+// ExportUser exports the user with given ID, see ExportUserData for the full export
 func (c *Client) ExportUser(targetID string, options map[string][]string) (user *User, err error) {
+	export, err := c.exportUser(targetID, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return export.User, nil
+}
+
+// UserExport is the data of a user; the user with their messages, reactions and channels
+type UserExport struct {
+	User      *User       `json:"user"`
+	Messages  []*Message  `json:"messages"`
+	Reactions []*Reaction `json:"reactions"`
+	Channels  []*Channel  `json:"channels"` // channels the user is a member of
+}
+
+// ExportUserData exports the user with given ID with all their messages, reactions and channels.
+// The export endpoint doesn't return channels, they're queried by membership of the user.
+func (c *Client) ExportUserData(targetID string, options map[string][]string) (*UserExport, error) {
+	export, err := c.exportUser(targetID, options)
+	if err != nil {
+		return nil, err
+	}
+
+	it := c.IterateChannels(&QueryOption{
+		Filter: map[string]interface{}{"members": map[string]interface{}{"$in": []string{targetID}}},
+	})
+	for it.Next(context.Background()) {
+		export.Channels = append(export.Channels, it.Channel())
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	return export, nil
+}
+
+func (c *Client) exportUser(targetID string, options map[string][]string) (*UserExport, error) {
 	if targetID == "" {
-		return user, errors.New("target ID is empty")
+		return nil, errors.New("target ID is empty")
 	}
 
 	p := path.Join("users", url.PathEscape(targetID), "export")
-	user = &User{}
 
-	err = c.makeRequest(http.MethodGet, p, options, nil, user)
+	var export UserExport
+
+	err := c.makeRequest(http.MethodGet, p, options, nil, &export)
+	if err != nil {
+		return nil, err
+	}
+	if export.User == nil {
+		return nil, errors.New("unexpected error: user export response is empty")
+	}
+
+	return &export, nil
+}
 
-	return user, err
+type exportUsersRequest struct {
+	UserIDs []string `json:"user_ids"`
 }
 
+// ExportUsers starts an async export of the users with given IDs, returns the ID of the export task
+func (c *Client) ExportUsers(userIDs []string) (taskID string, err error) {
+	if len(userIDs) == 0 {
+		return "", errors.New("user IDs are empty")
+	}
+
+	var resp taskResponse
+
+	err = c.makeRequest(http.MethodPost, "export/users", nil, exportUsersRequest{UserIDs: userIDs}, &resp)
+
+	return resp.TaskID, err
+}
+
+// DeactivateUser deactivates the user with given ID; deactivated users can't connect or be used in API calls
+// options: additional options, ie {"mark_messages_deleted": true, "created_by_id": "admin-id"}
 func (c *Client) DeactivateUser(targetID string, options map[string]interface{}) error {
 	if targetID == "" {
 		return errors.New("target ID is empty")
@@ -193,6 +480,8 @@ func (c *Client) DeactivateUser(targetID string, options map[string]interface{})
 	return c.makeRequest(http.MethodPost, p, nil, options, nil)
 }
 
+// ReactivateUser reactivates the deactivated user with given ID
+// options: additional options, ie {"restore_messages": true, "name": "new name", "created_by_id": "admin-id"}
 func (c *Client) ReactivateUser(targetID string, options map[string]interface{}) error {
 	if targetID == "" {
 		return errors.New("target ID is empty")
@@ -203,6 +492,58 @@ func (c *Client) ReactivateUser(targetID string, options map[string]interface{})
 	return c.makeRequest(http.MethodPost, p, nil, options, nil)
 }
 
+type DeactivateUserOptions struct {
+	MarkMessagesDeleted bool   `json:"mark_messages_deleted,omitempty"` // soft delete messages of the users
+	CreatedByID         string `json:"created_by_id,omitempty"`         // user who deactivates the users
+}
+
+type deactivateUsersRequest struct {
+	UserIDs []string `json:"user_ids"`
+	DeactivateUserOptions
+}
+
+// DeactivateUsers starts an async deactivation of the users with given IDs, returns the ID of the task
+func (c *Client) DeactivateUsers(userIDs []string, options DeactivateUserOptions) (taskID string, err error) {
+	if len(userIDs) == 0 {
+		return "", errors.New("user IDs are empty")
+	}
+
+	req := deactivateUsersRequest{UserIDs: userIDs, DeactivateUserOptions: options}
+
+	var resp taskResponse
+
+	err = c.makeRequest(http.MethodPost, "users/deactivate", nil, req, &resp)
+
+	return resp.TaskID, err
+}
+
+type ReactivateUserOptions struct {
+	RestoreMessages bool   `json:"restore_messages,omitempty"` // restore messages deleted on deactivation
+	CreatedByID     string `json:"created_by_id,omitempty"`    // user who reactivates the users
+}
+
+type reactivateUsersRequest struct {
+	UserIDs []string `json:"user_ids"`
+	ReactivateUserOptions
+}
+
+// ReactivateUsers starts an async reactivation of the users with given IDs, returns the ID of the task
+func (c *Client) ReactivateUsers(userIDs []string, options ReactivateUserOptions) (taskID string, err error) {
+	if len(userIDs) == 0 {
+		return "", errors.New("user IDs are empty")
+	}
+
+	req := reactivateUsersRequest{UserIDs: userIDs, ReactivateUserOptions: options}
+
+	var resp taskResponse
+
+	err = c.makeRequest(http.MethodPost, "users/reactivate", nil, req, &resp)
+
+	return resp.TaskID, err
+}
+
+// DeleteUser deletes the user with given ID
+// options: additional query params, ie {"hard_delete": {"true"}}; see DeleteUserWithOptions
 func (c *Client) DeleteUser(targetID string, options map[string][]string) error {
 	if targetID == "" {
 		return errors.New("target ID is empty")
@@ -213,6 +554,108 @@ func (c *Client) DeleteUser(targetID string, options map[string][]string) error
 	return c.makeRequest(http.MethodDelete, p, options, nil, nil)
 }
 
+// DeleteSingleUserOptions controls what is removed along with a single user, see DeleteUserWithOptions.
+// Use DeleteUsers for pruning deletion or channels ownership transfer.
+type DeleteSingleUserOptions struct {
+	MarkMessagesDeleted        bool // soft delete messages of the user, or hard delete them along with a hard deleted user
+	HardDelete                 bool // remove the user and their data permanently
+	DeleteConversationChannels bool // delete 1:1 channels of the user
+}
+
+// DeleteUserWithOptions deletes the user with given ID
+func (c *Client) DeleteUserWithOptions(targetID string, options DeleteSingleUserOptions) error {
+	params := url.Values{}
+	if options.HardDelete {
+		params.Set("hard_delete", "true")
+	}
+	if options.MarkMessagesDeleted {
+		params.Set("mark_messages_deleted", "true")
+	}
+	if options.DeleteConversationChannels {
+		params.Set("delete_conversation_channels", "true")
+	}
+
+	return c.DeleteUser(targetID, params)
+}
+
+type DeleteType string
+
+const (
+	DeleteTypeSoft    DeleteType = "soft"
+	DeleteTypeHard    DeleteType = "hard"
+	DeleteTypePruning DeleteType = "pruning" // soft delete which also removes the user data, users only
+)
+
+// DeleteUserOptions controls what is removed along with the users
+type DeleteUserOptions struct {
+	User              DeleteType `json:"user"`                           // required; soft, pruning or hard
+	Messages          DeleteType `json:"messages,omitempty"`             // soft or hard; messages are kept if empty
+	Conversations     DeleteType `json:"conversations,omitempty"`        // soft or hard; 1:1 channels are kept if empty
+	NewChannelOwnerID string     `json:"new_channel_owner_id,omitempty"` // user to transfer channels created by deleted users
+}
+
+func (o DeleteUserOptions) validate() error {
+	switch o.User {
+	case DeleteTypeSoft, DeleteTypeHard, DeleteTypePruning:
+	case "":
+		return errors.New("user delete type is empty")
+	default:
+		return fmt.Errorf("invalid user delete type: %s", o.User)
+	}
+
+	switch o.Messages {
+	case "", DeleteTypeSoft, DeleteTypeHard:
+	default:
+		return fmt.Errorf("invalid messages delete type: %s", o.Messages)
+	}
+
+	switch o.Conversations {
+	case "", DeleteTypeSoft, DeleteTypeHard:
+	default:
+		return fmt.Errorf("invalid conversations delete type: %s", o.Conversations)
+	}
+
+	return nil
+}
+
+type deleteUsersRequest struct {
+	UserIDs []string `json:"user_ids"`
+	DeleteUserOptions
+}
+
+// DeleteUsers starts an async deletion of the users with given IDs, returns the ID of the deletion task
+func (c *Client) DeleteUsers(userIDs []string, options DeleteUserOptions) (taskID string, err error) {
+	if len(userIDs) == 0 {
+		return "", errors.New("user IDs are empty")
+	}
+	if err := options.validate(); err != nil {
+		return "", err
+	}
+
+	req := deleteUsersRequest{UserIDs: userIDs, DeleteUserOptions: options}
+
+	var resp taskResponse
+
+	err = c.makeRequest(http.MethodPost, "users/delete", nil, req, &resp)
+
+	return resp.TaskID, err
+}
+
+type restoreUsersRequest struct {
+	UserIDs []string `json:"user_ids"`
+}
+
+// RestoreUsers restores the soft deleted users with given IDs, hard deleted users can't be restored
+func (c *Client) RestoreUsers(userIDs []string) error {
+	if len(userIDs) == 0 {
+		return errors.New("user IDs are empty")
+	}
+
+	req := restoreUsersRequest{UserIDs: userIDs}
+
+	return c.makeRequest(http.MethodPost, "users/restore", nil, req, nil)
+}
+
 type usersResponse struct {
 	Users map[string]*User `json:"users"`
 }
@@ -234,19 +677,47 @@ type userRequest struct {
 }
 
 // UpdateUser sending update users request, returns updated user info
+//
+// Deprecated: use UpsertUser
 func (c *Client) UpdateUser(user *User) (*User, error) {
-	users, err := c.UpdateUsers(user)
-	return users[user.ID], err
+	return c.UpsertUser(user)
 }
 
 // UpdateUsers send update users request, returns updated user info
+//
+// Deprecated: use UpsertUsers
 func (c *Client) UpdateUsers(users ...*User) (map[string]*User, error) {
+	return c.UpsertUsers(users...)
+}
+
+// UpsertUser creates or replaces the user, returns the user with server populated fields, ie role and created_at
+func (c *Client) UpsertUser(user *User) (*User, error) {
+	if user == nil {
+		return nil, errors.New("user is nil")
+	}
+
+	users, err := c.UpsertUsers(user)
+	if err != nil {
+		return nil, err
+	}
+
+	return users[user.ID], nil
+}
+
+// UpsertUsers creates or replaces the users, returns the users with server populated fields by ID
+func (c *Client) UpsertUsers(users ...*User) (map[string]*User, error) {
 	if len(users) == 0 {
 		return nil, errors.New("users are not set")
 	}
 
 	req := usersRequest{Users: make(map[string]userRequest, len(users))}
 	for _, u := range users {
+		switch {
+		case u == nil:
+			return nil, errors.New("user is nil")
+		case u.ID == "":
+			return nil, errors.New("user ID is empty")
+		}
 		req.Users[u.ID] = userRequest{User: u, ExtraData: u.ExtraData}
 	}
 
@@ -257,7 +728,38 @@ func (c *Client) UpdateUsers(users ...*User) (map[string]*User, error) {
 		return nil, err
 	}
 
-	return resp.Users, err
+	return resp.Users, nil
+}
+
+type guestUserRequest struct {
+	User userRequest `json:"user"`
+}
+
+type guestUserResponse struct {
+	User        *User  `json:"user"`
+	AccessToken string `json:"access_token"`
+}
+
+// CreateGuestUser creates a guest user, guest access must be enabled for the app.
+// Returns the created guest user and its access token.
+func (c *Client) CreateGuestUser(user *User) (guest *User, token string, err error) {
+	switch {
+	case user == nil:
+		return nil, "", errors.New("user is nil")
+	case user.ID == "":
+		return nil, "", errors.New("user ID is empty")
+	}
+
+	req := guestUserRequest{User: userRequest{User: user, ExtraData: user.ExtraData}}
+
+	var resp guestUserResponse
+
+	err = c.makeRequest(http.MethodPost, "guest", nil, req, &resp)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return resp.User, resp.AccessToken, nil
 }
 
 // PartialUserUpdate request; Set and Unset fields can be set at same time, but should not be same field,
@@ -1,25 +1,290 @@
 package stream_chat // nolint: golint
 
 import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
 
 func TestClient_BanUser(t *testing.T) {
+	c := initClient(t)
+
+	user := randomUser()
+
+	err := c.BanUserWithOptions(user.ID, serverUser.ID, BanOptions{
+		Timeout: time.Hour,
+		Reason:  "spam",
+	})
+	mustNoError(t, err, "ban user")
+
+	bans, err := c.QueryBannedUsers(&QueryOption{Filter: map[string]interface{}{"user_id": user.ID}})
+	mustNoError(t, err, "query banned users")
+	if assert.Len(t, bans, 1, "bans") {
+		assert.Nil(t, bans[0].Channel, "app wide ban")
+		assert.Equal(t, "spam", bans[0].Reason)
+	}
 
+	mustNoError(t, c.UnBanUser(user.ID, nil), "unban user")
+
+	err = c.BanUserWithOptions(user.ID, serverUser.ID, BanOptions{Timeout: time.Second})
+	mustError(t, err, "timeout under a minute")
+}
+
+func TestNewBanRequest(t *testing.T) {
+	inHour := time.Now().Add(time.Hour)
+	past := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name    string
+		target  string
+		options BanOptions
+		timeout int64
+		wantErr bool
+	}{
+		{name: "permanent", target: "bob"},
+		{name: "timeout", target: "bob", options: BanOptions{Timeout: 90 * time.Second}, timeout: 1},
+		{name: "expiration", target: "bob", options: BanOptions{Expiration: &inHour}, timeout: 60},
+		{name: "shadow", target: "bob", options: BanOptions{Shadow: true, BannedByID: "mod"}},
+		{name: "timeout and expiration", target: "bob", options: BanOptions{Timeout: time.Hour, Expiration: &inHour}, wantErr: true},
+		{name: "past expiration", target: "bob", options: BanOptions{Expiration: &past}, wantErr: true},
+		{name: "shadow ip ban", target: "bob", options: BanOptions{Shadow: true, IPBan: true}, wantErr: true},
+		{name: "self ban", target: "admin", wantErr: true},
+		{name: "ban by target", target: "bob", options: BanOptions{BannedByID: "bob"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := newBanRequest(tt.target, "admin", tt.options)
+			if tt.wantErr {
+				mustError(t, err, tt.name)
+				return
+			}
+
+			mustNoError(t, err, tt.name)
+			assert.Equal(t, tt.timeout, req.Timeout)
+			assert.Equal(t, tt.options.Shadow, req.Shadow)
+			assert.Equal(t, tt.options.BannedByID, req.BannedByID)
+		})
+	}
 }
 
 func TestClient_DeactivateUser(t *testing.T) {
+	c := initClient(t)
 
+	user := &User{ID: randomString(10)}
+	_, err := c.UpdateUser(user)
+	mustNoError(t, err, "update user")
+
+	err = c.DeactivateUser(user.ID, map[string]interface{}{"mark_messages_deleted": true})
+	mustNoError(t, err, "deactivate user")
+
+	err = c.ReactivateUser(user.ID, map[string]interface{}{"restore_messages": true})
+	mustNoError(t, err, "reactivate user")
+}
+
+func TestClient_DeactivateUsers(t *testing.T) {
+	c := initClient(t)
+
+	user := &User{ID: randomString(10)}
+	_, err := c.UpdateUser(user)
+	mustNoError(t, err, "update user")
+
+	taskID, err := c.DeactivateUsers([]string{user.ID}, DeactivateUserOptions{CreatedByID: serverUser.ID})
+	mustNoError(t, err, "deactivate users")
+
+	task, err := c.WaitForTask(taskID, time.Minute)
+	mustNoError(t, err, "wait for task")
+	assert.Equal(t, TaskStatusCompleted, task.Status, "users deactivated")
+
+	taskID, err = c.ReactivateUsers([]string{user.ID}, ReactivateUserOptions{RestoreMessages: true})
+	mustNoError(t, err, "reactivate users")
+
+	task, err = c.WaitForTask(taskID, time.Minute)
+	mustNoError(t, err, "wait for task")
+	assert.Equal(t, TaskStatusCompleted, task.Status, "users reactivated")
 }
 
 func TestClient_DeleteUser(t *testing.T) {
+	c := initClient(t)
+
+	user := &User{ID: randomString(10)}
+	_, err := c.UpdateUser(user)
+	mustNoError(t, err, "update user")
+
+	err = c.DeleteUserWithOptions(user.ID, DeleteSingleUserOptions{
+		MarkMessagesDeleted:        true,
+		HardDelete:                 true,
+		DeleteConversationChannels: true,
+	})
+	mustNoError(t, err, "delete user")
+}
+
+func TestClient_DeleteUserWithOptions(t *testing.T) {
+	var query url.Values
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/users/bob", r.URL.Path)
+		query = r.URL.Query()
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	c, err := NewClient("key", []byte("secret"), WithBaseURL(srv.URL))
+	mustNoError(t, err, "new client")
+
+	mustNoError(t, c.DeleteUserWithOptions("bob", DeleteSingleUserOptions{}), "soft delete user")
+	assert.Empty(t, query.Get("hard_delete"))
+	assert.Empty(t, query.Get("mark_messages_deleted"))
+	assert.Empty(t, query.Get("delete_conversation_channels"))
+
+	err = c.DeleteUserWithOptions("bob", DeleteSingleUserOptions{
+		MarkMessagesDeleted:        true,
+		HardDelete:                 true,
+		DeleteConversationChannels: true,
+	})
+	mustNoError(t, err, "hard delete user")
+	assert.Equal(t, "true", query.Get("hard_delete"))
+	assert.Equal(t, "true", query.Get("mark_messages_deleted"))
+	assert.Equal(t, "true", query.Get("delete_conversation_channels"))
+}
+
+func TestClient_DeleteUsers(t *testing.T) {
+	c := initClient(t)
+
+	user := &User{ID: randomString(10)}
+	_, err := c.UpdateUser(user)
+	mustNoError(t, err, "update user")
+
+	_, err = c.DeleteUsers([]string{user.ID}, DeleteUserOptions{})
+	mustError(t, err, "delete type is required")
+
+	taskID, err := c.DeleteUsers([]string{user.ID}, DeleteUserOptions{
+		User:     DeleteTypeHard,
+		Messages: DeleteTypeHard,
+	})
+	mustNoError(t, err, "delete users")
+
+	task, err := c.WaitForTask(taskID, time.Minute)
+	mustNoError(t, err, "wait for task")
+	assert.Equal(t, TaskStatusCompleted, task.Status, "users deleted")
+}
+
+func TestClient_RestoreUsers(t *testing.T) {
+	c := initClient(t)
+
+	user := &User{ID: randomString(10)}
+	_, err := c.UpsertUser(user)
+	mustNoError(t, err, "upsert user")
+
+	mustNoError(t, c.DeleteUserWithOptions(user.ID, DeleteSingleUserOptions{}), "soft delete user")
+	mustNoError(t, c.RestoreUsers([]string{user.ID}), "restore users")
+
+	users, err := c.QueryUsers(&QueryOption{Filter: map[string]interface{}{"id": user.ID}})
+	mustNoError(t, err, "query users")
+	if assert.Len(t, users, 1) {
+		assert.Nil(t, users[0].DeletedAt, "user is restored")
+	}
+
+	mustError(t, c.RestoreUsers(nil), "empty user IDs")
+}
+
+func TestClient_ExportUser(t *testing.T) {
+	c := initClient(t)
+	ch := initChannel(t, c)
+
+	user := randomUser()
+
+	_, err := ch.SendMessage(&Message{Text: "test message"}, user.ID)
+	mustNoError(t, err, "send message")
+
+	export, err := c.ExportUserData(user.ID, nil)
+	mustNoError(t, err, "export user")
+
+	assert.Equal(t, user.ID, export.User.ID, "exported user")
+	assert.NotEmpty(t, export.Messages, "exported messages")
+
+	var cids []string
+	for _, exported := range export.Channels {
+		cids = append(cids, exported.CID)
+	}
+	assert.Contains(t, cids, ch.CID, "exported channels")
+
+	got, err := c.ExportUser(user.ID, nil)
+	mustNoError(t, err, "export user")
+	assert.Equal(t, user.ID, got.ID, "exported user")
 }
 
-func TestClient_ExportUser(t *testing.T) {}
+func TestClient_ExportUserDataChannels(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/users/bob/export":
+			_, _ = w.Write([]byte(`{"user":{"id":"bob"},"messages":[{"id":"m1"}]}`))
+		case "/channels":
+			assert.Contains(t, r.URL.Query().Get("payload"), `"members":{"$in":["bob"]}`)
+			_, _ = w.Write([]byte(`{"channels":[{"channel":{"cid":"messaging:general"}}]}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := NewClient("key", []byte("secret"), WithBaseURL(srv.URL))
+	mustNoError(t, err, "new client")
+
+	export, err := c.ExportUserData("bob", nil)
+	mustNoError(t, err, "export user")
+	assert.Len(t, export.Messages, 1, "exported messages")
+	if assert.Len(t, export.Channels, 1, "exported channels") {
+		assert.Equal(t, "messaging:general", export.Channels[0].CID)
+	}
+}
+
+func TestClient_ExportUsers(t *testing.T) {
+	c := initClient(t)
+
+	taskID, err := c.ExportUsers([]string{randomUser().ID})
+	mustNoError(t, err, "export users")
+	assert.NotEmpty(t, taskID, "export task ID")
+}
 
 func TestClient_FlagUser(t *testing.T) {
+	c := initClient(t)
+	initChannel(t, c)
+
+	user := randomUser()
+
+	err := c.FlagUser(user.ID, map[string]interface{}{"user_id": serverUser.ID})
+	mustNoError(t, err, "flag user")
+
+	err = c.UnFlagUser(user.ID, map[string]interface{}{"user_id": serverUser.ID})
+	mustNoError(t, err, "unflag user")
+}
+
+func TestClient_BlockUser(t *testing.T) {
+	c := initClient(t)
+
+	user, target := randomUser(), randomUser()
+
+	mustNoError(t, c.BlockUser(target.ID, user.ID), "block user")
+
+	blocks, err := c.GetBlockedUsers(user.ID)
+	mustNoError(t, err, "get blocked users")
+	if assert.Len(t, blocks, 1) {
+		assert.Equal(t, target.ID, blocks[0].BlockedUserID)
+	}
+
+	mustNoError(t, c.UnblockUser(target.ID, user.ID), "unblock user")
+
+	blocks, err = c.GetBlockedUsers(user.ID)
+	mustNoError(t, err, "get blocked users")
+	assert.Empty(t, blocks)
+
+	mustError(t, c.BlockUser(user.ID, user.ID), "self block")
 }
 
 func TestClient_MuteUser(t *testing.T) {
@@ -55,6 +320,29 @@ func TestClient_MuteUsers(t *testing.T) {
 	mustNoError(t, err, "mute user")
 }
 
+func TestClient_MuteUserWithExpiration(t *testing.T) {
+	c := initClient(t)
+	initChannel(t, c)
+
+	user := randomUser()
+
+	mute, err := c.MuteUserWithExpiration(user.ID, serverUser.ID, time.Hour)
+	mustNoError(t, err, "mute user")
+
+	assert.Equal(t, user.ID, mute.Target.ID, "mute target")
+	assert.Equal(t, serverUser.ID, mute.User.ID, "mute user")
+	assert.NotNil(t, mute.Expires, "mute has expiration")
+	assert.NotEmpty(t, mute.CreatedAt, "mute has created at")
+
+	mustNoError(t, c.UnmuteUser(user.ID, serverUser.ID), "unmute user")
+
+	_, err = c.MuteUserWithExpiration(user.ID, serverUser.ID, -time.Hour)
+	mustError(t, err, "negative expiration")
+
+	_, err = c.MuteUserWithExpiration(user.ID, serverUser.ID, 30*time.Second)
+	mustError(t, err, "expiration under a minute")
+}
+
 func TestClient_UnBanUser(t *testing.T) {
 }
 
@@ -89,6 +377,25 @@ func TestClient_UpdateUsers(t *testing.T) {
 	assert.NotEmpty(t, resp[user.ID].UpdatedAt)
 }
 
+func TestClient_UpsertUsers(t *testing.T) {
+	c := initClient(t)
+
+	user := &User{ID: randomString(10), ExtraData: map[string]interface{}{"race": "Dwarf"}}
+
+	resp, err := c.UpsertUsers(user)
+	mustNoError(t, err, "upsert users")
+
+	if assert.Contains(t, resp, user.ID) {
+		got := resp[user.ID]
+		assert.Equal(t, "user", got.Role, "default role")
+		assert.NotNil(t, got.CreatedAt, "created at")
+		assert.Equal(t, "Dwarf", got.ExtraData["race"])
+	}
+
+	_, err = c.UpsertUsers(&User{})
+	mustError(t, err, "user without ID")
+}
+
 func TestClient_PartialUpdateUsers(t *testing.T) {
 	c := initClient(t)
 
@@ -124,3 +431,40 @@ func TestClient_PartialUpdateUsers(t *testing.T) {
 	assert.Contains(t, got[user.ID].ExtraData, "test", "extra data contains", got[user.ID].ExtraData)
 	assert.Empty(t, got[user.ID].ExtraData["test"], "extra data field removed")
 }
+
+func TestClient_CreateGuestUser(t *testing.T) {
+	c := initClient(t)
+
+	user := &User{ID: randomString(10), Name: "Guest"}
+
+	guest, token, err := c.CreateGuestUser(user)
+	mustNoError(t, err, "create guest user")
+
+	assert.Contains(t, guest.ID, user.ID, "guest user ID")
+	assert.Equal(t, "guest", guest.Role, "guest user role")
+	assert.NotEmpty(t, token, "guest access token")
+}
+
+func TestUser_UnmarshalJSON(t *testing.T) {
+	data := `{"id":"bob","role":"admin","banned":true,"ban_expires":"2020-01-02T15:04:05Z",
+		"online":true,"invisible":true,"teams":["red"],"last_active":"2020-01-01T15:04:05Z","favorite_color":"blue"}`
+
+	var u User
+	mustNoError(t, u.UnmarshalJSON([]byte(data)), "unmarshal user")
+
+	assert.Equal(t, "admin", u.Role)
+	assert.True(t, u.Banned, "banned")
+	if assert.NotNil(t, u.BanExpires, "ban expires") {
+		assert.Equal(t, time.Date(2020, 1, 2, 15, 4, 5, 0, time.UTC), u.BanExpires.UTC())
+	}
+	assert.True(t, u.Online, "online")
+	assert.True(t, u.Invisible, "invisible")
+	assert.Equal(t, []string{"red"}, u.Teams)
+	assert.NotNil(t, u.LastActive, "last active")
+	assert.Equal(t, map[string]interface{}{"favorite_color": "blue"}, u.ExtraData, "only custom fields are extra")
+
+	b, err := u.MarshalJSON()
+	mustNoError(t, err, "marshal user")
+	assert.Contains(t, string(b), `"favorite_color":"blue"`, "custom fields are flattened")
+	assert.Contains(t, string(b), `"banned":true`)
+}
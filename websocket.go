@@ -0,0 +1,304 @@
+package stream_chat // nolint: golint
+
+import (
+	"errors"
+	"net/url"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/getstream/easyjson"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	wsHealthCheckInterval = 25 * time.Second
+	wsHealthCheckTimeout  = 35 * time.Second
+	wsHandshakeTimeout    = 10 * time.Second
+	wsMinReconnectDelay   = 500 * time.Millisecond
+	wsMaxReconnectDelay   = 30 * time.Second
+	wsEventsBuffer        = 100
+)
+
+type wsUserDetails struct {
+	ID string `json:"id"`
+}
+
+type wsConnectRequest struct {
+	UserID                       string        `json:"user_id"`
+	UserDetails                  wsUserDetails `json:"user_details"`
+	ServerDeterminesConnectionID bool          `json:"server_determines_connection_id"`
+}
+
+type wsHealthCheck struct {
+	Type     EventType `json:"type"`
+	ClientID string    `json:"client_id"`
+}
+
+// WebSocket is a realtime connection receiving events on behalf of a user.
+// Dropped connections are re-established automatically until Close is called.
+type WebSocket struct {
+	client *Client
+	userID string
+
+	events    chan *Event
+	done      chan struct{}
+	closeOnce *sync.Once
+
+	mu           *sync.Mutex // guards conn writes and connectionID
+	conn         *websocket.Conn
+	connectionID string
+}
+
+// ConnectWebSocket opens a realtime connection as the user with given ID,
+// events received by the user are delivered on the Events channel:
+//
+//	ws, err := client.ConnectWebSocket("bob")
+//	...
+//	defer ws.Close()
+//	for event := range ws.Events() {
+//	  if event.Type == EventMessageNew { ... }
+//	}
+func (c *Client) ConnectWebSocket(userID string) (*WebSocket, error) {
+	if userID == "" {
+		return nil, errors.New("user ID is empty")
+	}
+
+	ws := &WebSocket{
+		client:    c,
+		userID:    userID,
+		events:    make(chan *Event, wsEventsBuffer),
+		done:      make(chan struct{}),
+		closeOnce: &sync.Once{},
+		mu:        &sync.Mutex{},
+	}
+
+	conn, err := ws.connect()
+	if err != nil {
+		return nil, err
+	}
+
+	go ws.run(conn)
+
+	return ws, nil
+}
+
+// Events returns the channel of received events, it is closed once the connection is closed.
+// Health check events are handled internally and are not delivered.
+func (ws *WebSocket) Events() <-chan *Event {
+	return ws.events
+}
+
+// ConnectionID returns the ID assigned by the server to the current connection
+func (ws *WebSocket) ConnectionID() string {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	return ws.connectionID
+}
+
+// Close closes the connection and stops reconnecting
+func (ws *WebSocket) Close() error {
+	var err error
+
+	ws.closeOnce.Do(func() {
+		close(ws.done)
+
+		ws.mu.Lock()
+		defer ws.mu.Unlock()
+
+		err = ws.conn.Close()
+	})
+
+	return err
+}
+
+func (ws *WebSocket) url() (string, error) {
+	u, err := url.Parse(ws.client.BaseURL)
+	if err != nil {
+		return "", err
+	}
+
+	switch u.Scheme {
+	case "https":
+		u.Scheme = "wss"
+	case "http":
+		u.Scheme = "ws"
+	}
+	u.Path = path.Join(u.Path, "connect")
+
+	token, err := ws.client.CreateToken(ws.userID, time.Time{})
+	if err != nil {
+		return "", err
+	}
+
+	req := wsConnectRequest{
+		UserID:                       ws.userID,
+		UserDetails:                  wsUserDetails{ID: ws.userID},
+		ServerDeterminesConnectionID: true,
+	}
+
+	data, err := easyjson.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+
+	values := url.Values{}
+	values.Set("json", string(data))
+	values.Set("api_key", ws.client.apiKey)
+	values.Set("authorization", string(token))
+	values.Set("stream-auth-type", "jwt")
+	u.RawQuery = values.Encode()
+
+	return u.String(), nil
+}
+
+// connect dials the server and waits for the initial health check carrying the connection ID
+func (ws *WebSocket) connect() (*websocket.Conn, error) {
+	u, err := ws.url()
+	if err != nil {
+		return nil, err
+	}
+
+	dialer := websocket.Dialer{HandshakeTimeout: wsHandshakeTimeout}
+
+	conn, _, err := dialer.Dial(u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := conn.SetReadDeadline(time.Now().Add(wsHealthCheckTimeout)); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	var event Event
+
+	_, data, err := conn.ReadMessage()
+	if err == nil {
+		err = easyjson.Unmarshal(data, &event)
+	}
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	if event.Type != EventHealthCheck || event.ConnectionID == "" {
+		conn.Close()
+		return nil, errors.New("websocket: unexpected first event " + string(event.Type))
+	}
+
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	select {
+	case <-ws.done:
+		conn.Close()
+		return nil, errors.New("websocket: closed")
+	default:
+	}
+
+	ws.conn = conn
+	ws.connectionID = event.ConnectionID
+
+	return conn, nil
+}
+
+// run reads events until the websocket is closed, reconnecting with exponential backoff on failures
+func (ws *WebSocket) run(conn *websocket.Conn) {
+	defer close(ws.events)
+
+	for {
+		ws.read(conn)
+
+		conn = ws.reconnect()
+		if conn == nil {
+			return
+		}
+	}
+}
+
+func (ws *WebSocket) reconnect() *websocket.Conn {
+	delay := wsMinReconnectDelay
+
+	for {
+		select {
+		case <-ws.done:
+			return nil
+		case <-time.After(delay):
+		}
+
+		if conn, err := ws.connect(); err == nil {
+			return conn
+		}
+
+		delay *= 2
+		if delay > wsMaxReconnectDelay {
+			delay = wsMaxReconnectDelay
+		}
+	}
+}
+
+// read delivers events of the connection until a read fails or no message
+// is received within the health check timeout
+func (ws *WebSocket) read(conn *websocket.Conn) {
+	defer conn.Close()
+
+	stop := make(chan struct{})
+	defer close(stop)
+
+	go ws.healthCheck(conn, stop)
+
+	for {
+		if err := conn.SetReadDeadline(time.Now().Add(wsHealthCheckTimeout)); err != nil {
+			return
+		}
+
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		event := &Event{}
+		if err := easyjson.Unmarshal(data, event); err != nil {
+			continue
+		}
+
+		if event.Type == EventHealthCheck {
+			continue
+		}
+
+		select {
+		case ws.events <- event:
+		case <-ws.done:
+			return
+		}
+	}
+}
+
+// healthCheck periodically tells the server the connection is alive
+func (ws *WebSocket) healthCheck(conn *websocket.Conn, stop <-chan struct{}) {
+	ticker := time.NewTicker(wsHealthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+
+		ws.mu.Lock()
+		msg := wsHealthCheck{Type: EventHealthCheck, ClientID: ws.connectionID}
+		data, err := easyjson.Marshal(msg)
+		if err == nil {
+			err = conn.WriteMessage(websocket.TextMessage, data)
+		}
+		ws.mu.Unlock()
+
+		if err != nil {
+			conn.Close()
+			return
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package stream_chat // nolint: golint
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ConnectWebSocket(t *testing.T) {
+	var connections int32
+
+	upgrader := websocket.Upgrader{}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/connect", r.URL.Path)
+		assert.Equal(t, "key", r.URL.Query().Get("api_key"))
+
+		var req wsConnectRequest
+		assert.NoError(t, json.Unmarshal([]byte(r.URL.Query().Get("json")), &req))
+		assert.Equal(t, "bob", req.UserID)
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		n := atomic.AddInt32(&connections, 1)
+
+		_ = conn.WriteMessage(websocket.TextMessage,
+			[]byte(fmt.Sprintf(`{"type":"health.check","connection_id":"conn-%d"}`, n)))
+		_ = conn.WriteMessage(websocket.TextMessage,
+			[]byte(fmt.Sprintf(`{"type":"message.new","cid":"messaging:%d","message":{"text":"hi"}}`, n)))
+
+		// drop the first connection, keep the second one open until client closes
+		if n > 1 {
+			_, _, _ = conn.ReadMessage()
+		}
+	}))
+	defer srv.Close()
+
+	c, err := NewClient("key", []byte("secret"))
+	mustNoError(t, err, "new client")
+	c.BaseURL = srv.URL
+
+	ws, err := c.ConnectWebSocket("bob")
+	mustNoError(t, err, "connect websocket")
+
+	next := func() *Event {
+		select {
+		case event := <-ws.Events():
+			return event
+		case <-time.After(5 * time.Second):
+			t.Fatal("no event received")
+		}
+		return nil
+	}
+
+	event := next()
+	require.NotNil(t, event)
+	assert.Equal(t, EventMessageNew, event.Type)
+	assert.Equal(t, "messaging:1", event.CID)
+	assert.Equal(t, "hi", event.Message.Text)
+
+	event = next()
+	require.NotNil(t, event)
+	assert.Equal(t, "messaging:2", event.CID, "event after reconnect")
+	assert.Equal(t, "conn-2", ws.ConnectionID())
+
+	_ = ws.Close()
+
+	_, ok := <-ws.Events()
+	assert.False(t, ok, "events closed")
+}